@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func cosmosDbThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.CosmosThroughput,
+	}
+}
+
+// expandCosmosDbThroughputOptions returns the Options for a Create request - throughput can only be
+// provisioned when a database/container is created, after which it's updated via the Throughput endpoints
+func expandCosmosDbThroughputOptions(d *schema.ResourceData) map[string]*string {
+	options := make(map[string]*string)
+
+	if v, ok := d.GetOk("throughput"); ok {
+		options["throughput"] = utils.String(strconv.Itoa(v.(int)))
+	}
+
+	return options
+}
+
+func expandCosmosDbThroughput(d *schema.ResourceData) documentdb.ThroughputUpdateParameters {
+	return documentdb.ThroughputUpdateParameters{
+		ThroughputUpdateProperties: &documentdb.ThroughputUpdateProperties{
+			Resource: &documentdb.ThroughputResource{
+				Throughput: utils.Int32(int32(d.Get("throughput").(int))),
+			},
+		},
+	}
+}
+
+// flattenCosmosDbThroughput returns the throughput provisioned on a database/container, where a 404 means
+// the throughput is either shared from the database or was never provisioned
+func flattenCosmosDbThroughput(resp documentdb.Throughput, err error) (*int, error) {
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if props := resp.ThroughputProperties; props != nil && props.Throughput != nil {
+		return utils.Int(int(*props.Throughput)), nil
+	}
+
+	return nil, nil
+}
+
+func cosmosDbPartitionKeyPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+}
+
+func cosmosDbUniqueKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"paths": {
+					Type:     schema.TypeSet,
+					Required: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+			},
+		},
+	}
+}
+
+func cosmosDbIndexingPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// the API returns the indexing mode in lower case
+				"indexing_mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          string(documentdb.Consistent),
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(documentdb.Consistent),
+						string(documentdb.Lazy),
+						string(documentdb.None),
+					}, true),
+				},
+
+				"included_path": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+
+				"excluded_path": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandCosmosDbPartitionKey(input string) *documentdb.ContainerPartitionKey {
+	if input == "" {
+		return nil
+	}
+
+	return &documentdb.ContainerPartitionKey{
+		Paths: &[]string{input},
+		Kind:  documentdb.PartitionKindHash,
+	}
+}
+
+func flattenCosmosDbPartitionKey(input *documentdb.ContainerPartitionKey) string {
+	if input == nil || input.Paths == nil {
+		return ""
+	}
+
+	// the API accepts a list of paths, but only a single path is supported
+	for _, path := range *input.Paths {
+		return path
+	}
+
+	return ""
+}
+
+func expandCosmosDbUniqueKeyPolicy(input []interface{}) *documentdb.UniqueKeyPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	keys := make([]documentdb.UniqueKey, 0)
+	for _, k := range input {
+		key := k.(map[string]interface{})
+		keys = append(keys, documentdb.UniqueKey{
+			Paths: utils.ExpandStringSlice(key["paths"].(*schema.Set).List()),
+		})
+	}
+
+	return &documentdb.UniqueKeyPolicy{
+		UniqueKeys: &keys,
+	}
+}
+
+func flattenCosmosDbUniqueKeyPolicy(input *documentdb.UniqueKeyPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.UniqueKeys == nil {
+		return results
+	}
+
+	for _, key := range *input.UniqueKeys {
+		if key.Paths == nil {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"paths": utils.FlattenStringSlice(key.Paths),
+		})
+	}
+
+	return results
+}
+
+func expandCosmosDbIndexingPolicy(input []interface{}) *documentdb.IndexingPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	policy := documentdb.IndexingPolicy{
+		IndexingMode: documentdb.IndexingMode(v["indexing_mode"].(string)),
+	}
+
+	if includedPaths := v["included_path"].([]interface{}); len(includedPaths) > 0 {
+		paths := make([]documentdb.IncludedPath, 0)
+		for _, p := range includedPaths {
+			path := p.(map[string]interface{})
+			paths = append(paths, documentdb.IncludedPath{
+				Path: utils.String(path["path"].(string)),
+			})
+		}
+		policy.IncludedPaths = &paths
+	}
+
+	if excludedPaths := v["excluded_path"].([]interface{}); len(excludedPaths) > 0 {
+		paths := make([]documentdb.ExcludedPath, 0)
+		for _, p := range excludedPaths {
+			path := p.(map[string]interface{})
+			paths = append(paths, documentdb.ExcludedPath{
+				Path: utils.String(path["path"].(string)),
+			})
+		}
+		policy.ExcludedPaths = &paths
+	}
+
+	return &policy
+}
+
+func flattenCosmosDbIndexingPolicy(input *documentdb.IndexingPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	includedPaths := make([]interface{}, 0)
+	if input.IncludedPaths != nil {
+		for _, p := range *input.IncludedPaths {
+			if p.Path == nil {
+				continue
+			}
+
+			includedPaths = append(includedPaths, map[string]interface{}{
+				"path": *p.Path,
+			})
+		}
+	}
+
+	excludedPaths := make([]interface{}, 0)
+	if input.ExcludedPaths != nil {
+		for _, p := range *input.ExcludedPaths {
+			// the `_etag` system property is always excluded by the API, so isn't surfaced
+			if p.Path == nil || *p.Path == `/"_etag"/?` {
+				continue
+			}
+
+			excludedPaths = append(excludedPaths, map[string]interface{}{
+				"path": *p.Path,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"indexing_mode": string(input.IndexingMode),
+			"included_path": includedPaths,
+			"excluded_path": excludedPaths,
+		},
+	}
+}
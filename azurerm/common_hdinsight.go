@@ -7,13 +7,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).HDInsight.ClustersClient
+		ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
 		id, err := azure.ParseAzureResourceID(d.Id())
 		if err != nil {
@@ -26,7 +28,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 		if d.HasChange("tags") {
 			tags := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
-				Tags: expandTags(tags),
+				Tags: expandTags(tags, meta),
 			}
 			if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
 				return fmt.Errorf("Error updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
@@ -60,8 +62,9 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 
 func hdinsightClusterDelete(clusterKind string) schema.DeleteFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).HDInsight.ClustersClient
+		ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
 		id, err := azure.ParseAzureResourceID(d.Id())
 		if err != nil {
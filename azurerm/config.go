@@ -3,9 +3,6 @@ package azurerm
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
-	"strings"
 	"sync"
 	"time"
 
@@ -13,142 +10,18 @@ import (
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/go-azure-helpers/sender"
-	"github.com/hashicorp/terraform/httpclient"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/graph"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
-	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
-// ArmClient contains the handles to all the specific Azure Resource Manager
-// resource classes' respective clients.
-type ArmClient struct {
-	clientId                 string
-	tenantId                 string
-	subscriptionId           string
-	partnerId                string
-	usingServicePrincipal    bool
-	environment              azure.Environment
-	skipProviderRegistration bool
-
-	StopContext context.Context
-
-	// Services
-	analysisservices *analysisservices.Client
-	apiManagement    *apimanagement.Client
-	appInsights      *applicationinsights.Client
-	automation       *automation.Client
-	authorization    *authorization.Client
-	batch            *batch.Client
-	cdn              *cdn.Client
-	cognitive        *cognitive.Client
-	compute          *compute.Client
-	containers       *containers.Client
-	cosmos           *cosmos.Client
-	databricks       *databricks.Client
-	dataFactory      *datafactory.Client
-	datalake         *datalake.Client
-	devSpace         *devspace.Client
-	devTestLabs      *devtestlabs.Client
-	dns              *dns.Client
-	privateDns       *privatedns.Client
-	eventGrid        *eventgrid.Client
-	eventhub         *eventhub.Client
-	graph            *graph.Client
-	hdinsight        *hdinsight.Client
-	iothub           *iothub.Client
-	keyvault         *keyvault.Client
-	logAnalytics     *loganalytics.Client
-	logic            *logic.Client
-	managementGroups *managementgroup.Client
-	maps             *maps.Client
-	mariadb          *mariadb.Client
-	media            *media.Client
-	monitor          *monitor.Client
-	mysql            *mysql.Client
-	msi              *msi.Client
-	mssql            *mssql.Client
-	network          *network.Client
-	notificationHubs *notificationhub.Client
-	policy           *policy.Client
-	postgres         *postgres.Client
-	recoveryServices *recoveryservices.Client
-	redis            *redis.Client
-	relay            *relay.Client
-	resource         *resource.Client
-	scheduler        *scheduler.Client
-	search           *search.Client
-	securityCenter   *securitycenter.Client
-	servicebus       *servicebus.Client
-	serviceFabric    *servicefabric.Client
-	signalr          *signalr.Client
-	storage          *intStor.Client
-	streamanalytics  *streamanalytics.Client
-	subscription     *subscription.Client
-	sql              *sql.Client
-	trafficManager   *trafficmanager.Client
-	web              *web.Client
-
-	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsagesClient
-}
+// ArmClient is an alias of the Client defined in `internal/clients`, which allows the Data Sources
+// and Resources which haven't yet been migrated into a Service Package to continue using `meta.(*ArmClient)`
+type ArmClient = clients.Client
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
@@ -160,13 +33,12 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 
 	// client declarations:
 	client := ArmClient{
-		clientId:                 c.ClientID,
-		tenantId:                 c.TenantID,
-		subscriptionId:           c.SubscriptionID,
-		partnerId:                partnerId,
-		environment:              *env,
-		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
-		skipProviderRegistration: skipProviderRegistration,
+		ClientID:              c.ClientID,
+		TenantID:              c.TenantID,
+		SubscriptionID:        c.SubscriptionID,
+		Environment:           *env,
+		UsingServicePrincipal: c.AuthenticatedAsAServicePrincipal,
+		Features:              features.Default(),
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -231,112 +103,22 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		Environment:                 *env,
 	}
 
-	client.analysisservices = analysisservices.BuildClient(o)
-	client.apiManagement = apimanagement.BuildClient(o)
-	client.appInsights = applicationinsights.BuildClient(o)
-	client.automation = automation.BuildClient(o)
-	client.authorization = authorization.BuildClient(o)
-	client.batch = batch.BuildClient(o)
-	client.cdn = cdn.BuildClient(o)
-	client.cognitive = cognitive.BuildClient(o)
-	client.compute = compute.BuildClient(o)
-	client.containers = containers.BuildClient(o)
-	client.cosmos = cosmos.BuildClient(o)
-	client.databricks = databricks.BuildClient(o)
-	client.dataFactory = datafactory.BuildClient(o)
-	client.datalake = datalake.BuildClient(o)
-	client.devSpace = devspace.BuildClient(o)
-	client.devTestLabs = devtestlabs.BuildClient(o)
-	client.dns = dns.BuildClient(o)
-	client.eventGrid = eventgrid.BuildClient(o)
-	client.eventhub = eventhub.BuildClient(o)
-	client.graph = graph.BuildClient(o)
-	client.hdinsight = hdinsight.BuildClient(o)
-	client.iothub = iothub.BuildClient(o)
-	client.keyvault = keyvault.BuildClient(o)
-	client.logic = logic.BuildClient(o)
-	client.logAnalytics = loganalytics.BuildClient(o)
-	client.maps = maps.BuildClient(o)
-	client.mariadb = mariadb.BuildClient(o)
-	client.media = media.BuildClient(o)
-	client.monitor = monitor.BuildClient(o)
-	client.mssql = mssql.BuildClient(o)
-	client.msi = msi.BuildClient(o)
-	client.mysql = mysql.BuildClient(o)
-	client.managementGroups = managementgroup.BuildClient(o)
-	client.network = network.BuildClient(o)
-	client.notificationHubs = notificationhub.BuildClient(o)
-	client.policy = policy.BuildClient(o)
-	client.postgres = postgres.BuildClient(o)
-	client.privateDns = privatedns.BuildClient(o)
-	client.recoveryServices = recoveryservices.BuildClient(o)
-	client.redis = redis.BuildClient(o)
-	client.relay = relay.BuildClient(o)
-	client.resource = resource.BuildClient(o)
-	client.search = search.BuildClient(o)
-	client.securityCenter = securitycenter.BuildClient(o)
-	client.servicebus = servicebus.BuildClient(o)
-	client.serviceFabric = servicefabric.BuildClient(o)
-	client.scheduler = scheduler.BuildClient(o)
-	client.signalr = signalr.BuildClient(o)
-	client.streamanalytics = streamanalytics.BuildClient(o)
-	client.subscription = subscription.BuildClient(o)
-	client.sql = sql.BuildClient(o)
-	client.trafficManager = trafficmanager.BuildClient(o)
-	client.web = web.BuildClient(o)
+	client.Build(o)
 
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth, o)
+	// the Storage Client requires the Storage Accounts client, which it uses to look up the Account Keys
+	accountsClient := storage.NewAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
+	o.ConfigureClient(&accountsClient.Client, auth)
+	client.Storage = intStor.BuildClient(accountsClient, o)
 
 	return &client, nil
 }
 
-func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
-	setUserAgent(client, c.partnerId)
-	client.Authorizer = auth
-	client.RequestInspector = common.WithCorrelationRequestID(common.CorrelationRequestID())
-	client.Sender = sender.BuildSender("AzureRM")
-	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 180 * time.Minute
-}
-
-func setUserAgent(client *autorest.Client, partnerID string) {
-	// TODO: This is the SDK version not the CLI version, once we are on 0.12, should revisit
-	tfUserAgent := httpclient.UserAgentString()
-
-	pv := version.ProviderVersion
-	providerUserAgent := fmt.Sprintf("%s terraform-provider-azurerm/%s", tfUserAgent, pv)
-	client.UserAgent = strings.TrimSpace(fmt.Sprintf("%s %s", client.UserAgent, providerUserAgent))
-
-	// append the CloudShell version to the user agent if it exists
-	if azureAgent := os.Getenv("AZURE_HTTP_USER_AGENT"); azureAgent != "" {
-		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
-	}
-
-	if partnerID != "" {
-		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerID)
-	}
-
-	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
-}
-
-func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth autorest.Authorizer, options *common.ClientOptions) {
-	accountsClient := storage.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&accountsClient.Client, auth)
-	c.storageServiceClient = accountsClient
-
-	usageClient := storage.NewUsagesClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&usageClient.Client, auth)
-	c.storageUsageClient = usageClient
-
-	c.storage = intStor.BuildClient(accountsClient, options)
-}
-
 var (
 	storageKeyCacheMu sync.RWMutex
 	storageKeyCache   = make(map[string]string)
 )
 
-func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (string, bool, error) {
+func getKeyForStorageAccount(ctx context.Context, client *ArmClient, resourceGroupName, storageAccountName string) (string, bool, error) {
 	cacheIndex := resourceGroupName + "/" + storageAccountName
 	storageKeyCacheMu.RLock()
 	key, ok := storageKeyCache[cacheIndex]
@@ -350,7 +132,7 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	defer storageKeyCacheMu.Unlock()
 	key, ok = storageKeyCache[cacheIndex]
 	if !ok {
-		accountKeys, err := c.storageServiceClient.ListKeys(ctx, resourceGroupName, storageAccountName)
+		accountKeys, err := client.Storage.AccountsClient.ListKeys(ctx, resourceGroupName, storageAccountName)
 		if utils.ResponseWasNotFound(accountKeys.Response) {
 			return "", false, nil
 		}
@@ -381,8 +163,8 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	return key, true, nil
 }
 
-func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+func getBlobStorageClientForStorageAccount(ctx context.Context, client *ArmClient, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+	key, accountExists, err := getKeyForStorageAccount(ctx, client, resourceGroupName, storageAccountName)
 	if err != nil {
 		return nil, accountExists, err
 	}
@@ -390,7 +172,7 @@ func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, r
 		return nil, false, nil
 	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, client.Environment.StorageEndpointSuffix,
 		mainStorage.DefaultAPIVersion, true)
 	if err != nil {
 		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
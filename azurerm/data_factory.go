@@ -1,14 +1,21 @@
 package azurerm
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func validateAzureRMDataFactoryLinkedServiceDatasetName(v interface{}, k string) (warnings []string, errors []error) {
@@ -190,3 +197,292 @@ func flattenDataFactoryStructureColumns(input interface{}) []interface{} {
 	}
 	return output
 }
+
+func expandDataFactoryPipelineActivities(input string) (*[]datafactory.BasicActivity, error) {
+	// the Activities are polymorphic, so we unmarshal them as a part of the Pipeline to determine the concrete types
+	pipeline := datafactory.Pipeline{}
+	if err := pipeline.UnmarshalJSON([]byte(fmt.Sprintf(`{"activities":%s}`, input))); err != nil {
+		return nil, fmt.Errorf("Error parsing `activities_json`: %+v", err)
+	}
+
+	if pipeline.Activities == nil {
+		activities := make([]datafactory.BasicActivity, 0)
+		return &activities, nil
+	}
+
+	return pipeline.Activities, nil
+}
+
+func flattenDataFactoryPipelineActivities(input *[]datafactory.BasicActivity) (string, error) {
+	if input == nil || len(*input) == 0 {
+		return "", nil
+	}
+
+	activities, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("Error serializing `activities_json`: %+v", err)
+	}
+
+	return string(activities), nil
+}
+
+// azureRmDataFactoryJsonDiff compares JSON documents semantically - in addition to ignoring whitespace and
+// ordering of keys, the API omits null values and empty arrays/objects, so these are ignored too (and as
+// such an empty value, which is what's flattened when the API omits these, is equal to an empty array/object)
+func azureRmDataFactoryJsonDiff(_, old string, new string, _ *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	oldValue, err := unmarshalDataFactoryJson(old)
+	if err != nil {
+		return false
+	}
+	newValue, err := unmarshalDataFactoryJson(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizeDataFactoryJson(oldValue), normalizeDataFactoryJson(newValue))
+}
+
+func unmarshalDataFactoryJson(input string) (interface{}, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+
+	var output interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func normalizeDataFactoryJson(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, value := range v {
+			if normalized := normalizeDataFactoryJson(value); normalized != nil {
+				output[key] = normalized
+			}
+		}
+		if len(output) == 0 {
+			return nil
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0)
+		for _, value := range v {
+			output = append(output, normalizeDataFactoryJson(value))
+		}
+		if len(output) == 0 {
+			return nil
+		}
+		return output
+	}
+
+	return input
+}
+
+func expandDataFactoryTriggerPipelineReference(pipelineName string, parameters map[string]interface{}) *datafactory.TriggerPipelineReference {
+	return &datafactory.TriggerPipelineReference{
+		PipelineReference: &datafactory.PipelineReference{
+			ReferenceName: utils.String(pipelineName),
+			Type:          utils.String("PipelineReference"),
+		},
+		Parameters: parameters,
+	}
+}
+
+func flattenDataFactoryTriggerPipelineReference(input *datafactory.TriggerPipelineReference) (string, map[string]interface{}) {
+	pipelineName := ""
+	parameters := make(map[string]interface{})
+	if input == nil {
+		return pipelineName, parameters
+	}
+
+	if ref := input.PipelineReference; ref != nil && ref.ReferenceName != nil {
+		pipelineName = *ref.ReferenceName
+	}
+
+	for k, v := range input.Parameters {
+		// we only support string parameters at this time
+		val, ok := v.(string)
+		if !ok {
+			log.Printf("[DEBUG] Skipping pipeline parameter %q since it's not a string", k)
+			continue
+		}
+		parameters[k] = val
+	}
+
+	return pipelineName, parameters
+}
+
+// Triggers can't be updated or deleted whilst they're running - so these need to be stopped first
+func stopDataFactoryTrigger(ctx context.Context, client *datafactory.TriggersClient, resourceGroup string, dataFactoryName string, name string) error {
+	existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroup, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if dataFactoryTriggerRuntimeState(existing.Properties) != datafactory.TriggerRuntimeStateStarted {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Stopping Data Factory Trigger %q (Resource Group %q / Data Factory %q)..", name, resourceGroup, dataFactoryName)
+	future, err := client.Stop(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		return fmt.Errorf("Error stopping Data Factory Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroup, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Data Factory Trigger %q (Resource Group %q / Data Factory %q) to stop: %+v", name, resourceGroup, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	return nil
+}
+
+func startDataFactoryTrigger(ctx context.Context, client *datafactory.TriggersClient, resourceGroup string, dataFactoryName string, name string) error {
+	log.Printf("[DEBUG] Starting Data Factory Trigger %q (Resource Group %q / Data Factory %q)..", name, resourceGroup, dataFactoryName)
+	future, err := client.Start(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Data Factory Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroup, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Data Factory Trigger %q (Resource Group %q / Data Factory %q) to start: %+v", name, resourceGroup, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	return nil
+}
+
+func dataFactoryTriggerRuntimeState(input datafactory.BasicTrigger) datafactory.TriggerRuntimeState {
+	if input == nil {
+		return ""
+	}
+
+	if v, ok := input.AsScheduleTrigger(); ok && v != nil {
+		return v.RuntimeState
+	}
+
+	if v, ok := input.AsTumblingWindowTrigger(); ok && v != nil {
+		return v.RuntimeState
+	}
+
+	return ""
+}
+
+func parseDataFactoryTriggerTime(input string) (*date.Time, error) {
+	t, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date.Time{Time: t}, nil
+}
+
+func expandDataFactoryDatasetLinkedService(linkedServiceName string) *datafactory.LinkedServiceReference {
+	return &datafactory.LinkedServiceReference{
+		ReferenceName: utils.String(linkedServiceName),
+		Type:          utils.String("LinkedServiceReference"),
+	}
+}
+
+// expandDataFactoryDatasetLocation returns the storage location of a file-based Dataset, which is either
+// the `http_server_location` or the `azure_blob_storage_location` block
+func expandDataFactoryDatasetLocation(d *schema.ResourceData) *datafactory.DatasetLocation {
+	if v, ok := d.GetOk("http_server_location"); ok {
+		props := v.([]interface{})[0].(map[string]interface{})
+		return &datafactory.DatasetLocation{
+			Type:       utils.String("HttpServerLocation"),
+			FolderPath: props["path"].(string),
+			FileName:   props["filename"].(string),
+			AdditionalProperties: map[string]interface{}{
+				"relativeUrl": props["relative_url"].(string),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("azure_blob_storage_location"); ok {
+		props := v.([]interface{})[0].(map[string]interface{})
+		return &datafactory.DatasetLocation{
+			Type:       utils.String("AzureBlobStorageLocation"),
+			FolderPath: props["path"].(string),
+			FileName:   props["filename"].(string),
+			AdditionalProperties: map[string]interface{}{
+				"container": props["container"].(string),
+			},
+		}
+	}
+
+	return nil
+}
+
+// flattenDataFactoryDatasetLocation sets the `http_server_location` or `azure_blob_storage_location` block
+// depending on the type of the storage location
+func flattenDataFactoryDatasetLocation(d *schema.ResourceData, input *datafactory.DatasetLocation) error {
+	httpServerLocation := make([]interface{}, 0)
+	azureBlobStorageLocation := make([]interface{}, 0)
+
+	if input != nil && input.Type != nil {
+		path := ""
+		if v, ok := input.FolderPath.(string); ok {
+			path = v
+		}
+		filename := ""
+		if v, ok := input.FileName.(string); ok {
+			filename = v
+		}
+
+		switch *input.Type {
+		case "HttpServerLocation":
+			relativeUrl := ""
+			if v, ok := input.AdditionalProperties["relativeUrl"].(string); ok {
+				relativeUrl = v
+			}
+			httpServerLocation = append(httpServerLocation, map[string]interface{}{
+				"relative_url": relativeUrl,
+				"path":         path,
+				"filename":     filename,
+			})
+
+		case "AzureBlobStorageLocation":
+			container := ""
+			if v, ok := input.AdditionalProperties["container"].(string); ok {
+				container = v
+			}
+			azureBlobStorageLocation = append(azureBlobStorageLocation, map[string]interface{}{
+				"container": container,
+				"path":      path,
+				"filename":  filename,
+			})
+
+		default:
+			log.Printf("[DEBUG] Skipping Dataset Location of type %q since it's not supported", *input.Type)
+		}
+	}
+
+	if err := d.Set("http_server_location", httpServerLocation); err != nil {
+		return fmt.Errorf("Error setting `http_server_location`: %+v", err)
+	}
+
+	if err := d.Set("azure_blob_storage_location", azureBlobStorageLocation); err != nil {
+		return fmt.Errorf("Error setting `azure_blob_storage_location`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandDataFactorySecureString(input string) *datafactory.SecureString {
+	return &datafactory.SecureString{
+		Value: utils.String(input),
+		Type:  datafactory.TypeSecureString,
+	}
+}
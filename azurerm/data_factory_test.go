@@ -1,6 +1,13 @@
 package azurerm
 
-import "testing"
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
 
 func TestAzureRmDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	cases := []struct {
@@ -38,3 +45,143 @@ func TestAzureRmDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestAzureRmDataFactoryJsonDiff(t *testing.T) {
+	cases := []struct {
+		Old    string
+		New    string
+		NoDiff bool
+	}{
+		{
+			Old:    "",
+			New:    "",
+			NoDiff: true,
+		},
+		{
+			Old:    "",
+			New:    "[]",
+			NoDiff: true,
+		},
+		{
+			Old:    "[]",
+			New:    "",
+			NoDiff: true,
+		},
+		{
+			Old:    "",
+			New:    `[{"name":"test","type":"Wait","typeProperties":{"waitTimeInSeconds":5}}]`,
+			NoDiff: false,
+		},
+		{
+			Old:    `[{"name":"test","type":"Wait","typeProperties":{"waitTimeInSeconds":5}}]`,
+			New:    `[ { "type": "Wait", "name": "test", "typeProperties": { "waitTimeInSeconds": 5 } } ]`,
+			NoDiff: true,
+		},
+		{
+			Old:    `[{"name":"test","type":"Wait","dependsOn":[],"userProperties":[],"description":null,"typeProperties":{"waitTimeInSeconds":5}}]`,
+			New:    `[{"name":"test","type":"Wait","typeProperties":{"waitTimeInSeconds":5}}]`,
+			NoDiff: true,
+		},
+		{
+			Old:    `[{"name":"test","type":"Wait","typeProperties":{"waitTimeInSeconds":5}}]`,
+			New:    `[{"name":"test","type":"Wait","typeProperties":{"waitTimeInSeconds":10}}]`,
+			NoDiff: false,
+		},
+		{
+			Old:    `[{"name":"test","type":"Wait"}]`,
+			New:    `not json`,
+			NoDiff: false,
+		},
+	}
+
+	for _, tc := range cases {
+		noDiff := azureRmDataFactoryJsonDiff("", tc.Old, tc.New, nil)
+
+		if noDiff != tc.NoDiff {
+			t.Fatalf("Expected azureRmDataFactoryJsonDiff to be '%t' for '%s' '%s' - got '%t'", tc.NoDiff, tc.Old, tc.New, noDiff)
+		}
+	}
+}
+
+func TestDataFactoryPipelineActivitiesRoundTrip(t *testing.T) {
+	input := `[{"name":"Append variable1","type":"AppendVariable","typeProperties":{"variableName":"bob","value":"something"}}]`
+
+	activities, err := expandDataFactoryPipelineActivities(input)
+	if err != nil {
+		t.Fatalf("Error expanding activities: %+v", err)
+	}
+
+	if activities == nil || len(*activities) != 1 {
+		t.Fatalf("Expected 1 activity but got %+v", activities)
+	}
+
+	if _, ok := (*activities)[0].AsAppendVariableActivity(); !ok {
+		t.Fatalf("Expected the activity to be an AppendVariableActivity")
+	}
+
+	output, err := flattenDataFactoryPipelineActivities(activities)
+	if err != nil {
+		t.Fatalf("Error flattening activities: %+v", err)
+	}
+
+	if !strings.Contains(output, `"variableName":"bob"`) {
+		t.Fatalf("Expected the flattened activities to contain the variable name but got %q", output)
+	}
+
+	if !azureRmDataFactoryJsonDiff("", output, input, nil) {
+		t.Fatalf("Expected no diff between %q and %q", input, output)
+	}
+}
+
+func TestDataFactoryDatasetJSONSerialization(t *testing.T) {
+	location := &datafactory.DatasetLocation{
+		Type:       utils.String("AzureBlobStorageLocation"),
+		FolderPath: "foo/bar",
+		FileName:   "example.json",
+		AdditionalProperties: map[string]interface{}{
+			"container": "content",
+		},
+	}
+
+	dataset := datafactory.Dataset{
+		AdditionalProperties: map[string]interface{}{
+			"type": dataFactoryDatasetTypeJSON,
+			"typeProperties": map[string]interface{}{
+				"location": location,
+			},
+		},
+	}
+
+	body, err := json.Marshal(datafactory.DatasetResource{Properties: &dataset})
+	if err != nil {
+		t.Fatalf("Error serializing Dataset: %+v", err)
+	}
+
+	var resource datafactory.DatasetResource
+	if err := json.Unmarshal(body, &resource); err != nil {
+		t.Fatalf("Error deserializing Dataset: %+v", err)
+	}
+
+	actual, ok := resource.Properties.AsDataset()
+	if !ok || actual.Type != dataFactoryDatasetTypeJSON {
+		t.Fatalf("Expected a Dataset of type %q but got %s", dataFactoryDatasetTypeJSON, string(body))
+	}
+
+	properties, ok := actual.AdditionalProperties["typeProperties"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected `typeProperties` to be returned as an Additional Property but got %+v", actual.AdditionalProperties)
+	}
+
+	parsed, err := parseDataFactoryDatasetJSONLocation(properties["location"])
+	if err != nil {
+		t.Fatalf("Error parsing location: %+v", err)
+	}
+
+	if parsed.Type == nil || *parsed.Type != "AzureBlobStorageLocation" {
+		t.Fatalf("Expected the location type to be %q but got %+v", "AzureBlobStorageLocation", parsed.Type)
+	}
+
+	if parsed.FileName != "example.json" || parsed.AdditionalProperties["container"] != "content" {
+		t.Fatalf("Expected the location to round-trip but got %+v", parsed)
+	}
+}
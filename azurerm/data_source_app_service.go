@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -132,7 +133,7 @@ func dataSourceArmAppService() *schema.Resource {
 	}
 }
 func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.AppServicesClient
+	client := meta.(*ArmClient).Web.AppServicesClient
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -143,12 +144,12 @@ func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: App Service %q (Resource Group %q) was not found", name, resourceGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	configResp, err := client.GetConfiguration(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	appSettingsResp, err := client.ListApplicationSettings(ctx, resourceGroup, name)
@@ -158,12 +159,12 @@ func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error
 
 	connectionStringsResp, err := client.ListConnectionStrings(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	scmResp, err := client.GetSourceControl(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, resourceGroup, name)
@@ -176,7 +177,7 @@ func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error
 	}
 	siteCredResp, err := siteCredFuture.Result(*client)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -220,7 +221,7 @@ func dataSourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,7 +29,7 @@ func dataSourceArmApplicationSecurityGroup() *schema.Resource {
 }
 
 func dataSourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.ApplicationSecurityGroupsClient
+	client := meta.(*ArmClient).Network.ApplicationSecurityGroupsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -40,7 +41,7 @@ func dataSourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta inte
 			return fmt.Errorf("Error: Application Security Group %q (Resource Group %q) was not found", name, resourceGroup)
 		}
 
-		return fmt.Errorf("Error making Read request on Application Security Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Application Security Group %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -51,7 +52,7 @@ func dataSourceArmApplicationSecurityGroupRead(d *schema.ResourceData, meta inte
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
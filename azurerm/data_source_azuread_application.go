@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -74,7 +75,7 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 }
 
 func dataSourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ApplicationsClient
+	client := meta.(*ArmClient).Graph.ApplicationsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	var application graphrbac.Application
@@ -89,7 +90,7 @@ func dataSourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{
 				return fmt.Errorf("Error: AzureAD Application with ID %q was not found", objectId)
 			}
 
-			return fmt.Errorf("Error making Read request on AzureAD Application with ID %q: %+v", objectId, err)
+			return fmt.Errorf("Error making Read request on AzureAD Application with ID %q: %+v", objectId, tf.WrapArmError(err))
 		}
 
 		application = resp
@@ -103,7 +104,7 @@ func dataSourceArmAzureADApplicationRead(d *schema.ResourceData, meta interface{
 		resp, err := client.ListComplete(ctx, filter)
 
 		if err != nil {
-			return fmt.Errorf("Error listing Azure AD Applications: %+v", err)
+			return fmt.Errorf("Error listing Azure AD Applications: %+v", tf.WrapArmError(err))
 		}
 
 		var app *graphrbac.Application
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -45,7 +46,7 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 }
 
 func dataSourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	var servicePrincipal *graphrbac.ServicePrincipal
@@ -60,7 +61,7 @@ func dataSourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, me
 				return fmt.Errorf("Service Principal with Object ID %q was not found!", objectId)
 			}
 
-			return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+			return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, tf.WrapArmError(err))
 		}
 
 		servicePrincipal = &app
@@ -74,7 +75,7 @@ func dataSourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, me
 
 		apps, err := client.ListComplete(ctx, filter)
 		if err != nil {
-			return fmt.Errorf("Error listing Service Principals: %+v", err)
+			return fmt.Errorf("Error listing Service Principals: %+v", tf.WrapArmError(err))
 		}
 
 		for _, app := range *apps.Response().Value {
@@ -101,7 +102,7 @@ func dataSourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, me
 
 		apps, err := client.ListComplete(ctx, filter)
 		if err != nil {
-			return fmt.Errorf("Error listing Service Principals: %+v", err)
+			return fmt.Errorf("Error listing Service Principals: %+v", tf.WrapArmError(err))
 		}
 
 		for _, app := range *apps.Response().Value {
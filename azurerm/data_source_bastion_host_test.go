@@ -0,0 +1,45 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMBastionHost_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_bastion_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBastionHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMBastionHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "dns_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ip_configuration.0.subnet_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ip_configuration.0.public_ip_address_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMBastionHost_basic(rInt int, location string) string {
+	config := testAccAzureRMBastionHost_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_bastion_host" "test" {
+  name                = "${azurerm_bastion_host.test.name}"
+  resource_group_name = "${azurerm_bastion_host.test.resource_group_name}"
+}
+`, config)
+}
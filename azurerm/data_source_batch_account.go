@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -66,7 +67,7 @@ func dataSourceArmBatchAccount() *schema.Resource {
 }
 
 func dataSourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.AccountClient
+	client := meta.(*ArmClient).Batch.AccountClient
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -77,7 +78,7 @@ func dataSourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) err
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Batch account %q (Resource Group %q) was not found", name, resourceGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Batch account %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Batch account %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -101,7 +102,7 @@ func dataSourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) err
 			keys, err := client.GetKeys(ctx, resourceGroup, name)
 
 			if err != nil {
-				return fmt.Errorf("Cannot read keys for Batch account %q (resource group %q): %v", name, resourceGroup, err)
+				return fmt.Errorf("Cannot read keys for Batch account %q (resource group %q): %v", name, resourceGroup, tf.WrapArmError(err))
 			}
 
 			d.Set("primary_access_key", keys.Primary)
@@ -116,7 +117,7 @@ func dataSourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -50,7 +51,7 @@ func dataSourceArmBatchCertificate() *schema.Resource {
 
 func dataSourceArmBatchCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batch.CertificateClient
+	client := meta.(*ArmClient).Batch.CertificateClient
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	accountName := d.Get("account_name").(string)
@@ -61,7 +62,7 @@ func dataSourceArmBatchCertificateRead(d *schema.ResourceData, meta interface{})
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Batch certificate %q (Account %q / Resource Group %q) was not found", name, accountName, resourceGroupName)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Batch certificate %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Batch certificate %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
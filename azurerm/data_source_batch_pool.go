@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -252,7 +253,7 @@ func dataSourceArmBatchPool() *schema.Resource {
 }
 
 func dataSourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.PoolClient
+	client := meta.(*ArmClient).Batch.PoolClient
 
 	name := d.Get("name").(string)
 	accountName := d.Get("account_name").(string)
@@ -264,7 +265,7 @@ func dataSourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Batch pool %q in account %q (Resource Group %q) was not found", name, accountName, resourceGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Batch pool %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM Batch pool %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func dataSourceArmBuiltInRoleDefinition() *schema.Resource {
@@ -81,7 +82,7 @@ As such this Data Source will be removed in v2.0 of the AzureRM Provider.
 }
 
 func dataSourceArmBuiltInRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).authorization.RoleDefinitionsClient
+	client := meta.(*ArmClient).Authorization.RoleDefinitionsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -91,7 +92,7 @@ func dataSourceArmBuiltInRoleDefinitionRead(d *schema.ResourceData, meta interfa
 	filter := fmt.Sprintf("roleName eq '%s'", name)
 	roleDefinitions, err := client.List(ctx, "", filter)
 	if err != nil {
-		return fmt.Errorf("Error loading Role Definition List: %+v", err)
+		return fmt.Errorf("Error loading Role Definition List: %+v", tf.WrapArmError(err))
 	}
 	if len(roleDefinitions.Values()) != 1 {
 		return fmt.Errorf("Error loading Role Definition List: could not find role '%s'", name)
@@ -103,7 +104,7 @@ func dataSourceArmBuiltInRoleDefinitionRead(d *schema.ResourceData, meta interfa
 
 	role, err := client.GetByID(ctx, roleDefinitionId)
 	if err != nil {
-		return fmt.Errorf("Error loading Role Definition: %+v", err)
+		return fmt.Errorf("Error loading Role Definition: %+v", tf.WrapArmError(err))
 	}
 
 	if props := role.RoleDefinitionProperties; props != nil {
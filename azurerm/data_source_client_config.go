@@ -42,11 +42,11 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 	ctx := meta.(*ArmClient).StopContext
 
 	var servicePrincipal *graphrbac.ServicePrincipal
-	if client.usingServicePrincipal {
-		spClient := client.graph.ServicePrincipalsClient
+	if client.UsingServicePrincipal {
+		spClient := client.Graph.ServicePrincipalsClient
 		// Application & Service Principal is 1:1 per tenant. Since we know the appId (client_id)
 		// here, we can query for the Service Principal whose appId matches.
-		filter := fmt.Sprintf("appId eq '%s'", client.clientId)
+		filter := fmt.Sprintf("appId eq '%s'", client.ClientID)
 		listResult, listErr := spClient.List(ctx, filter)
 
 		if listErr != nil {
@@ -61,9 +61,9 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(time.Now().UTC().String())
-	d.Set("client_id", client.clientId)
-	d.Set("tenant_id", client.tenantId)
-	d.Set("subscription_id", client.subscriptionId)
+	d.Set("client_id", client.ClientID)
+	d.Set("tenant_id", client.TenantID)
+	d.Set("subscription_id", client.SubscriptionID)
 
 	if principal := servicePrincipal; principal != nil {
 		d.Set("service_principal_application_id", principal.AppID)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -60,7 +61,7 @@ func dataSourceArmContainerRegistry() *schema.Resource {
 }
 
 func dataSourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.RegistriesClient
+	client := meta.(*ArmClient).Containers.RegistriesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -72,7 +73,7 @@ func dataSourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Container Registry %q was not found in Resource Group %q", name, resourceGroup)
 		}
 
-		return fmt.Errorf("Error making Read request on Azure Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure Container Registry %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -95,7 +96,7 @@ func dataSourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}
 	if *resp.AdminUserEnabled {
 		credsResp, err := client.ListCredentials(ctx, resourceGroup, name)
 		if err != nil {
-			return fmt.Errorf("Error making Read request on Azure Container Registry %s for Credentials: %s", name, err)
+			return fmt.Errorf("Error making Read request on Azure Container Registry %s for Credentials: %s", name, tf.WrapArmError(err))
 		}
 
 		d.Set("admin_username", credsResp.Username)
@@ -108,7 +109,7 @@ func dataSourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}
 		d.Set("admin_password", "")
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
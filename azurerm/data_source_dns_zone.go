@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -67,7 +68,7 @@ func dataSourceArmDnsZone() *schema.Resource {
 }
 
 func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).dns.ZonesClient
+	client := meta.(*ArmClient).Dns.ZonesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -83,10 +84,10 @@ func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 			if utils.ResponseWasNotFound(resp.Response) {
 				return fmt.Errorf("Error: DNS Zone %q (Resource Group %q) was not found", name, resourceGroup)
 			}
-			return fmt.Errorf("Error reading DNS Zone %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error reading DNS Zone %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 		}
 	} else {
-		rgClient := meta.(*ArmClient).resource.GroupsClient
+		rgClient := meta.(*ArmClient).Resource.GroupsClient
 
 		resp, resourceGroup, err = findZone(client, rgClient, ctx, name)
 		if err != nil {
@@ -136,7 +137,7 @@ func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -144,7 +145,7 @@ func dataSourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 func findZone(client *dns.ZonesClient, rgClient *resources.GroupsClient, ctx context.Context, name string) (dns.Zone, string, error) {
 	groups, err := rgClient.List(ctx, "", nil)
 	if err != nil {
-		return dns.Zone{}, "", fmt.Errorf("Error listing Resource Groups: %+v", err)
+		return dns.Zone{}, "", fmt.Errorf("Error listing Resource Groups: %+v", tf.WrapArmError(err))
 	}
 
 	for _, g := range groups.Values() {
@@ -152,7 +153,7 @@ func findZone(client *dns.ZonesClient, rgClient *resources.GroupsClient, ctx con
 
 		zones, err := client.ListByResourceGroup(ctx, resourceGroup, nil)
 		if err != nil {
-			return dns.Zone{}, "", fmt.Errorf("Error listing DNS Zones (Resource Group: %s): %+v", resourceGroup, err)
+			return dns.Zone{}, "", fmt.Errorf("Error listing DNS Zones (Resource Group: %s): %+v", resourceGroup, tf.WrapArmError(err))
 		}
 
 		for _, z := range zones.Values() {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -114,7 +115,7 @@ func dataSourceArmImage() *schema.Resource {
 }
 
 func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).compute.ImagesClient
+	client := meta.(*ArmClient).Compute.ImagesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resGroup := d.Get("resource_group_name").(string)
@@ -134,7 +135,7 @@ func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 			if utils.ResponseWasNotFound(img.Response) {
 				return fmt.Errorf("Error: Image %q (Resource Group %q) was not found", name, resGroup)
 			}
-			return fmt.Errorf("[ERROR] Error making Read request on Azure Image %q (resource group %q): %+v", name, resGroup, err)
+			return fmt.Errorf("[ERROR] Error making Read request on Azure Image %q (resource group %q): %+v", name, resGroup, tf.WrapArmError(err))
 		}
 	} else {
 		r := regexp.MustCompile(nameRegex.(string))
@@ -145,7 +146,7 @@ func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 			if utils.ResponseWasNotFound(resp.Response().Response) {
 				return fmt.Errorf("Error: Unable to list images for Resource Group %q", resGroup)
 			}
-			return fmt.Errorf("[ERROR] Error getting list of images (resource group %q): %+v", resGroup, err)
+			return fmt.Errorf("[ERROR] Error getting list of images (resource group %q): %+v", resGroup, tf.WrapArmError(err))
 		}
 
 		for resp.NotDone() {
@@ -201,7 +202,7 @@ func dataSourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("zone_resilient", profile.ZoneResilient)
 	}
 
-	flattenAndSetTags(d, img.Tags)
+	flattenAndSetTags(d, img.Tags, meta)
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -38,7 +39,7 @@ func dataSourceArmKubernetesServiceVersions() *schema.Resource {
 }
 
 func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.ServicesClient
+	client := meta.(*ArmClient).Containers.ServicesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -48,12 +49,12 @@ func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta int
 		if utils.ResponseWasNotFound(listResp.Response) {
 			return fmt.Errorf("Error: No Kubernetes Service versions found for location %q", location)
 		}
-		return fmt.Errorf("Error retrieving Kubernetes Versions in %q: %+v", location, err)
+		return fmt.Errorf("Error retrieving Kubernetes Versions in %q: %+v", location, tf.WrapArmError(err))
 	}
 
 	lv, err := version.NewVersion("0.0.0")
 	if err != nil {
-		return fmt.Errorf("Cannot set version baseline (likely an issue in go-version): %+v", err)
+		return fmt.Errorf("Cannot set version baseline (likely an issue in go-version): %+v", tf.WrapArmError(err))
 	}
 
 	var versions []string
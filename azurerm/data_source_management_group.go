@@ -5,6 +5,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -39,7 +40,7 @@ func dataSourceArmManagementGroup() *schema.Resource {
 }
 
 func dataSourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementGroups.GroupsClient
+	client := meta.(*ArmClient).ManagementGroups.GroupsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	groupId := d.Get("group_id").(string)
@@ -51,7 +52,7 @@ func dataSourceArmManagementGroupRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Management Group %q was not found", groupId)
 		}
 
-		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), tf.WrapArmError(err))
 	}
 
 	if resp.ID == nil {
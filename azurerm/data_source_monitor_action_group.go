@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -90,7 +91,7 @@ func dataSourceArmMonitorActionGroup() *schema.Resource {
 }
 
 func dataSourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitor.ActionGroupsClient
+	client := meta.(*ArmClient).Monitor.ActionGroupsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -101,7 +102,7 @@ func dataSourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Action Group %q (Resource Group %q) was not found", name, resourceGroup)
 		}
-		return fmt.Errorf("Error making Read request on Action Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Action Group %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 	d.SetId(*resp.ID)
 
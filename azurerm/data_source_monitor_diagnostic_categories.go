@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
@@ -37,7 +38,7 @@ func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
 }
 
 func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
-	categoriesClient := meta.(*ArmClient).monitor.DiagnosticSettingsCategoryClient
+	categoriesClient := meta.(*ArmClient).Monitor.DiagnosticSettingsCategoryClient
 	ctx := meta.(*ArmClient).StopContext
 
 	actualResourceId := d.Get("resource_id").(string)
@@ -47,7 +48,7 @@ func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta i
 	// then retrieve the possible Diagnostics Categories for this Resource
 	categories, err := categoriesClient.List(ctx, resourceId)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: %+v", actualResourceId, err)
+		return fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: %+v", actualResourceId, tf.WrapArmError(err))
 	}
 
 	if categories.Value == nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -59,7 +60,7 @@ func dataSourceArmMonitorLogProfile() *schema.Resource {
 }
 
 func dataSourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitor.LogProfilesClient
+	client := meta.(*ArmClient).Monitor.LogProfilesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -68,7 +69,7 @@ func dataSourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Log Profile %q was not found", name)
 		}
-		return fmt.Errorf("Error reading Log Profile: %+v", err)
+		return fmt.Errorf("Error reading Log Profile: %+v", tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
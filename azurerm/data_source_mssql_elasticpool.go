@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -58,7 +59,7 @@ func dataSourceArmMsSqlElasticpool() *schema.Resource {
 }
 
 func dataSourceArmMsSqlElasticpoolRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mssql.ElasticPoolsClient
+	client := meta.(*ArmClient).Mssql.ElasticPoolsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -71,7 +72,7 @@ func dataSourceArmMsSqlElasticpoolRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error: Elasticpool %q (Resource Group %q, SQL Server %q) was not found", elasticPoolName, resourceGroup, serverName)
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Elasticpool %s (Resource Group %q, SQL Server %q): %+v", elasticPoolName, resourceGroup, serverName, err)
+		return fmt.Errorf("Error making Read request on AzureRM Elasticpool %s (Resource Group %q, SQL Server %q): %+v", elasticPoolName, resourceGroup, serverName, tf.WrapArmError(err))
 	}
 
 	if id := resp.ID; id != nil {
@@ -84,7 +85,7 @@ func dataSourceArmMsSqlElasticpoolRead(d *schema.ResourceData, meta interface{})
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	if props := resp.ElasticPoolProperties; props != nil {
 		d.Set("max_size_gb", float64(*props.MaxSizeBytes/int64(1073741824)))
@@ -98,7 +99,7 @@ func dataSourceArmMsSqlElasticpoolRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
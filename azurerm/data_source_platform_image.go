@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -38,7 +39,7 @@ func dataSourceArmPlatformImage() *schema.Resource {
 }
 
 func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).compute.VMImageClient
+	client := meta.(*ArmClient).Compute.VMImageClient
 	ctx := meta.(*ArmClient).StopContext
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
@@ -48,7 +49,7 @@ func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) er
 
 	result, err := client.List(ctx, location, publisher, offer, sku, "", utils.Int32(int32(1000)), "name")
 	if err != nil {
-		return fmt.Errorf("Error reading Platform Images: %+v", err)
+		return fmt.Errorf("Error reading Platform Images: %+v", tf.WrapArmError(err))
 	}
 
 	// the last value is the latest, apparently.
@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
@@ -56,7 +57,7 @@ func dataSourceArmPolicyDefinition() *schema.Resource {
 }
 
 func dataSourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.DefinitionsClient
+	client := meta.(*ArmClient).Policy.DefinitionsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("display_name").(string)
@@ -72,7 +73,7 @@ func dataSourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error loading Policy Definition List: %+v", err)
+		return fmt.Errorf("Error loading Policy Definition List: %+v", tf.WrapArmError(err))
 	}
 
 	var policyDefinition policy.Definition
@@ -86,7 +87,7 @@ func dataSourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{})
 
 		err = policyDefinitions.NextWithContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error loading Policy Definition List: %s", err)
+			return fmt.Errorf("Error loading Policy Definition List: %s", tf.WrapArmError(err))
 		}
 	}
 
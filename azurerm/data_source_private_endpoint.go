@@ -0,0 +1,140 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"private_connection_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"request_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateEndpointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Private Endpoint %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Endpoint %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	networkInterfaceId := ""
+	privateIpAddress := ""
+	if props := resp.PrivateEndpointProperties; props != nil {
+		if props.Subnet != nil {
+			d.Set("subnet_id", props.Subnet.ID)
+		}
+
+		connections := flattenArmPrivateEndpointServiceConnections(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections)
+		if err := d.Set("private_service_connection", connections); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+
+		if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
+			nic := (*nics)[0]
+			if nic.ID != nil {
+				networkInterfaceId = *nic.ID
+				privateIpAddress, err = retrievePrivateEndpointIPAddress(ctx, meta, networkInterfaceId)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	d.Set("network_interface_id", networkInterfaceId)
+	d.Set("private_ip_address", privateIpAddress)
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
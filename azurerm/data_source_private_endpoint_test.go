@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMPrivateEndpoint_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "subnet_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_service_connection.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_service_connection.0.status", "Approved"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMPrivateEndpoint_basic(rInt int, location string) string {
+	config := testAccAzureRMPrivateEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_endpoint" "test" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+}
+`, config)
+}
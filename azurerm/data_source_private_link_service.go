@@ -0,0 +1,240 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmPrivateLinkService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateLinkServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"load_balancer_frontend_ip_configuration_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"nat_ip_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"auto_approval_subscription_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"visibility_subscription_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"fqdns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"private_endpoint_connection": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmPrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Private Link Service %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Link Service %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil {
+		d.Set("alias", props.Alias)
+
+		if err := d.Set("nat_ip_configuration", flattenArmPrivateLinkServiceIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `nat_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("load_balancer_frontend_ip_configuration_ids", flattenArmPrivateLinkServiceFrontendIPConfigurations(props.LoadBalancerFrontendIPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `load_balancer_frontend_ip_configuration_ids`: %+v", err)
+		}
+
+		var autoApprovalSubscriptions *[]string
+		if props.AutoApproval != nil {
+			autoApprovalSubscriptions = props.AutoApproval.Subscriptions
+		}
+		if err := d.Set("auto_approval_subscription_ids", utils.FlattenStringSlice(autoApprovalSubscriptions)); err != nil {
+			return fmt.Errorf("Error setting `auto_approval_subscription_ids`: %+v", err)
+		}
+
+		var visibilitySubscriptions *[]string
+		if props.Visibility != nil {
+			visibilitySubscriptions = props.Visibility.Subscriptions
+		}
+		if err := d.Set("visibility_subscription_ids", utils.FlattenStringSlice(visibilitySubscriptions)); err != nil {
+			return fmt.Errorf("Error setting `visibility_subscription_ids`: %+v", err)
+		}
+
+		if err := d.Set("fqdns", utils.FlattenStringSlice(props.Fqdns)); err != nil {
+			return fmt.Errorf("Error setting `fqdns`: %+v", err)
+		}
+
+		networkInterfaceIds := make([]interface{}, 0)
+		if nics := props.NetworkInterfaces; nics != nil {
+			for _, nic := range *nics {
+				if nic.ID != nil {
+					networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+				}
+			}
+		}
+		if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+
+		if err := d.Set("private_endpoint_connection", flattenArmPrivateLinkServiceEndpointConnections(props.PrivateEndpointConnections)); err != nil {
+			return fmt.Errorf("Error setting `private_endpoint_connection`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func flattenArmPrivateLinkServiceEndpointConnections(input *[]network.PrivateEndpointConnection) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateEndpointId := ""
+		status := ""
+		description := ""
+		if props := item.PrivateEndpointConnectionProperties; props != nil {
+			if props.PrivateEndpoint != nil && props.PrivateEndpoint.ID != nil {
+				privateEndpointId = *props.PrivateEndpoint.ID
+			}
+			if state := props.PrivateLinkServiceConnectionState; state != nil {
+				if state.Status != nil {
+					status = *state.Status
+				}
+				if state.Description != nil {
+					description = *state.Description
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                name,
+			"private_endpoint_id": privateEndpointId,
+			"status":              status,
+			"description":         description,
+		})
+	}
+
+	return results
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func dataSourceArmPublicIPs() *schema.Resource {
@@ -71,7 +72,7 @@ func dataSourceArmPublicIPs() *schema.Resource {
 }
 
 func dataSourceArmPublicIPsRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.PublicIPsClient
+	client := meta.(*ArmClient).Network.PublicIPsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -79,7 +80,7 @@ func dataSourceArmPublicIPsRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading Public IP's in Resource Group %q", resourceGroup)
 	resp, err := client.List(ctx, resourceGroup)
 	if err != nil {
-		return fmt.Errorf("Error listing Public IP Addresses in the Resource Group %q: %v", resourceGroup, err)
+		return fmt.Errorf("Error listing Public IP Addresses in the Resource Group %q: %v", resourceGroup, tf.WrapArmError(err))
 	}
 
 	filteredIPAddresses := make([]network.PublicIPAddress, 0)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -194,7 +195,7 @@ func dataSourceArmRedisCache() *schema.Resource {
 
 func dataSourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).redis.Client
+	client := meta.(*ArmClient).Redis.Client
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -204,7 +205,7 @@ func dataSourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Redis instance %q (Resource group %q) was not found", name, resourceGroup)
 		}
-		return fmt.Errorf("Error reading the state of Redis instance %q: %+v", name, err)
+		return fmt.Errorf("Error reading the state of Redis instance %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -245,7 +246,7 @@ func dataSourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `redis_configuration`: %+v", err)
 	}
 
-	patchSchedulesClient := meta.(*ArmClient).redis.PatchSchedulesClient
+	patchSchedulesClient := meta.(*ArmClient).Redis.PatchSchedulesClient
 
 	schedule, err := patchSchedulesClient.Get(ctx, resourceGroup, name)
 	if err == nil {
@@ -265,7 +266,7 @@ func dataSourceArmRedisCacheRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("primary_access_key", keys.PrimaryKey)
 	d.Set("secondary_access_key", keys.SecondaryKey)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 
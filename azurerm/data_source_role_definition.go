@@ -4,9 +4,9 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func dataSourceArmRoleDefinition() *schema.Resource {
@@ -100,7 +100,7 @@ func dataSourceArmRoleDefinition() *schema.Resource {
 }
 
 func dataSourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).authorization.RoleDefinitionsClient
+	client := meta.(*ArmClient).Authorization.RoleDefinitionsClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -121,7 +121,7 @@ func dataSourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) e
 
 		roleDefinitions, err := client.List(ctx, scope, fmt.Sprintf("roleName eq '%s'", name))
 		if err != nil {
-			return fmt.Errorf("Error loading Role Definition List: %+v", err)
+			return fmt.Errorf("Error loading Role Definition List: %+v", tf.WrapArmError(err))
 		}
 		if len(roleDefinitions.Values()) != 1 {
 			return fmt.Errorf("Error loading Role Definition List: could not find role '%s'", name)
@@ -133,13 +133,13 @@ func dataSourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) e
 		defId = *roleDefinitions.Values()[0].ID
 		role, err = client.GetByID(ctx, defId)
 		if err != nil {
-			return fmt.Errorf("Error Getting Role Definition by ID %s: %+v", defId, err)
+			return fmt.Errorf("Error Getting Role Definition by ID %s: %+v", defId, tf.WrapArmError(err))
 		}
 	} else {
 		var err error
 		role, err = client.Get(ctx, scope, defId)
 		if err != nil {
-			return fmt.Errorf("Error loading Role Definition: %+v", err)
+			return fmt.Errorf("Error loading Role Definition: %+v", tf.WrapArmError(err))
 		}
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -46,7 +47,7 @@ func dataSourceSqlServer() *schema.Resource {
 }
 
 func dataSourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).sql.ServersClient
+	client := meta.(*ArmClient).Sql.ServersClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
@@ -58,7 +59,7 @@ func dataSourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("Sql Server %q was not found in Resource Group %q", name, resourceGroup)
 		}
 
-		return fmt.Errorf("Error retrieving Sql Server %q (Resource Group %q): %s", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Sql Server %q (Resource Group %q): %s", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if id := resp.ID; id != nil {
@@ -75,7 +76,7 @@ func dataSourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("administrator_login", props.AdministratorLogin)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -0,0 +1,58 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+)
+
+// This is a SERVICE SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+// which is scoped to a single Blob - rather than an Account SAS
+func dataSourceArmStorageBlobSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageBlobSasRead,
+
+		Schema: storageServiceSasSchema(map[string]*schema.Schema{
+			"container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageContainerName,
+			},
+
+			"blob_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"permissions": storageServiceSasPermissionsSchema([]string{"read", "add", "create", "write", "delete"}),
+
+			"cache_control":       storageServiceSasHeaderSchema(),
+			"content_disposition": storageServiceSasHeaderSchema(),
+			"content_encoding":    storageServiceSasHeaderSchema(),
+			"content_language":    storageServiceSasHeaderSchema(),
+			"content_type":        storageServiceSasHeaderSchema(),
+		}),
+	}
+}
+
+func dataSourceArmStorageBlobSasRead(d *schema.ResourceData, _ interface{}) error {
+	containerName := d.Get("container_name").(string)
+	blobName := d.Get("blob_name").(string)
+
+	options, err := expandStorageServiceSasOptions(d, "racwd")
+	if err != nil {
+		return err
+	}
+	expandStorageServiceSasHeaders(d, options)
+
+	sasToken, err := intStor.ComputeBlobSASToken(*options, containerName, blobName)
+	if err != nil {
+		return fmt.Errorf("Error computing SAS Token for Blob %q (Container %q / Storage Account %q): %+v", blobName, containerName, options.AccountName, err)
+	}
+
+	setStorageServiceSas(d, sasToken)
+	return nil
+}
@@ -0,0 +1,66 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceArmStorageBlobSas_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_blob_sas.test"
+	rInt := tf.AccRandTimeInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageBlobSas_basic(rInt, rString, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "blob_name", "example.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "start", startDate),
+					resource.TestCheckResourceAttr(dataSourceName, "expiry", endDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageBlobSas_basic(rInt int, rString string, location string, startDate string, endDate string) string {
+	template := testAccDataSourceAzureRMStorageContainerSas_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  container_name    = "${azurerm_storage_container.test.name}"
+  blob_name         = "example.txt"
+  https_only        = true
+  ip_address        = "10.0.0.1"
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = false
+    create = false
+    write  = false
+    delete = false
+  }
+
+  content_disposition = "attachment; filename=example.txt"
+  content_type        = "text/plain"
+}
+`, template, startDate, endDate)
+}
@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+)
+
+// This is a SERVICE SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+// which is scoped to a single Blob Container - rather than an Account SAS
+func dataSourceArmStorageContainerSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageContainerSasRead,
+
+		Schema: storageServiceSasSchema(map[string]*schema.Schema{
+			"container_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageContainerName,
+			},
+
+			"permissions": storageServiceSasPermissionsSchema([]string{"read", "add", "create", "write", "delete", "list"}),
+
+			"cache_control":       storageServiceSasHeaderSchema(),
+			"content_disposition": storageServiceSasHeaderSchema(),
+			"content_encoding":    storageServiceSasHeaderSchema(),
+			"content_language":    storageServiceSasHeaderSchema(),
+			"content_type":        storageServiceSasHeaderSchema(),
+		}),
+	}
+}
+
+func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{}) error {
+	containerName := d.Get("container_name").(string)
+
+	options, err := expandStorageServiceSasOptions(d, "racwdl")
+	if err != nil {
+		return err
+	}
+	expandStorageServiceSasHeaders(d, options)
+
+	sasToken, err := intStor.ComputeContainerSASToken(*options, containerName)
+	if err != nil {
+		return fmt.Errorf("Error computing SAS Token for Container %q (Storage Account %q): %+v", containerName, options.AccountName, err)
+	}
+
+	setStorageServiceSas(d, sasToken)
+	return nil
+}
+
+// storageServiceSasSchema returns the Schema common to the Service SAS Data Sources, merged with the specified fields
+func storageServiceSasSchema(input map[string]*schema.Schema) map[string]*schema.Schema {
+	output := map[string]*schema.Schema{
+		"connection_string": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+
+		"https_only": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"ip_address": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateStorageServiceSasIPAddress,
+		},
+
+		// Always in UTC and must be ISO-8601 format - can be omitted when defined in the Stored Access Policy
+		"start": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		// Always in UTC and must be ISO-8601 format - can be omitted when defined in the Stored Access Policy
+		"expiry": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"access_policy_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+
+		"sas": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
+func storageServiceSasPermissionsSchema(permissions []string) *schema.Schema {
+	fields := make(map[string]*schema.Schema)
+	for _, permission := range permissions {
+		fields[permission] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Required: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func storageServiceSasHeaderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+}
+
+// expandStorageServiceSasOptions parses the fields common to the Service SAS Data Sources - where `permissionOrder`
+// is the order in which the permissions (identified by their first character) must be specified in the SAS Token
+func expandStorageServiceSasOptions(d *schema.ResourceData, permissionOrder string) (*intStor.ServiceSASOptions, error) {
+	kvp, err := storage.ParseAccountSASConnectionString(d.Get("connection_string").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	options := intStor.ServiceSASOptions{
+		AccountName: kvp[connStringAccountNameKey],
+		AccountKey:  kvp[connStringAccountKeyKey],
+		Start:       d.Get("start").(string),
+		Expiry:      d.Get("expiry").(string),
+		Identifier:  d.Get("access_policy_id").(string),
+		IPRange:     d.Get("ip_address").(string),
+		Protocol:    "https,http",
+	}
+
+	if d.Get("https_only").(bool) {
+		options.Protocol = "https"
+	}
+
+	if permissions := d.Get("permissions").([]interface{}); len(permissions) > 0 && permissions[0] != nil {
+		options.Permissions = buildStorageServiceSasPermissionsString(permissions[0].(map[string]interface{}), permissionOrder)
+	}
+
+	// without a Stored Access Policy, the permissions and validity period must be specified in the SAS Token
+	if options.Identifier == "" {
+		if options.Permissions == "" || options.Start == "" || options.Expiry == "" {
+			return nil, fmt.Errorf("`permissions`, `start` and `expiry` must be specified when `access_policy_id` isn't set")
+		}
+	}
+
+	return &options, nil
+}
+
+func expandStorageServiceSasHeaders(d *schema.ResourceData, options *intStor.ServiceSASOptions) {
+	options.CacheControl = d.Get("cache_control").(string)
+	options.ContentDisposition = d.Get("content_disposition").(string)
+	options.ContentEncoding = d.Get("content_encoding").(string)
+	options.ContentLanguage = d.Get("content_language").(string)
+	options.ContentType = d.Get("content_type").(string)
+}
+
+func setStorageServiceSas(d *schema.ResourceData, sasToken string) {
+	d.Set("sas", sasToken)
+	tokenHash := sha256.Sum256([]byte(sasToken))
+	d.SetId(hex.EncodeToString(tokenHash[:]))
+}
+
+func buildStorageServiceSasPermissionsString(perms map[string]interface{}, order string) string {
+	enabled := make(map[byte]bool)
+	for k, v := range perms {
+		if val, ok := v.(bool); ok && val {
+			enabled[k[0]] = true
+		}
+	}
+
+	retVal := ""
+	for i := 0; i < len(order); i++ {
+		if enabled[order[i]] {
+			retVal += string(order[i])
+		}
+	}
+
+	return retVal
+}
+
+func validateStorageServiceSasIPAddress(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	addresses := strings.Split(v, "-")
+	if len(addresses) > 2 {
+		errors = append(errors, fmt.Errorf("%q must be a single IPv4 Address or a range of IPv4 Addresses separated by a hyphen, got %q", k, v))
+		return warnings, errors
+	}
+
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			errors = append(errors, fmt.Errorf("%q must be a single IPv4 Address or a range of IPv4 Addresses separated by a hyphen, got %q", k, v))
+			break
+		}
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceArmStorageContainerSas_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_container_sas.test"
+	rInt := tf.AccRandTimeInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageContainerSas_basic(rInt, rString, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "https_only", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "start", startDate),
+					resource.TestCheckResourceAttr(dataSourceName, "expiry", endDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceArmStorageContainerSas_accessPolicy(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_container_sas.test"
+	rInt := tf.AccRandTimeInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageContainerSas_accessPolicy(rInt, rString, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "access_policy_id", "policy1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_address", "168.1.5.60-168.1.5.70"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+func TestStorageServiceSasPermissionsString(t *testing.T) {
+	testCases := []struct {
+		input    map[string]interface{}
+		order    string
+		expected string
+	}{
+		{map[string]interface{}{"read": true}, "racwdl", "r"},
+		{map[string]interface{}{"read": false, "list": true}, "racwdl", "l"},
+		{map[string]interface{}{"list": true, "delete": true, "write": true, "create": true, "add": true, "read": true}, "racwdl", "racwdl"},
+		{map[string]interface{}{"delete": true, "read": true}, "racwd", "rd"},
+		{map[string]interface{}{"process": true, "update": true, "add": true, "read": true}, "raup", "raup"},
+	}
+
+	for _, test := range testCases {
+		result := buildStorageServiceSasPermissionsString(test.input, test.order)
+		if test.expected != result {
+			t.Fatalf("Failed to build permissions string: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
+
+func TestValidateStorageServiceSasIPAddress(t *testing.T) {
+	testCases := []struct {
+		input  string
+		errors int
+	}{
+		{"", 1},
+		{"10.0.0.1", 0},
+		{"168.1.5.60-168.1.5.70", 0},
+		{"168.1.5.60-", 1},
+		{"168.1.5.60-168.1.5.70-168.1.5.80", 1},
+		{"10.0.0.0/24", 1},
+		{"2001:db8::1", 1},
+	}
+
+	for _, test := range testCases {
+		_, errors := validateStorageServiceSasIPAddress(test.input, "ip_address")
+		if len(errors) != test.errors {
+			t.Fatalf("Expected %d errors for %q but got %d", test.errors, test.input, len(errors))
+		}
+	}
+}
+
+func testAccDataSourceAzureRMStorageContainerSas_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestsa-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsads%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+`, rInt, location, rString)
+}
+
+func testAccDataSourceAzureRMStorageContainerSas_basic(rInt int, rString string, location string, startDate string, endDate string) string {
+	template := testAccDataSourceAzureRMStorageContainerSas_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_container_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  container_name    = "${azurerm_storage_container.test.name}"
+  https_only        = true
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = true
+    create = false
+    write  = false
+    delete = true
+    list   = true
+  }
+
+  cache_control       = "max-age=5"
+  content_disposition = "inline"
+  content_encoding    = "deflate"
+  content_language    = "en-US"
+  content_type        = "application/json"
+}
+`, template, startDate, endDate)
+}
+
+func testAccDataSourceAzureRMStorageContainerSas_accessPolicy(rInt int, rString string, location string) string {
+	template := testAccDataSourceAzureRMStorageContainerSas_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_container_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  container_name    = "${azurerm_storage_container.test.name}"
+  access_policy_id  = "policy1"
+  ip_address        = "168.1.5.60-168.1.5.70"
+  https_only        = false
+}
+`, template)
+}
@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+)
+
+// This is a SERVICE SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+// which is scoped to a single Queue - rather than an Account SAS
+func dataSourceArmStorageQueueSharedAccessSignature() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStorageQueueSasRead,
+
+		Schema: storageServiceSasSchema(map[string]*schema.Schema{
+			"queue_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArmStorageQueueName,
+			},
+
+			"permissions": storageServiceSasPermissionsSchema([]string{"read", "add", "update", "process"}),
+		}),
+	}
+}
+
+func dataSourceArmStorageQueueSasRead(d *schema.ResourceData, _ interface{}) error {
+	queueName := d.Get("queue_name").(string)
+
+	options, err := expandStorageServiceSasOptions(d, "raup")
+	if err != nil {
+		return err
+	}
+
+	sasToken, err := intStor.ComputeQueueSASToken(*options, queueName)
+	if err != nil {
+		return fmt.Errorf("Error computing SAS Token for Queue %q (Storage Account %q): %+v", queueName, options.AccountName, err)
+	}
+
+	setStorageServiceSas(d, sasToken)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,12 +18,12 @@ func dataSourceArmSubscription() *schema.Resource {
 
 func dataSourceArmSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	groupClient := client.subscription.Client
+	groupClient := client.Subscription.Client
 	ctx := client.StopContext
 
 	subscriptionId := d.Get("subscription_id").(string)
 	if subscriptionId == "" {
-		subscriptionId = client.subscriptionId
+		subscriptionId = client.SubscriptionID
 	}
 
 	resp, err := groupClient.Get(ctx, subscriptionId)
@@ -31,7 +32,7 @@ func dataSourceArmSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error: Subscription %q was not found", subscriptionId)
 		}
 
-		return fmt.Errorf("Error reading subscription: %+v", err)
+		return fmt.Errorf("Error reading subscription: %+v", tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
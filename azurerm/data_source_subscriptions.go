@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func dataSourceArmSubscriptions() *schema.Resource {
@@ -34,7 +35,7 @@ func dataSourceArmSubscriptions() *schema.Resource {
 
 func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	subClient := armClient.subscription.Client
+	subClient := armClient.Subscription.Client
 	ctx := armClient.StopContext
 
 	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
@@ -43,7 +44,7 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 	//ListComplete returns an iterator struct
 	results, err := subClient.ListComplete(ctx)
 	if err != nil {
-		return fmt.Errorf("Error listing subscriptions: %+v", err)
+		return fmt.Errorf("Error listing subscriptions: %+v", tf.WrapArmError(err))
 	}
 
 	//iterate across each subscriptions and append them to slice
@@ -75,7 +76,7 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 		}
 
 		if err = results.Next(); err != nil {
-			return fmt.Errorf("Error going to next subscriptions value: %+v", err)
+			return fmt.Errorf("Error going to next subscriptions value: %+v", tf.WrapArmError(err))
 		}
 
 		//check if the display name prefix matches the given input
@@ -97,7 +98,7 @@ func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) er
 		subscriptions = append(subscriptions, s)
 	}
 
-	d.SetId("subscriptions-" + armClient.tenantId)
+	d.SetId("subscriptions-" + armClient.TenantID)
 	if err = d.Set("subscriptions", subscriptions); err != nil {
 		return fmt.Errorf("Error setting `subscriptions`: %+v", err)
 	}
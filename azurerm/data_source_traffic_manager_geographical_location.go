@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
 func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
@@ -21,12 +22,12 @@ func dataSourceArmTrafficManagerGeographicalLocation() *schema.Resource {
 }
 
 func dataSourceArmTrafficManagerGeographicalLocationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManager.GeographialHierarchiesClient
+	client := meta.(*ArmClient).TrafficManager.GeographialHierarchiesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	results, err := client.GetDefault(ctx)
 	if err != nil {
-		return fmt.Errorf("Error loading Traffic Manager Geographical Hierarchies: %+v", err)
+		return fmt.Errorf("Error loading Traffic Manager Geographical Hierarchies: %+v", tf.WrapArmError(err))
 	}
 
 	name := d.Get("name").(string)
@@ -6,6 +6,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -64,7 +65,7 @@ func dataSourceArmVirtualNetwork() *schema.Resource {
 }
 
 func dataSourceArmVnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.VnetClient
+	client := meta.(*ArmClient).Network.VnetClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resGroup := d.Get("resource_group_name").(string)
@@ -76,11 +77,11 @@ func dataSourceArmVnetRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Error: Virtual Network %q (Resource Group %q) was not found", name, resGroup)
 		}
 
-		return fmt.Errorf("Error making Read request on Virtual Network %q (resource group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error making Read request on Virtual Network %q (resource group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if resp.ID == nil || *resp.ID == "" {
-		return fmt.Errorf("API returns a nil/empty id on Virtual Network %q (resource group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("API returns a nil/empty id on Virtual Network %q (resource group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 	d.SetId(*resp.ID)
 
@@ -34,17 +34,17 @@ func validateAzureRMTags(v interface{}, k string) (warnings []string, errors []e
 	return tags.Validate(v, k)
 }
 
-func expandTags(tagsMap map[string]interface{}) map[string]*string {
-	return tags.Expand(tagsMap)
+func expandTags(tagsMap map[string]interface{}, meta interface{}) map[string]*string {
+	return tags.Expand(tagsMap, meta)
 }
 
 func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*string {
 	return tags.Filter(tagsMap, tagNames...)
 }
 
-func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
+func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) {
 	// we intentionally ignore the error here, since this method doesn't expose it
-	_ = tags.FlattenAndSet(d, tagMap)
+	_ = tags.FlattenAndSet(d, tagMap, meta)
 }
 
 // migrated
@@ -0,0 +1,135 @@
+package azure
+
+import "testing"
+
+func TestParseCosmosDatabaseContainerID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *CosmosDatabaseContainerID
+	}{
+		{
+			Name:  "Missing Container",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/sql/databases/db1",
+		},
+		{
+			Name:  "Graph instead of Container",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/gremlin/databases/db1/graphs/graph1",
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/sql/databases/db1/containers/container1",
+			Expected: &CosmosDatabaseContainerID{
+				CosmosDatabaseID: CosmosDatabaseID{
+					CosmosAccountID: CosmosAccountID{
+						ResourceID: ResourceID{
+							ResourceGroup: "group1",
+						},
+						Account: "account1",
+					},
+					Database: "db1",
+				},
+				Container: "container1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseCosmosDatabaseContainerID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.Account != v.Expected.Account {
+			t.Fatalf("Expected %q but got %q for Account", v.Expected.Account, actual.Account)
+		}
+
+		if actual.Database != v.Expected.Database {
+			t.Fatalf("Expected %q but got %q for Database", v.Expected.Database, actual.Database)
+		}
+
+		if actual.Container != v.Expected.Container {
+			t.Fatalf("Expected %q but got %q for Container", v.Expected.Container, actual.Container)
+		}
+	}
+}
+
+func TestParseCosmosDatabaseGraphID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *CosmosDatabaseGraphID
+	}{
+		{
+			Name:  "Missing Graph",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/gremlin/databases/db1",
+		},
+		{
+			Name:  "Container instead of Graph",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/sql/databases/db1/containers/container1",
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1/apis/gremlin/databases/db1/graphs/graph1",
+			Expected: &CosmosDatabaseGraphID{
+				CosmosDatabaseID: CosmosDatabaseID{
+					CosmosAccountID: CosmosAccountID{
+						ResourceID: ResourceID{
+							ResourceGroup: "group1",
+						},
+						Account: "account1",
+					},
+					Database: "db1",
+				},
+				Graph: "graph1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseCosmosDatabaseGraphID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.Account != v.Expected.Account {
+			t.Fatalf("Expected %q but got %q for Account", v.Expected.Account, actual.Account)
+		}
+
+		if actual.Database != v.Expected.Database {
+			t.Fatalf("Expected %q but got %q for Database", v.Expected.Database, actual.Database)
+		}
+
+		if actual.Graph != v.Expected.Graph {
+			t.Fatalf("Expected %q but got %q for Graph", v.Expected.Graph, actual.Graph)
+		}
+	}
+}
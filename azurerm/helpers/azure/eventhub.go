@@ -7,6 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 )
@@ -143,3 +146,153 @@ func EventHubAuthorizationRuleCustomizeDiff(d *schema.ResourceDiff, _ interface{
 
 	return nil
 }
+
+func SchemaEventHubNamespaceNetworkRuleSets() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_action": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(eventhub.Allow),
+						string(eventhub.Deny),
+					}, false),
+				},
+
+				"ip_rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ip_mask": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+
+							"action": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  string(eventhub.NetworkRuleIPActionAllow),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(eventhub.NetworkRuleIPActionAllow),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"virtual_network_rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							// the API returns the Subnet ID with a lower-cased Resource Group
+							"subnet_id": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateFunc:     ValidateResourceID,
+								DiffSuppressFunc: suppress.CaseDifference,
+							},
+
+							"ignore_missing_vnet_service_endpoint": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandEventHubNamespaceNetworkRuleSets(input []interface{}) eventhub.NetworkRuleSet {
+	if len(input) == 0 || input[0] == nil {
+		return eventhub.NetworkRuleSet{}
+	}
+	v := input[0].(map[string]interface{})
+
+	ipRules := make([]eventhub.NWRuleSetIPRules, 0)
+	for _, r := range v["ip_rule"].([]interface{}) {
+		rule := r.(map[string]interface{})
+		ipRules = append(ipRules, eventhub.NWRuleSetIPRules{
+			IPMask: utils.String(rule["ip_mask"].(string)),
+			Action: eventhub.NetworkRuleIPAction(rule["action"].(string)),
+		})
+	}
+
+	virtualNetworkRules := make([]eventhub.NWRuleSetVirtualNetworkRules, 0)
+	for _, r := range v["virtual_network_rule"].([]interface{}) {
+		rule := r.(map[string]interface{})
+		virtualNetworkRules = append(virtualNetworkRules, eventhub.NWRuleSetVirtualNetworkRules{
+			Subnet: &eventhub.Subnet{
+				ID: utils.String(rule["subnet_id"].(string)),
+			},
+			IgnoreMissingVnetServiceEndpoint: utils.Bool(rule["ignore_missing_vnet_service_endpoint"].(bool)),
+		})
+	}
+
+	return eventhub.NetworkRuleSet{
+		NetworkRuleSetProperties: &eventhub.NetworkRuleSetProperties{
+			DefaultAction:       eventhub.DefaultAction(v["default_action"].(string)),
+			IPRules:             &ipRules,
+			VirtualNetworkRules: &virtualNetworkRules,
+		},
+	}
+}
+
+func FlattenEventHubNamespaceNetworkRuleSets(input *eventhub.NetworkRuleSetProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	ipRules := make([]interface{}, 0)
+	if input.IPRules != nil {
+		for _, rule := range *input.IPRules {
+			ipMask := ""
+			if rule.IPMask != nil {
+				ipMask = *rule.IPMask
+			}
+
+			ipRules = append(ipRules, map[string]interface{}{
+				"ip_mask": ipMask,
+				"action":  string(rule.Action),
+			})
+		}
+	}
+
+	virtualNetworkRules := make([]interface{}, 0)
+	if input.VirtualNetworkRules != nil {
+		for _, rule := range *input.VirtualNetworkRules {
+			subnetId := ""
+			if rule.Subnet != nil && rule.Subnet.ID != nil {
+				subnetId = *rule.Subnet.ID
+			}
+
+			ignoreMissingVnetServiceEndpoint := false
+			if rule.IgnoreMissingVnetServiceEndpoint != nil {
+				ignoreMissingVnetServiceEndpoint = *rule.IgnoreMissingVnetServiceEndpoint
+			}
+
+			virtualNetworkRules = append(virtualNetworkRules, map[string]interface{}{
+				"subnet_id":                            subnetId,
+				"ignore_missing_vnet_service_endpoint": ignoreMissingVnetServiceEndpoint,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_action":       string(input.DefaultAction),
+			"ip_rule":              ipRules,
+			"virtual_network_rule": virtualNetworkRules,
+		},
+	}
+}
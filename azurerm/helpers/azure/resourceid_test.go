@@ -156,3 +156,85 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	testCases := []struct {
+		id            string
+		segment       string
+		expectedValue string
+		expectError   bool
+	}{
+		{
+			// missing segment
+			"/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualNetworks/virtualNetwork1",
+			"subnets",
+			"",
+			true,
+		},
+		{
+			"/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualNetworks/virtualNetwork1",
+			"virtualNetworks",
+			"virtualNetwork1",
+			false,
+		},
+		{
+			// different casing
+			"/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/dnszones/zone1.com",
+			"dnsZones",
+			"zone1.com",
+			false,
+		},
+	}
+
+	for _, test := range testCases {
+		id, err := ParseAzureResourceID(test.id)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing %q: %s", test.id, err)
+		}
+
+		value, err := id.PopSegment(test.segment)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if test.expectError {
+			t.Fatalf("Expected an error for segment %q in %q but didn't get one", test.segment, test.id)
+		}
+
+		if value != test.expectedValue {
+			t.Fatalf("Expected %q but got %q", test.expectedValue, value)
+		}
+
+		if _, ok := id.Path[test.segment]; ok {
+			t.Fatalf("Expected segment %q to be removed from the Path", test.segment)
+		}
+	}
+}
+
+func TestResourceIDValidateNoEmptySegments(t *testing.T) {
+	input := "/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualNetworks/virtualNetwork1/subnets/subnet1"
+	id, err := ParseAzureResourceID(input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if _, err := id.PopSegment("virtualNetworks"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err == nil {
+		t.Fatalf("Expected an error since the `subnets` segment remains but didn't get one")
+	}
+
+	if _, err := id.PopSegment("subnets"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
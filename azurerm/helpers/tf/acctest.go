@@ -6,9 +6,15 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/testhttp"
 )
 
 func AccRandTimeInt() int {
+	// when recording/replaying the names of resources need to match those in the Cassette
+	if testhttp.IsRecording() {
+		return testhttp.RandInt()
+	}
+
 	// acctest.RantInt() returns a value of size:
 	// 000000000000000000
 	// YYMMddHHmmsshhRRRR
@@ -1,8 +1,325 @@
 package tf
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
 
 func ImportAsExistsError(resourceName, id string) error {
 	msg := "A resource with the ID %q already exists - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
 	return fmt.Errorf(msg, id, resourceName)
 }
+
+// ArmError is a structured representation of an error returned from Azure Resource Manager
+type ArmError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Target     string
+
+	// Details are the (nested) errors which caused this error, e.g. the individual quota failures
+	Details []ArmErrorDetail
+
+	// InnerError contains any additional (unstructured) information about the error
+	InnerError map[string]interface{}
+
+	// PolicyAssignments are the Policy Assignments which denied this request, if any
+	PolicyAssignments []string
+
+	CorrelationID string
+	RequestID     string
+
+	original error
+}
+
+// ArmErrorDetail is a nested error within an ArmError
+type ArmErrorDetail struct {
+	Code    string
+	Message string
+	Target  string
+	Details []ArmErrorDetail
+}
+
+// WrapArmError wraps the specified error as an ArmError when it was returned from Azure Resource Manager, such
+// that the Code, Target, nested Details, any denying Policy Assignments and the Correlation ID are readable.
+// Any other error is returned as-is.
+func WrapArmError(err error) error {
+	if armError, ok := ParseArmError(err); ok {
+		return armError
+	}
+
+	return err
+}
+
+// ParseArmError unwraps the `autorest.DetailedError`/`azure.RequestError`/`azure.ServiceError` returned from
+// the Azure SDK into an ArmError, returning false when the error wasn't returned from Azure Resource Manager
+func ParseArmError(err error) (*ArmError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	if v, ok := err.(*ArmError); ok {
+		return v, true
+	}
+
+	output := ArmError{
+		original: err,
+	}
+
+	var resp *http.Response
+	var serviceError *azure.ServiceError
+
+	for serviceError == nil && err != nil {
+		switch v := err.(type) {
+		case autorest.DetailedError:
+			resp, err = detailedErrorResponse(v, resp, &output)
+			if err == nil {
+				serviceError = serviceErrorFromBody(v.ServiceError)
+			}
+
+		case *autorest.DetailedError:
+			resp, err = detailedErrorResponse(*v, resp, &output)
+			if err == nil {
+				serviceError = serviceErrorFromBody(v.ServiceError)
+			}
+
+		case azure.RequestError:
+			serviceError = v.ServiceError
+			output.RequestID = v.RequestID
+			if v.Response != nil {
+				resp = v.Response
+			}
+			err = nil
+
+		case *azure.RequestError:
+			serviceError = v.ServiceError
+			output.RequestID = v.RequestID
+			if v.Response != nil {
+				resp = v.Response
+			}
+			err = nil
+
+		case azure.ServiceError:
+			serviceError = &v
+
+		case *azure.ServiceError:
+			serviceError = v
+
+		default:
+			err = nil
+		}
+	}
+
+	if serviceError == nil {
+		return nil, false
+	}
+
+	output.Code = serviceError.Code
+	output.Message = serviceError.Message
+	if serviceError.Target != nil {
+		output.Target = *serviceError.Target
+	}
+	output.InnerError = serviceError.InnerError
+	output.Details = flattenArmErrorDetails(serviceError.Details)
+	output.PolicyAssignments = policyAssignmentsFromArmError(serviceError)
+
+	if resp != nil {
+		if output.StatusCode == 0 {
+			output.StatusCode = resp.StatusCode
+		}
+		if output.RequestID == "" {
+			output.RequestID = azure.ExtractRequestID(resp)
+		}
+		output.CorrelationID = resp.Header.Get(common.HeaderCorrelationRequestID)
+		if output.CorrelationID == "" && resp.Request != nil {
+			output.CorrelationID = resp.Request.Header.Get(common.HeaderCorrelationRequestID)
+		}
+	}
+	if output.CorrelationID == "" {
+		// errors from polling Long Running Operations don't include the response, however the
+		// same Correlation ID is sent with every request made by the Provider
+		output.CorrelationID = common.CorrelationRequestID()
+	}
+
+	return &output, true
+}
+
+func (e ArmError) Error() string {
+	lines := make([]string, 0)
+
+	summary := fmt.Sprintf("Code=%q Message=%q", e.Code, e.Message)
+	if e.StatusCode != 0 {
+		summary = fmt.Sprintf("Status=%d %s", e.StatusCode, summary)
+	}
+	if e.Target != "" {
+		summary += fmt.Sprintf(" Target=%q", e.Target)
+	}
+	lines = append(lines, summary)
+
+	if len(e.PolicyAssignments) > 0 {
+		lines = append(lines, fmt.Sprintf("  Policy Assignments: %s", strings.Join(e.PolicyAssignments, ", ")))
+	}
+
+	if len(e.Details) > 0 {
+		lines = append(lines, "  Details:")
+		lines = append(lines, formatArmErrorDetails(e.Details, "    ")...)
+	}
+
+	if len(e.InnerError) > 0 {
+		if v, err := json.Marshal(e.InnerError); err == nil {
+			lines = append(lines, fmt.Sprintf("  Inner Error: %s", string(v)))
+		}
+	}
+
+	if e.CorrelationID != "" {
+		lines = append(lines, fmt.Sprintf("  Correlation ID: %s", e.CorrelationID))
+	}
+
+	if e.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("  Request ID: %s", e.RequestID))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Original returns the error returned from the Azure SDK
+func (e ArmError) Original() error {
+	return e.original
+}
+
+func detailedErrorResponse(input autorest.DetailedError, resp *http.Response, output *ArmError) (*http.Response, error) {
+	if resp == nil && input.Response != nil {
+		resp = input.Response
+	}
+
+	if output.StatusCode == 0 {
+		if v, ok := input.StatusCode.(int); ok && v != autorest.UndefinedStatusCode {
+			output.StatusCode = v
+		}
+	}
+
+	return resp, input.Original
+}
+
+// serviceErrorFromBody parses the response body stored within an `autorest.DetailedError`, if any
+func serviceErrorFromBody(body []byte) *azure.ServiceError {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var wrapped struct {
+		ServiceError *azure.ServiceError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &wrapped); err == nil && wrapped.ServiceError != nil && wrapped.ServiceError.Code != "" {
+		return wrapped.ServiceError
+	}
+
+	var unwrapped azure.ServiceError
+	if err := json.Unmarshal(body, &unwrapped); err == nil && unwrapped.Code != "" {
+		return &unwrapped
+	}
+
+	return nil
+}
+
+func flattenArmErrorDetails(input []map[string]interface{}) []ArmErrorDetail {
+	output := make([]ArmErrorDetail, 0)
+
+	for _, v := range input {
+		detail := ArmErrorDetail{}
+		if code, ok := v["code"].(string); ok {
+			detail.Code = code
+		}
+		if message, ok := v["message"].(string); ok {
+			detail.Message = message
+		}
+		if target, ok := v["target"].(string); ok {
+			detail.Target = target
+		}
+		if nested, ok := v["details"].([]interface{}); ok {
+			detail.Details = flattenArmErrorDetails(mapsFromList(nested))
+		}
+
+		output = append(output, detail)
+	}
+
+	return output
+}
+
+func formatArmErrorDetails(input []ArmErrorDetail, indent string) []string {
+	lines := make([]string, 0)
+
+	for _, v := range input {
+		line := fmt.Sprintf("%s- Code=%q Message=%q", indent, v.Code, v.Message)
+		if v.Target != "" {
+			line += fmt.Sprintf(" Target=%q", v.Target)
+		}
+		lines = append(lines, line)
+
+		lines = append(lines, formatArmErrorDetails(v.Details, indent+"  ")...)
+	}
+
+	return lines
+}
+
+// policyAssignmentsFromArmError returns the Policy Assignments from the `PolicyViolation` Additional Info, which
+// is present (either at the top-level, or within the Details) when a request is denied by Azure Policy
+func policyAssignmentsFromArmError(input *azure.ServiceError) []string {
+	additionalInfo := make([]map[string]interface{}, 0)
+	additionalInfo = append(additionalInfo, input.AdditionalInfo...)
+
+	for _, detail := range input.Details {
+		if v, ok := detail["additionalInfo"].([]interface{}); ok {
+			additionalInfo = append(additionalInfo, mapsFromList(v)...)
+		}
+	}
+
+	output := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, v := range additionalInfo {
+		if infoType, ok := v["type"].(string); !ok || !strings.EqualFold(infoType, "PolicyViolation") {
+			continue
+		}
+
+		info, ok := v["info"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := info["policyAssignmentDisplayName"].(string)
+		if name == "" {
+			name, _ = info["policyAssignmentName"].(string)
+		}
+		id, _ := info["policyAssignmentId"].(string)
+
+		assignment := fmt.Sprintf("%q", name)
+		if id != "" {
+			assignment = fmt.Sprintf("%q (%s)", name, id)
+		}
+
+		if !seen[assignment] {
+			seen[assignment] = true
+			output = append(output, assignment)
+		}
+	}
+
+	return output
+}
+
+func mapsFromList(input []interface{}) []map[string]interface{} {
+	output := make([]map[string]interface{}, 0)
+
+	for _, v := range input {
+		if m, ok := v.(map[string]interface{}); ok {
+			output = append(output, m)
+		}
+	}
+
+	return output
+}
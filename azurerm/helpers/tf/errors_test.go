@@ -0,0 +1,239 @@
+package tf
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestParseArmError(t *testing.T) {
+	target := "example"
+	response := &http.Response{
+		StatusCode: http.StatusConflict,
+		Header: http.Header{
+			"X-Ms-Correlation-Request-Id": []string{"11111111-1111-1111-1111-111111111111"},
+			"X-Ms-Request-Id":             []string{"22222222-2222-2222-2222-222222222222"},
+		},
+	}
+
+	quotaError := &azure.ServiceError{
+		Code:    "QuotaExceeded",
+		Message: "Operation results in exceeding quota limits.",
+		Target:  &target,
+		Details: []map[string]interface{}{
+			{
+				"code":    "CoreQuotaExceeded",
+				"message": "Operation results in exceeding the Core quota.",
+				"target":  "cores",
+				"details": []interface{}{
+					map[string]interface{}{
+						"code":    "RegionalQuotaExceeded",
+						"message": "The regional quota has been exceeded.",
+					},
+				},
+			},
+		},
+	}
+
+	policyError := &azure.ServiceError{
+		Code:    "RequestDisallowedByPolicy",
+		Message: "Resource 'example' was disallowed by policy.",
+		AdditionalInfo: []map[string]interface{}{
+			{
+				"type": "PolicyViolation",
+				"info": map[string]interface{}{
+					"policyAssignmentDisplayName": "Allowed Locations",
+					"policyAssignmentId":          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations",
+				},
+			},
+			{
+				"type": "PolicyViolation",
+				"info": map[string]interface{}{
+					"policyAssignmentName": "tagging",
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    error
+		Expected *ArmError
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:     "Not an Azure Error",
+			Input:    fmt.Errorf("Error expanding `properties`"),
+			Expected: nil,
+		},
+		{
+			Name:     "Detailed Error without a Service Error",
+			Input:    autorest.NewErrorWithError(fmt.Errorf("connection refused"), "resources.GroupsClient", "Get", nil, "Failure sending request"),
+			Expected: nil,
+		},
+		{
+			Name: "Request Error from the SDK",
+			Input: autorest.NewErrorWithError(&azure.RequestError{
+				DetailedError: autorest.DetailedError{
+					StatusCode: http.StatusConflict,
+					Response:   response,
+				},
+				ServiceError: quotaError,
+			}, "compute.VirtualMachinesClient", "CreateOrUpdate", response, "Failure sending request"),
+			Expected: &ArmError{
+				StatusCode: http.StatusConflict,
+				Code:       "QuotaExceeded",
+				Message:    "Operation results in exceeding quota limits.",
+				Target:     "example",
+				Details: []ArmErrorDetail{
+					{
+						Code:    "CoreQuotaExceeded",
+						Message: "Operation results in exceeding the Core quota.",
+						Target:  "cores",
+						Details: []ArmErrorDetail{
+							{
+								Code:    "RegionalQuotaExceeded",
+								Message: "The regional quota has been exceeded.",
+							},
+						},
+					},
+				},
+				CorrelationID: "11111111-1111-1111-1111-111111111111",
+				RequestID:     "22222222-2222-2222-2222-222222222222",
+			},
+		},
+		{
+			Name:  "Service Error from a Long Running Operation",
+			Input: policyError,
+			Expected: &ArmError{
+				Code:    "RequestDisallowedByPolicy",
+				Message: "Resource 'example' was disallowed by policy.",
+				PolicyAssignments: []string{
+					`"Allowed Locations" (/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations)`,
+					`"tagging"`,
+				},
+			},
+		},
+		{
+			Name: "Detailed Error containing the Response Body",
+			Input: autorest.DetailedError{
+				StatusCode:   http.StatusBadRequest,
+				ServiceError: []byte(`{"error": {"code": "InvalidParameter", "message": "The value is invalid.", "target": "sku"}}`),
+			},
+			Expected: &ArmError{
+				StatusCode: http.StatusBadRequest,
+				Code:       "InvalidParameter",
+				Message:    "The value is invalid.",
+				Target:     "sku",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, ok := ParseArmError(v.Input)
+		if !ok {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but didn't get one")
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected no value but got %+v", actual)
+		}
+
+		if actual.StatusCode != v.Expected.StatusCode {
+			t.Fatalf("Expected StatusCode to be %d but got %d", v.Expected.StatusCode, actual.StatusCode)
+		}
+
+		if actual.Code != v.Expected.Code {
+			t.Fatalf("Expected Code to be %q but got %q", v.Expected.Code, actual.Code)
+		}
+
+		if actual.Message != v.Expected.Message {
+			t.Fatalf("Expected Message to be %q but got %q", v.Expected.Message, actual.Message)
+		}
+
+		if actual.Target != v.Expected.Target {
+			t.Fatalf("Expected Target to be %q but got %q", v.Expected.Target, actual.Target)
+		}
+
+		if fmt.Sprintf("%+v", actual.Details) != fmt.Sprintf("%+v", v.Expected.Details) {
+			t.Fatalf("Expected Details to be %+v but got %+v", v.Expected.Details, actual.Details)
+		}
+
+		if strings.Join(actual.PolicyAssignments, ",") != strings.Join(v.Expected.PolicyAssignments, ",") {
+			t.Fatalf("Expected PolicyAssignments to be %+v but got %+v", v.Expected.PolicyAssignments, actual.PolicyAssignments)
+		}
+
+		if v.Expected.CorrelationID != "" && actual.CorrelationID != v.Expected.CorrelationID {
+			t.Fatalf("Expected CorrelationID to be %q but got %q", v.Expected.CorrelationID, actual.CorrelationID)
+		}
+
+		if actual.CorrelationID == "" {
+			t.Fatalf("Expected a CorrelationID but didn't get one")
+		}
+
+		if actual.RequestID != v.Expected.RequestID {
+			t.Fatalf("Expected RequestID to be %q but got %q", v.Expected.RequestID, actual.RequestID)
+		}
+	}
+}
+
+func TestArmErrorMessage(t *testing.T) {
+	input := ArmError{
+		StatusCode: http.StatusForbidden,
+		Code:       "RequestDisallowedByPolicy",
+		Message:    "Resource 'example' was disallowed by policy.",
+		Details: []ArmErrorDetail{
+			{
+				Code:    "First",
+				Message: "The first detail.",
+				Target:  "first",
+				Details: []ArmErrorDetail{
+					{
+						Code:    "Nested",
+						Message: "The nested detail.",
+					},
+				},
+			},
+		},
+		PolicyAssignments: []string{`"Allowed Locations"`},
+		CorrelationID:     "11111111-1111-1111-1111-111111111111",
+		RequestID:         "22222222-2222-2222-2222-222222222222",
+	}
+
+	expected := `Status=403 Code="RequestDisallowedByPolicy" Message="Resource 'example' was disallowed by policy."
+  Policy Assignments: "Allowed Locations"
+  Details:
+    - Code="First" Message="The first detail." Target="first"
+      - Code="Nested" Message="The nested detail."
+  Correlation ID: 11111111-1111-1111-1111-111111111111
+  Request ID: 22222222-2222-2222-2222-222222222222`
+
+	if actual := input.Error(); actual != expected {
+		t.Fatalf("Expected:\n%s\n\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestWrapArmError(t *testing.T) {
+	input := fmt.Errorf("Error expanding `properties`")
+	if actual := WrapArmError(input); actual != input {
+		t.Fatalf("Expected errors which aren't from Azure to be returned as-is but got %+v", actual)
+	}
+
+	if actual := WrapArmError(&azure.ServiceError{Code: "Conflict"}); !strings.Contains(actual.Error(), `Code="Conflict"`) {
+		t.Fatalf("Expected the error to contain the Code but got %q", actual.Error())
+	}
+}
@@ -26,3 +26,19 @@ func CosmosEntityName(v interface{}, k string) (warnings []string, errors []erro
 
 	return warnings, errors
 }
+
+func CosmosThroughput(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(int)
+
+	if value < 400 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a minimum of 400", k))
+	}
+
+	if value%100 != 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be set in increments of 100", k))
+	}
+
+	return warnings, errors
+}
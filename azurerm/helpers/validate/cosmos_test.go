@@ -0,0 +1,47 @@
+package validate
+
+import "testing"
+
+func TestCosmosThroughput(t *testing.T) {
+	cases := []struct {
+		Value  int
+		Errors int
+	}{
+		{
+			Value:  0,
+			Errors: 1,
+		},
+		{
+			Value:  300,
+			Errors: 1,
+		},
+		{
+			Value:  400,
+			Errors: 0,
+		},
+		{
+			Value:  450,
+			Errors: 1,
+		},
+		{
+			Value:  1000,
+			Errors: 0,
+		},
+		{
+			Value:  1050,
+			Errors: 1,
+		},
+		{
+			Value:  100000,
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := CosmosThroughput(tc.Value, "throughput")
+
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected CosmosThroughput to trigger '%d' errors for '%d' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
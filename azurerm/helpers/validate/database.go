@@ -3,6 +3,11 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func DatabaseCollation(i interface{}, k string) (warnings []string, errors []error) {
@@ -21,3 +26,73 @@ func DatabaseCollation(i interface{}, k string) (warnings []string, errors []err
 
 	return warnings, errors
 }
+
+// DatabaseServerRestorePointInTime validates that the point in time to restore a MySQL/PostgreSQL Server
+// from is a valid RFC3339 date - whether it's within the backups of the Source Server is checked by the API
+func DatabaseServerRestorePointInTime(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := date.ParseTime(time.RFC3339, v); err != nil {
+		errors = append(errors, fmt.Errorf("%q has the invalid RFC3339 date format %q: %+v", k, v, err))
+	}
+
+	return warnings, errors
+}
+
+// DatabaseServerCreateMode validates that the fields required by the `create_mode` of a MySQL/PostgreSQL Server
+// have been specified - Servers which are created from an existing Server (e.g. Replicas) inherit the
+// Administrator Login and SKU from the Source Server, so these are only required when creating a new Server.
+func DatabaseServerCreateMode(d *schema.ResourceDiff) error {
+	isSet := func(key string) bool {
+		// if the value isn't known yet (e.g. it's interpolated) then assume it's been specified
+		if !d.NewValueKnown(key) {
+			return true
+		}
+
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	return databaseServerCreateMode(
+		d.Get("create_mode").(string),
+		isSet("creation_source_server_id"),
+		isSet("restore_point_in_time"),
+		isSet("administrator_login"),
+		isSet("administrator_login_password"),
+		isSet("sku"),
+	)
+}
+
+func databaseServerCreateMode(createMode string, hasCreationSource, hasRestorePoint, hasAdminLogin, hasAdminPassword, hasSku bool) error {
+	switch strings.ToLower(createMode) {
+	case "", "default":
+		if hasCreationSource {
+			return fmt.Errorf("`creation_source_server_id` can only be specified when `create_mode` is not `Default`")
+		}
+		if !hasAdminLogin || !hasAdminPassword {
+			return fmt.Errorf("`administrator_login` and `administrator_login_password` must be specified when `create_mode` is `Default`")
+		}
+		if !hasSku {
+			return fmt.Errorf("`sku` must be specified when `create_mode` is `Default`")
+		}
+
+	case "georestore", "pointintimerestore", "replica":
+		if !hasCreationSource {
+			return fmt.Errorf("`creation_source_server_id` must be specified when `create_mode` is %q", createMode)
+		}
+	}
+
+	isPointInTimeRestore := strings.EqualFold(createMode, "PointInTimeRestore")
+	if isPointInTimeRestore && !hasRestorePoint {
+		return fmt.Errorf("`restore_point_in_time` must be specified when `create_mode` is `PointInTimeRestore`")
+	}
+	if !isPointInTimeRestore && hasRestorePoint {
+		return fmt.Errorf("`restore_point_in_time` can only be specified when `create_mode` is `PointInTimeRestore`")
+	}
+
+	return nil
+}
@@ -32,3 +32,128 @@ func TestDatabaseCollation(t *testing.T) {
 		}
 	}
 }
+
+func TestDatabaseServerRestorePointInTime(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "2019-09-01",
+			Errors: 1,
+		},
+		{
+			Value:  "2019-09-01T10:00:00Z",
+			Errors: 0,
+		},
+		{
+			Value:  "2019-09-01T10:00:00+01:00",
+			Errors: 0,
+		},
+		{
+			Value:  "2019-09-01 10:00:00",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := DatabaseServerRestorePointInTime(tc.Value, "restore_point_in_time")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected DatabaseServerRestorePointInTime to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestDatabaseServerCreateMode(t *testing.T) {
+	cases := []struct {
+		CreateMode        string
+		HasCreationSource bool
+		HasRestorePoint   bool
+		HasAdmin          bool
+		HasSku            bool
+		ExpectError       bool
+	}{
+		{
+			CreateMode:  "Default",
+			HasAdmin:    true,
+			HasSku:      true,
+			ExpectError: false,
+		},
+		{
+			CreateMode:  "Default",
+			HasAdmin:    false,
+			HasSku:      true,
+			ExpectError: true,
+		},
+		{
+			CreateMode:  "Default",
+			HasAdmin:    true,
+			HasSku:      false,
+			ExpectError: true,
+		},
+		{
+			CreateMode:        "Default",
+			HasCreationSource: true,
+			HasAdmin:          true,
+			HasSku:            true,
+			ExpectError:       true,
+		},
+		{
+			CreateMode:      "Default",
+			HasRestorePoint: true,
+			HasAdmin:        true,
+			HasSku:          true,
+			ExpectError:     true,
+		},
+		{
+			CreateMode:        "Replica",
+			HasCreationSource: true,
+			ExpectError:       false,
+		},
+		{
+			CreateMode:  "Replica",
+			ExpectError: true,
+		},
+		{
+			CreateMode:        "Replica",
+			HasCreationSource: true,
+			HasRestorePoint:   true,
+			ExpectError:       true,
+		},
+		{
+			CreateMode:        "GeoRestore",
+			HasCreationSource: true,
+			ExpectError:       false,
+		},
+		{
+			CreateMode:        "PointInTimeRestore",
+			HasCreationSource: true,
+			HasRestorePoint:   true,
+			ExpectError:       false,
+		},
+		{
+			CreateMode:        "PointInTimeRestore",
+			HasCreationSource: true,
+			ExpectError:       true,
+		},
+		{
+			CreateMode:      "PointInTimeRestore",
+			HasRestorePoint: true,
+			ExpectError:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := databaseServerCreateMode(tc.CreateMode, tc.HasCreationSource, tc.HasRestorePoint, tc.HasAdmin, tc.HasAdmin, tc.HasSku)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %+v but didn't get one", tc)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for %+v but got: %+v", tc, err)
+		}
+	}
+}
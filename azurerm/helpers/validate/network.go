@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strings"
 )
 
 func IPv6Address(i interface{}, k string) (warnings []string, errors []error) {
@@ -107,3 +108,41 @@ func validatePortNumber(i interface{}, k string, allowZero bool) (warnings []str
 
 	return warnings, errors
 }
+
+func BastionHostName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// regex pulled from https://docs.microsoft.com/en-us/azure/azure-resource-manager/resource-name-rules#microsoftnetwork
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters, start with an alphanumeric character, end with an alphanumeric character or an underscore and may only contain alphanumeric characters, underscores, periods and dashes: %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// BastionSubnetID validates that the specified value is the ID of a Subnet named `AzureBastionSubnet`,
+// which is the name Azure requires for the Subnet a Bastion Host is deployed into
+func BastionSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	segments := strings.Split(strings.TrimSuffix(v, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[len(segments)-2], "subnets") {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Subnet: %q", k, v))
+		return
+	}
+
+	// whilst Azure treats this case-insensitively, the name must be `AzureBastionSubnet`
+	if name := segments[len(segments)-1]; !strings.EqualFold(name, "AzureBastionSubnet") {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Subnet named `AzureBastionSubnet` but got a Subnet named %q", k, name))
+	}
+
+	return warnings, errors
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -331,3 +332,95 @@ func TestPortNumberOrZero(t *testing.T) {
 		})
 	}
 }
+
+func TestBastionHostName(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Errors int
+	}{
+		{
+			Name:   "",
+			Errors: 1,
+		},
+		{
+			Name:   "a",
+			Errors: 0,
+		},
+		{
+			Name:   "bastion-host_1.example",
+			Errors: 0,
+		},
+		{
+			Name:   "bastion_",
+			Errors: 0,
+		},
+		{
+			Name:   "-bastion",
+			Errors: 1,
+		},
+		{
+			Name:   "bastion.",
+			Errors: 1,
+		},
+		{
+			Name:   "bastion!",
+			Errors: 1,
+		},
+		{
+			Name:   strings.Repeat("a", 80),
+			Errors: 0,
+		},
+		{
+			Name:   strings.Repeat("a", 81),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := BastionHostName(tc.Name, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected BastionHostName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
+func TestBastionSubnetID(t *testing.T) {
+	cases := []struct {
+		ID     string
+		Errors int
+	}{
+		{
+			ID:     "",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/AzureBastionSubnet",
+			Errors: 0,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/azurebastionsubnet",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ID, func(t *testing.T) {
+			_, errors := BastionSubnetID(tc.ID, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected BastionSubnetID to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -122,6 +122,11 @@ func TestISO8601Duration(t *testing.T) {
 			Value:  "P1Y2M3DT7H42M3S",
 			Errors: 0,
 		},
+		{
+			// Weeks only
+			Value:  "P12W",
+			Errors: 0,
+		},
 		{
 			// Invalid prefix
 			Value:  "1Y2M3DT7H42M3S",
@@ -0,0 +1,110 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azuread/azuread"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/testhttp"
+)
+
+// NOTE: this package can't import the Provider (`azurerm`) since the tests within it use this package,
+// as such the Provider is passed in by the tests for each Service Package
+
+// Providers returns the Providers used in the acceptance tests, which includes the AzureAD Provider
+func Providers(provider terraform.ResourceProvider) map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"azurerm": provider,
+		"azuread": azuread.Provider().(*schema.Provider),
+	}
+}
+
+// PreCheck ensures the Environment Variables required for the acceptance tests are set, and starts the
+// recording/replaying of the HTTP requests for this test (if enabled)
+func PreCheck(t *testing.T) {
+	if testhttp.IsOffline() {
+		preCheckOffline(t)
+	}
+
+	variables := []string{
+		"ARM_CLIENT_ID",
+		"ARM_CLIENT_SECRET",
+		"ARM_SUBSCRIPTION_ID",
+		"ARM_TENANT_ID",
+		"ARM_TEST_LOCATION",
+		"ARM_TEST_LOCATION_ALT",
+	}
+
+	for _, variable := range variables {
+		value := os.Getenv(variable)
+		if value == "" {
+			t.Fatalf("`%s` must be set for acceptance tests!", variable)
+		}
+	}
+
+	if err := testhttp.Start(t.Name()); err != nil {
+		t.Fatalf("Error starting the HTTP recording for %q: %+v", t.Name(), err)
+	}
+}
+
+// offlineDefaults are the values used for the Environment Variables required for the acceptance tests
+// when running without network access, since the credentials are never used
+var offlineDefaults = map[string]string{
+	"ARM_CLIENT_ID":                   "00000000-0000-0000-0000-000000000000",
+	"ARM_CLIENT_SECRET":               "offline",
+	"ARM_SUBSCRIPTION_ID":             testhttp.RecordedSubscriptionId,
+	"ARM_TENANT_ID":                   "00000000-0000-0000-0000-000000000000",
+	"ARM_TEST_LOCATION":               "westeurope",
+	"ARM_TEST_LOCATION_ALT":           "northeurope",
+	"ARM_SKIP_CREDENTIALS_VALIDATION": "true",
+	"ARM_SKIP_PROVIDER_REGISTRATION":  "true",
+}
+
+func preCheckOffline(t *testing.T) {
+	for variable, value := range offlineDefaults {
+		if os.Getenv(variable) != "" {
+			continue
+		}
+
+		if err := os.Setenv(variable, value); err != nil {
+			t.Fatalf("Error setting `%s`: %+v", variable, err)
+		}
+	}
+
+	// the Subscription ID within the recorded requests is always sanitized
+	if testhttp.CurrentMode() == testhttp.ModeReplay {
+		if err := os.Setenv("ARM_SUBSCRIPTION_ID", testhttp.RecordedSubscriptionId); err != nil {
+			t.Fatalf("Error setting `ARM_SUBSCRIPTION_ID`: %+v", err)
+		}
+	}
+}
+
+// Location returns the primary location used in the acceptance tests
+func Location() string {
+	return envOrOfflineDefault("ARM_TEST_LOCATION")
+}
+
+// AltLocation returns the secondary location used in the acceptance tests
+func AltLocation() string {
+	return envOrOfflineDefault("ARM_TEST_LOCATION_ALT")
+}
+
+// envOrOfflineDefault is used for values which are needed before the PreCheck has run (e.g. in the test configurations)
+func envOrOfflineDefault(variable string) string {
+	value := os.Getenv(variable)
+	if value == "" && testhttp.IsOffline() {
+		return offlineDefaults[variable]
+	}
+
+	return value
+}
+
+// RequiresImportError returns the error expected when a resource which already exists needs to be imported
+func RequiresImportError(resourceName string) *regexp.Regexp {
+	message := "to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
+	return regexp.MustCompile(fmt.Sprintf(message, resourceName))
+}
@@ -0,0 +1,207 @@
+package clients
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/authorization"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cognitive"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databricks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datalake"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devspace"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/devtestlabs"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub"
+	frontdoorClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/frontdoor/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/graph"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup"
+	mapsClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/policy"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/scheduler"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/search"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabric"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/signalr"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/subscription"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+// Client contains the handles to all the specific Azure Resource Manager
+// resource classes' respective clients.
+type Client struct {
+	// StopContext is used for propagating control from Terraform Core (e.g. Ctrl/Cmd+C)
+	StopContext context.Context
+
+	// Account
+	ClientID              string
+	TenantID              string
+	SubscriptionID        string
+	UsingServicePrincipal bool
+	Environment           azure.Environment
+
+	// Features are the behaviours configured in the `features` block of the Provider
+	Features features.UserFeatures
+
+	// DefaultTags are the tags configured in the `default_tags` block of the Provider
+	DefaultTags tags.ProviderDefaults
+
+	// Services
+	AnalysisServices *analysisservices.Client
+	ApiManagement    *apimanagement.Client
+	AppInsights      *applicationinsights.Client
+	Automation       *automation.Client
+	Authorization    *authorization.Client
+	Batch            *batch.Client
+	Cdn              *cdn.Client
+	Cognitive        *cognitive.Client
+	Compute          *compute.Client
+	Containers       *containers.Client
+	Cosmos           *cosmos.Client
+	DataBricks       *databricks.Client
+	DataFactory      *datafactory.Client
+	Datalake         *datalake.Client
+	DevSpace         *devspace.Client
+	DevTestLabs      *devtestlabs.Client
+	Dns              *dns.Client
+	PrivateDns       *privatedns.Client
+	EventGrid        *eventgrid.Client
+	Eventhub         *eventhub.Client
+	Frontdoor        *frontdoorClient.Client
+	Graph            *graph.Client
+	HDInsight        *hdinsight.Client
+	IoTHub           *iothub.Client
+	KeyVault         *keyvault.Client
+	LogAnalytics     *loganalytics.Client
+	Logic            *logic.Client
+	ManagementGroups *managementgroup.Client
+	Maps             *mapsClient.Client
+	MariaDB          *mariadb.Client
+	Media            *media.Client
+	Monitor          *monitor.Client
+	MySQL            *mysql.Client
+	Msi              *msi.Client
+	Mssql            *mssql.Client
+	Network          *network.Client
+	NotificationHubs *notificationhub.Client
+	Policy           *policy.Client
+	Postgres         *postgres.Client
+	RecoveryServices *recoveryservices.Client
+	Redis            *redis.Client
+	Relay            *relay.Client
+	Resource         *resource.Client
+	Scheduler        *scheduler.Client
+	Search           *search.Client
+	SecurityCenter   *securitycenter.Client
+	ServiceBus       *servicebus.Client
+	ServiceFabric    *servicefabric.Client
+	SignalR          *signalr.Client
+	Storage          *storage.Client
+	StreamAnalytics  *streamanalytics.Client
+	Subscription     *subscription.Client
+	Sql              *sql.Client
+	TrafficManager   *trafficmanager.Client
+	Web              *web.Client
+}
+
+// Build configures each of the Service Clients using the specified Client Options
+//
+// NOTE: the Storage Client requires the Storage Accounts client from the parent, so is configured separately
+// TagDefaults returns the `default_tags` configured for this Provider block
+func (client Client) TagDefaults() tags.ProviderDefaults {
+	return client.DefaultTags
+}
+
+func (client *Client) Build(o *common.ClientOptions) {
+	client.AnalysisServices = analysisservices.BuildClient(o)
+	client.ApiManagement = apimanagement.BuildClient(o)
+	client.AppInsights = applicationinsights.BuildClient(o)
+	client.Automation = automation.BuildClient(o)
+	client.Authorization = authorization.BuildClient(o)
+	client.Batch = batch.BuildClient(o)
+	client.Cdn = cdn.BuildClient(o)
+	client.Cognitive = cognitive.BuildClient(o)
+	client.Compute = compute.BuildClient(o)
+	client.Containers = containers.BuildClient(o)
+	client.Cosmos = cosmos.BuildClient(o)
+	client.DataBricks = databricks.BuildClient(o)
+	client.DataFactory = datafactory.BuildClient(o)
+	client.Datalake = datalake.BuildClient(o)
+	client.DevSpace = devspace.BuildClient(o)
+	client.DevTestLabs = devtestlabs.BuildClient(o)
+	client.Dns = dns.BuildClient(o)
+	client.PrivateDns = privatedns.BuildClient(o)
+	client.EventGrid = eventgrid.BuildClient(o)
+	client.Eventhub = eventhub.BuildClient(o)
+	client.Frontdoor = frontdoorClient.BuildClient(o)
+	client.Graph = graph.BuildClient(o)
+	client.HDInsight = hdinsight.BuildClient(o)
+	client.IoTHub = iothub.BuildClient(o)
+	client.KeyVault = keyvault.BuildClient(o)
+	client.LogAnalytics = loganalytics.BuildClient(o)
+	client.Logic = logic.BuildClient(o)
+	client.ManagementGroups = managementgroup.BuildClient(o)
+	client.Maps = mapsClient.BuildClient(o)
+	client.MariaDB = mariadb.BuildClient(o)
+	client.Media = media.BuildClient(o)
+	client.Monitor = monitor.BuildClient(o)
+	client.MySQL = mysql.BuildClient(o)
+	client.Msi = msi.BuildClient(o)
+	client.Mssql = mssql.BuildClient(o)
+	client.Network = network.BuildClient(o)
+	client.NotificationHubs = notificationhub.BuildClient(o)
+	client.Policy = policy.BuildClient(o)
+	client.Postgres = postgres.BuildClient(o)
+	client.RecoveryServices = recoveryservices.BuildClient(o)
+	client.Redis = redis.BuildClient(o)
+	client.Relay = relay.BuildClient(o)
+	client.Resource = resource.BuildClient(o)
+	client.Scheduler = scheduler.BuildClient(o)
+	client.Search = search.BuildClient(o)
+	client.SecurityCenter = securitycenter.BuildClient(o)
+	client.ServiceBus = servicebus.BuildClient(o)
+	client.ServiceFabric = servicefabric.BuildClient(o)
+	client.SignalR = signalr.BuildClient(o)
+	client.StreamAnalytics = streamanalytics.BuildClient(o)
+	client.Subscription = subscription.BuildClient(o)
+	client.Sql = sql.BuildClient(o)
+	client.TrafficManager = trafficmanager.BuildClient(o)
+	client.Web = web.BuildClient(o)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform/httpclient"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/testhttp"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -38,12 +39,20 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), WithRetries())
+
+	// the Azure SDK wraps each request in autorest's own retry (for `autorest.StatusCodesForRetry` and to register
+	// Resource Providers) - since `WithRetries` handles transient failures (and the Resource Providers are
+	// registered when the Provider's configured) we only allow autorest a single attempt on top of it
+	c.RetryAttempts = 1
 	c.PollingDuration = o.PollingDuration
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		c.RequestInspector = WithCorrelationRequestID(CorrelationRequestID())
 	}
+
+	// when running the Acceptance Tests the requests can be recorded, replayed or faked
+	testhttp.Configure(c, o.SubscriptionId)
 }
 
 func setUserAgent(client *autorest.Client, partnerID string) {
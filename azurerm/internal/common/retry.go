@@ -0,0 +1,267 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry, when Azure doesn't specify one via `Retry-After`
+	retryBaseDelay = 5 * time.Second
+
+	// retryMaxDelay is the maximum delay between retries, when Azure doesn't specify one via `Retry-After`
+	retryMaxDelay = time.Minute
+
+	// retryMaxAttempts is the maximum number of retries for a single request
+	retryMaxAttempts = 10
+
+	// retryMaxElapsed is the maximum amount of time spent retrying a single request, regardless of the
+	// deadline for the request's context (e.g. the timeout for the resource)
+	retryMaxElapsed = 10 * time.Minute
+)
+
+// retryableStatusCodes are the status codes which indicate a transient condition regardless of the error code
+// (if any) returned, for example a gateway timeout - these are only retried for idempotent requests
+var retryableStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryableErrorCodes are the error codes returned from Azure Resource Manager which indicate a transient
+// condition, such that the request can be retried as-is
+var retryableErrorCodes = []string{
+	// another operation is in progress on this (or a related) resource, e.g. Subnets/NSGs/Route Tables
+	"AnotherOperationInProgress",
+	"CanceledAndSupersededDueToAnotherOperation",
+	"OperationNotAllowedOnResourceInTransitioningState",
+	"ReferencedResourceNotProvisioned",
+	"RetryableError",
+
+	// throttling
+	"ResourceRequestsThrottled",
+	"SubscriptionRequestsThrottled",
+	"TenantRequestsThrottled",
+	"TooManyRequests",
+
+	// transient failures within Resource Manager/the Resource Provider
+	"InternalServerError",
+	"ServerBusy",
+	"ServiceUnavailable",
+}
+
+// rateLimitRemainingHeaderPrefix is the prefix for the headers which return the number of requests remaining
+// before requests are throttled, e.g. `x-ms-ratelimit-remaining-subscription-writes`
+const rateLimitRemainingHeaderPrefix = "X-Ms-Ratelimit-Remaining-"
+
+// WithRetries returns an autorest.SendDecorator which retries requests which failed due to a transient condition -
+// either returning a transient status code (e.g. being throttled, or a `502`/`504` from a gateway) or one of a
+// known set of retryable error codes (for example when `AnotherOperationInProgress` is returned since another
+// resource is being modified).
+//
+// Since POST requests aren't idempotent these are only retried when Azure has explicitly asked us to, that is a
+// `429` or `503` which includes a `Retry-After` header.
+//
+// The delay between retries is taken from the `Retry-After` header when specified, otherwise an exponential
+// backoff is used. Retries stop once either the maximum number of attempts, the maximum elapsed time or the
+// deadline for the request's context (e.g. the timeout for the resource) is reached.
+func WithRetries() autorest.SendDecorator {
+	return withRetries(retryBaseDelay, retryMaxDelay, retryMaxElapsed)
+}
+
+func withRetries(baseDelay time.Duration, maxDelay time.Duration, maxElapsed time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			start := time.Now()
+
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if err != nil {
+					return resp, err
+				}
+
+				retryable, reason := isRetryableResponse(r.Method, resp)
+				if !retryable || attempt >= retryMaxAttempts {
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt, baseDelay, maxDelay)
+				if time.Since(start)+delay > maxElapsed {
+					log.Printf("[DEBUG] Not retrying %s %s (%s) since the delay of %s exceeds the maximum time spent retrying (%s)", r.Method, r.URL, reason, delay, maxElapsed)
+					return resp, err
+				}
+
+				// there's no point waiting if the deadline will have passed by the time we retry
+				ctx := r.Context()
+				if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+					log.Printf("[DEBUG] Not retrying %s %s (%s) since the delay of %s exceeds the deadline", r.Method, r.URL, reason, delay)
+					return resp, err
+				}
+
+				log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d) since %s%s", r.Method, r.URL, delay, attempt+1, reason, rateLimitsRemaining(resp))
+
+				// the response body is discarded, since we're going to retry
+				autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing()) // nolint: errcheck
+
+				if !autorest.DelayForBackoff(delay, 0, ctx.Done()) {
+					return resp, ctx.Err()
+				}
+			}
+		})
+	}
+}
+
+// isRetryableResponse returns whether the response indicates a transient condition, and if so why
+func isRetryableResponse(method string, resp *http.Response) (bool, string) {
+	if resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return false, ""
+	}
+
+	// POST requests aren't idempotent, so may have been (partially) processed - as such these are only
+	// retried when Azure explicitly tells us to retry the request
+	if strings.EqualFold(method, http.MethodPost) {
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+			return false, ""
+		}
+
+		if resp.Header.Get(autorest.HeaderRetryAfter) == "" {
+			return false, ""
+		}
+
+		return true, "the request was throttled (" + strconv.Itoa(resp.StatusCode) + ")"
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, "the request was throttled (429)"
+	}
+
+	for _, v := range retryableStatusCodes {
+		if resp.StatusCode == v {
+			return true, "the status code " + strconv.Itoa(resp.StatusCode) + " is retryable"
+		}
+	}
+
+	code := errorCodeFromResponse(resp)
+	if code == "" {
+		return false, ""
+	}
+
+	for _, v := range retryableErrorCodes {
+		if strings.EqualFold(code, v) {
+			return true, "the error code " + strconv.Quote(code) + " is retryable"
+		}
+	}
+
+	return false, ""
+}
+
+// errorCodeFromResponse returns the ARM error code from the response body (if any) - the body is
+// replaced such that it can be read again by the caller
+func errorCodeFromResponse(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil || len(body) == 0 {
+		return ""
+	}
+
+	var wrapped struct {
+		Error *struct {
+			Code string `json:"code"`
+		} `json:"error"`
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(body, &wrapped); err != nil {
+		return ""
+	}
+
+	if wrapped.Error != nil && wrapped.Error.Code != "" {
+		return wrapped.Error.Code
+	}
+
+	return wrapped.Code
+}
+
+// retryDelay returns the delay before the next attempt - which is the value of the `Retry-After` header
+// if specified, otherwise an exponential backoff
+func retryDelay(resp *http.Response, attempt int, baseDelay time.Duration, maxDelay time.Duration) time.Duration {
+	if v := resp.Header.Get(autorest.HeaderRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+
+		if date, err := http.ParseTime(v); err == nil {
+			if delay := time.Until(date); delay > 0 {
+				return delay
+			}
+			return 0
+		}
+	}
+
+	// when the rate limit has been exhausted there's no point retrying quickly
+	if rateLimitExhausted(resp) {
+		return maxDelay
+	}
+
+	delay := time.Duration(float64(baseDelay) * math.Pow(2, float64(attempt)))
+	if delay > maxDelay || delay <= 0 {
+		return maxDelay
+	}
+
+	return delay
+}
+
+func rateLimitExhausted(resp *http.Response) bool {
+	for key, values := range resp.Header {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(key), rateLimitRemainingHeaderPrefix) {
+			continue
+		}
+
+		for _, v := range values {
+			if remaining, err := strconv.Atoi(v); err == nil && remaining <= 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// rateLimitsRemaining returns the `x-ms-ratelimit-remaining-*` headers for logging purposes
+func rateLimitsRemaining(resp *http.Response) string {
+	limits := make([]string, 0)
+	for key, values := range resp.Header {
+		if strings.HasPrefix(http.CanonicalHeaderKey(key), rateLimitRemainingHeaderPrefix) {
+			limits = append(limits, strings.ToLower(key)+"="+strings.Join(values, ","))
+		}
+	}
+
+	if len(limits) == 0 {
+		return ""
+	}
+	sort.Strings(limits)
+
+	return " (" + strings.Join(limits, ", ") + ")"
+}
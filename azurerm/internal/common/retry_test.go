@@ -0,0 +1,328 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testResponse struct {
+	statusCode int
+	headers    map[string]string
+	body       string
+}
+
+// testRetrySender returns the responses in order, recording the request bodies it received
+func testRetrySender(responses []testResponse, requestBodies *[]string) autorest.Sender {
+	attempt := 0
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Body != nil {
+			body, _ := ioutil.ReadAll(r.Body)
+			*requestBodies = append(*requestBodies, string(body))
+		}
+
+		response := responses[attempt]
+		if attempt < len(responses)-1 {
+			attempt++
+		}
+
+		header := make(http.Header)
+		for k, v := range response.headers {
+			header.Set(k, v)
+		}
+
+		return &http.Response{
+			StatusCode: response.statusCode,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(response.body)),
+			Request:    r,
+		}, nil
+	})
+}
+
+func TestWithRetries(t *testing.T) {
+	testData := []struct {
+		Name               string
+		Method             string
+		Responses          []testResponse
+		ExpectedAttempts   int
+		ExpectedStatusCode int
+	}{
+		{
+			Name: "Success",
+			Responses: []testResponse{
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   1,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Not Retryable",
+			Responses: []testResponse{
+				{statusCode: http.StatusBadRequest, body: `{"error": {"code": "InvalidParameter", "message": "The value is invalid."}}`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   1,
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "Not Found",
+			Responses: []testResponse{
+				{statusCode: http.StatusNotFound},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   1,
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		{
+			Name: "Throttled",
+			Responses: []testResponse{
+				{statusCode: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "0"}},
+				{statusCode: http.StatusTooManyRequests},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   3,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Another Operation In Progress",
+			Responses: []testResponse{
+				{statusCode: http.StatusConflict, body: `{"error": {"code": "AnotherOperationInProgress", "message": "Another operation on this or dependent resource is in progress."}}`},
+				{statusCode: http.StatusCreated},
+			},
+			ExpectedAttempts:   2,
+			ExpectedStatusCode: http.StatusCreated,
+		},
+		{
+			Name: "Retryable Error without a Wrapper",
+			Responses: []testResponse{
+				{statusCode: http.StatusInternalServerError, body: `{"code": "RetryableError", "message": "A retryable error occurred."}`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   2,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Gateway Errors without an Error Code",
+			Responses: []testResponse{
+				{statusCode: http.StatusBadGateway, body: `<html><body>Bad Gateway</body></html>`},
+				{statusCode: http.StatusGatewayTimeout},
+				{statusCode: http.StatusServiceUnavailable, body: `<html><body>Service Unavailable</body></html>`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   4,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Request Timeout",
+			Responses: []testResponse{
+				{statusCode: http.StatusRequestTimeout},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   2,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name:   "POST Internal Server Error",
+			Method: http.MethodPost,
+			Responses: []testResponse{
+				{statusCode: http.StatusInternalServerError, body: `{"error": {"code": "InternalServerError", "message": "An internal error occurred."}}`},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   1,
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			Name:   "POST Gateway Timeout",
+			Method: http.MethodPost,
+			Responses: []testResponse{
+				{statusCode: http.StatusGatewayTimeout},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   1,
+			ExpectedStatusCode: http.StatusGatewayTimeout,
+		},
+		{
+			Name:   "POST Throttled without Retry-After",
+			Method: http.MethodPost,
+			Responses: []testResponse{
+				{statusCode: http.StatusTooManyRequests},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   1,
+			ExpectedStatusCode: http.StatusTooManyRequests,
+		},
+		{
+			Name:   "POST Throttled with Retry-After",
+			Method: http.MethodPost,
+			Responses: []testResponse{
+				{statusCode: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "0"}},
+				{statusCode: http.StatusServiceUnavailable, headers: map[string]string{"Retry-After": "0"}},
+				{statusCode: http.StatusOK},
+			},
+			ExpectedAttempts:   3,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name: "Maximum Attempts",
+			Responses: []testResponse{
+				{statusCode: http.StatusTooManyRequests},
+			},
+			ExpectedAttempts:   retryMaxAttempts + 1,
+			ExpectedStatusCode: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		method := v.Method
+		if method == "" {
+			method = http.MethodPut
+		}
+
+		requestBodies := make([]string, 0)
+		sender := autorest.DecorateSender(testRetrySender(v.Responses, &requestBodies), withRetries(time.Millisecond, time.Millisecond, time.Minute))
+
+		req, _ := http.NewRequest(method, "https://management.azure.com/example", strings.NewReader(`{"hello": "world"}`))
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatusCode {
+			t.Fatalf("Expected the Status Code to be %d but got %d", v.ExpectedStatusCode, resp.StatusCode)
+		}
+
+		if len(requestBodies) != v.ExpectedAttempts {
+			t.Fatalf("Expected %d attempts but got %d", v.ExpectedAttempts, len(requestBodies))
+		}
+
+		// the request body must be sent in full on every attempt
+		for _, body := range requestBodies {
+			if body != `{"hello": "world"}` {
+				t.Fatalf("Expected the request body to be sent on every attempt but got %q", body)
+			}
+		}
+
+		// the response body must still be readable by the caller
+		// (the test sender repeats the last response once it's exhausted)
+		last := v.Responses[len(v.Responses)-1]
+		if len(requestBodies) < len(v.Responses) {
+			last = v.Responses[len(requestBodies)-1]
+		}
+		if len(last.body) > 0 {
+			body, _ := ioutil.ReadAll(resp.Body)
+			if string(body) != last.body {
+				t.Fatalf("Expected the response body to be readable but got %q", string(body))
+			}
+		}
+	}
+}
+
+func TestWithRetriesBoundedByDeadline(t *testing.T) {
+	requestBodies := make([]string, 0)
+	responses := []testResponse{
+		{statusCode: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "60"}},
+		{statusCode: http.StatusOK},
+	}
+	sender := autorest.DecorateSender(testRetrySender(responses, &requestBodies), withRetries(time.Millisecond, 10*time.Millisecond, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/example", nil)
+	resp, err := sender.Do(req.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// the Retry-After exceeds the deadline, so the throttled response should be returned
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+}
+
+func TestWithRetriesBoundedByMaxElapsed(t *testing.T) {
+	requestBodies := make([]string, 0)
+	responses := []testResponse{
+		{statusCode: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "60"}},
+		{statusCode: http.StatusOK},
+	}
+	sender := autorest.DecorateSender(testRetrySender(responses, &requestBodies), withRetries(time.Millisecond, 10*time.Millisecond, time.Second))
+
+	// there's no deadline for this request, but the Retry-After exceeds the maximum time spent retrying
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/example", nil)
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Headers  map[string]string
+		Attempt  int
+		Expected time.Duration
+	}{
+		{
+			Name:     "First Attempt",
+			Attempt:  0,
+			Expected: 5 * time.Second,
+		},
+		{
+			Name:     "Third Attempt",
+			Attempt:  2,
+			Expected: 20 * time.Second,
+		},
+		{
+			Name:     "Capped",
+			Attempt:  10,
+			Expected: time.Minute,
+		},
+		{
+			Name:     "Retry After",
+			Headers:  map[string]string{"Retry-After": "17"},
+			Attempt:  3,
+			Expected: 17 * time.Second,
+		},
+		{
+			Name:     "Rate Limit Exhausted",
+			Headers:  map[string]string{"x-ms-ratelimit-remaining-subscription-writes": "0"},
+			Attempt:  0,
+			Expected: time.Minute,
+		},
+		{
+			Name:     "Rate Limit Remaining",
+			Headers:  map[string]string{"x-ms-ratelimit-remaining-subscription-writes": "1199"},
+			Attempt:  0,
+			Expected: 5 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := &http.Response{
+			Header: make(http.Header),
+		}
+		for key, value := range v.Headers {
+			resp.Header.Set(key, value)
+		}
+
+		actual := retryDelay(resp, v.Attempt, retryBaseDelay, retryMaxDelay)
+		if actual != v.Expected {
+			t.Fatalf("Expected a delay of %s but got %s", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package features
+
+// UserFeatures contains the behaviours which can be toggled by users via the `features` block
+// within the Provider block
+type UserFeatures struct {
+	KeyVault KeyVaultFeatures
+}
+
+// KeyVaultFeatures controls how the Key Vault resources interact with Soft Delete
+type KeyVaultFeatures struct {
+	// PurgeSoftDeleteOnDestroy purges Key Vaults (and Certificates, Keys and Secrets within them)
+	// when they're destroyed, rather than leaving them in a soft-deleted state
+	PurgeSoftDeleteOnDestroy bool
+
+	// RecoverSoftDeletedKeyVaults recovers a soft-deleted Key Vault (or Certificate, Key or Secret)
+	// with the same name when the resource is created, rather than returning an error
+	RecoverSoftDeletedKeyVaults bool
+}
+
+// Default returns the features which are used when they're not configured in the Provider block
+func Default() UserFeatures {
+	return UserFeatures{
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy:    false,
+			RecoverSoftDeletedKeyVaults: true,
+		},
+	}
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// segment is a single key-value pair within a Resource ID, e.g. `virtualNetworks/{name}`
+type segment struct {
+	// Key is the key of this segment within the Resource ID, e.g. `virtualNetworks`
+	Key string
+
+	// Field is the name of the field on the generated struct, e.g. `VirtualNetworkName`
+	Field string
+}
+
+type definition struct {
+	// Name is the name of the Resource Type, e.g. `Subnet` (which generates a `SubnetID`)
+	Name string
+
+	// Description is a human-friendly description of the Resource Type, e.g. `Subnet`
+	Description string
+
+	// Provider is the Resource Provider for this Resource Type, e.g. `Microsoft.Network`
+	// this is empty for Resource IDs which aren't nested within a Resource Provider
+	Provider string
+
+	// Segments are the segments following the Resource Provider - the last of which is the Name
+	Segments []segment
+}
+
+func child(key string) []segment {
+	return []segment{{Key: key, Field: "Name"}}
+}
+
+func nested(parentKey, parentField, key string) []segment {
+	return []segment{{Key: parentKey, Field: parentField}, {Key: key, Field: "Name"}}
+}
+
+var definitions = []definition{
+	{Name: "AppService", Description: "App Service", Provider: "Microsoft.Web", Segments: child("sites")},
+	{Name: "AppServicePlan", Description: "App Service Plan", Provider: "Microsoft.Web", Segments: child("serverfarms")},
+	{Name: "ApplicationGateway", Description: "Application Gateway", Provider: "Microsoft.Network", Segments: child("applicationGateways")},
+	{Name: "AvailabilitySet", Description: "Availability Set", Provider: "Microsoft.Compute", Segments: child("availabilitySets")},
+	{Name: "BastionHost", Description: "Bastion Host", Provider: "Microsoft.Network", Segments: child("bastionHosts")},
+	{Name: "ContainerRegistry", Description: "Container Registry", Provider: "Microsoft.ContainerRegistry", Segments: child("registries")},
+	{Name: "DnsZone", Description: "DNS Zone", Provider: "Microsoft.Network", Segments: child("dnszones")},
+	{Name: "EventHubNamespace", Description: "EventHub Namespace", Provider: "Microsoft.EventHub", Segments: child("namespaces")},
+	{Name: "FrontDoor", Description: "Front Door", Provider: "Microsoft.Network", Segments: child("frontDoors")},
+	{Name: "FrontDoorFirewallPolicy", Description: "Front Door Firewall Policy", Provider: "Microsoft.Network", Segments: child("frontDoorWebApplicationFirewallPolicies")},
+	{Name: "KeyVault", Description: "Key Vault", Provider: "Microsoft.KeyVault", Segments: child("vaults")},
+	{Name: "KubernetesCluster", Description: "Kubernetes Cluster", Provider: "Microsoft.ContainerService", Segments: child("managedClusters")},
+	{Name: "KubernetesClusterNodePool", Description: "Kubernetes Cluster Node Pool", Provider: "Microsoft.ContainerService", Segments: nested("managedClusters", "ClusterName", "agentPools")},
+	{Name: "LoadBalancer", Description: "Load Balancer", Provider: "Microsoft.Network", Segments: child("loadBalancers")},
+	{Name: "LogAnalyticsWorkspace", Description: "Log Analytics Workspace", Provider: "Microsoft.OperationalInsights", Segments: child("workspaces")},
+	{Name: "ManagedDisk", Description: "Managed Disk", Provider: "Microsoft.Compute", Segments: child("disks")},
+	{Name: "NatGateway", Description: "NAT Gateway", Provider: "Microsoft.Network", Segments: child("natGateways")},
+	{Name: "NetworkInterface", Description: "Network Interface", Provider: "Microsoft.Network", Segments: child("networkInterfaces")},
+	{Name: "NetworkSecurityGroup", Description: "Network Security Group", Provider: "Microsoft.Network", Segments: child("networkSecurityGroups")},
+	{Name: "PrivateDnsZone", Description: "Private DNS Zone", Provider: "Microsoft.Network", Segments: child("privateDnsZones")},
+	{Name: "PrivateEndpoint", Description: "Private Endpoint", Provider: "Microsoft.Network", Segments: child("privateEndpoints")},
+	{Name: "PrivateEndpointConnection", Description: "Private Endpoint Connection", Provider: "Microsoft.Network", Segments: nested("privateLinkServices", "PrivateLinkServiceName", "privateEndpointConnections")},
+	{Name: "PrivateLinkService", Description: "Private Link Service", Provider: "Microsoft.Network", Segments: child("privateLinkServices")},
+	{Name: "PublicIPAddress", Description: "Public IP Address", Provider: "Microsoft.Network", Segments: child("publicIPAddresses")},
+	{Name: "PublicIPPrefix", Description: "Public IP Prefix", Provider: "Microsoft.Network", Segments: child("publicIPPrefixes")},
+	{Name: "ResourceGroup", Description: "Resource Group", Segments: child("resourceGroups")},
+	{Name: "RouteTable", Description: "Route Table", Provider: "Microsoft.Network", Segments: child("routeTables")},
+	{Name: "ServiceBusNamespace", Description: "ServiceBus Namespace", Provider: "Microsoft.ServiceBus", Segments: child("namespaces")},
+	{Name: "SqlDatabase", Description: "SQL Database", Provider: "Microsoft.Sql", Segments: nested("servers", "ServerName", "databases")},
+	{Name: "SqlFailoverGroup", Description: "SQL Failover Group", Provider: "Microsoft.Sql", Segments: nested("servers", "ServerName", "failoverGroups")},
+	{Name: "SqlServer", Description: "SQL Server", Provider: "Microsoft.Sql", Segments: child("servers")},
+	{Name: "StorageAccount", Description: "Storage Account", Provider: "Microsoft.Storage", Segments: child("storageAccounts")},
+	{Name: "StorageManagementPolicy", Description: "Storage Management Policy", Provider: "Microsoft.Storage", Segments: nested("storageAccounts", "StorageAccountName", "managementPolicies")},
+	{Name: "Subnet", Description: "Subnet", Provider: "Microsoft.Network", Segments: nested("virtualNetworks", "VirtualNetworkName", "subnets")},
+	{Name: "UserAssignedIdentity", Description: "User Assigned Identity", Provider: "Microsoft.ManagedIdentity", Segments: child("userAssignedIdentities")},
+	{Name: "VirtualMachine", Description: "Virtual Machine", Provider: "Microsoft.Compute", Segments: child("virtualMachines")},
+	{Name: "VirtualNetwork", Description: "Virtual Network", Provider: "Microsoft.Network", Segments: child("virtualNetworks")},
+}
+
+func main() {
+	for _, def := range definitions {
+		fileName := toSnakeCase(def.Name)
+
+		if err := generate(fmt.Sprintf("%s.go", fileName), idTemplate, def); err != nil {
+			log.Fatalf("Error generating the ID for %q: %+v", def.Name, err)
+		}
+
+		if err := generate(fmt.Sprintf("%s_test.go", fileName), testTemplate, def); err != nil {
+			log.Fatalf("Error generating the Tests for %q: %+v", def.Name, err)
+		}
+	}
+}
+
+func generate(fileName string, tmpl *template.Template, def definition) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, def); err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("Error formatting %q: %+v", fileName, err)
+	}
+
+	return ioutil.WriteFile(fileName, formatted, os.FileMode(0644))
+}
+
+var snakeCaseRegex = regexp.MustCompile("([a-z0-9])([A-Z])")
+
+func toSnakeCase(input string) string {
+	input = strings.Replace(input, "IP", "Ip", -1)
+	return strings.ToLower(snakeCaseRegex.ReplaceAllString(input, "${1}_${2}"))
+}
+
+var funcs = template.FuncMap{
+	// path returns the Resource ID format string for this definition
+	"path": func(def definition) string {
+		path := "/subscriptions/%s"
+		if def.Provider == "" {
+			// the Resource Group is the Resource
+			return path + "/resourceGroups/%s"
+		}
+
+		path += "/resourceGroups/%s/providers/" + def.Provider
+		for _, s := range def.Segments {
+			path += "/" + s.Key + "/%s"
+		}
+		return path
+	},
+
+	// example returns an example Resource ID for this definition, optionally lower-casing the keys
+	"example": func(def definition, lowerCaseKeys bool) string {
+		key := func(input string) string {
+			if lowerCaseKeys {
+				return strings.ToLower(input)
+			}
+			return input
+		}
+
+		path := "/" + key("subscriptions") + "/00000000-0000-0000-0000-000000000000"
+		if def.Provider == "" {
+			return path + "/" + key("resourceGroups") + "/group1"
+		}
+
+		path += "/" + key("resourceGroups") + "/group1/" + key("providers") + "/" + def.Provider
+		for i, s := range def.Segments {
+			path += fmt.Sprintf("/%s/%s%d", key(s.Key), strings.ToLower(s.Field), i+1)
+		}
+		return path
+	},
+
+	// value returns the example value for the specified segment
+	"value": func(s segment, i int) string {
+		return fmt.Sprintf("%s%d", strings.ToLower(s.Field), i+1)
+	},
+
+	// partial returns an example Resource ID which is missing the value for the specified segment
+	"partial": func(def definition, index int) string {
+		path := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/" + def.Provider
+		for i, s := range def.Segments[0:index] {
+			path += fmt.Sprintf("/%s/%s%d", s.Key, strings.ToLower(s.Field), i+1)
+		}
+		return path + "/" + def.Segments[index].Key + "/"
+	},
+}
+
+var idTemplate = template.Must(template.New("id").Funcs(funcs).Parse(`// Code generated by generator/main.go; DO NOT EDIT.
+
+package resourceid
+
+import "fmt"
+
+// {{ .Name }}ID is a strongly-typed Resource ID for a {{ .Description }}
+type {{ .Name }}ID struct {
+	SubscriptionId string
+{{- if .Provider }}
+	ResourceGroup  string
+{{- end }}
+{{- range .Segments }}
+	{{ .Field }} string
+{{- end }}
+}
+
+// Parse{{ .Name }}ID parses the specified Resource ID into a {{ .Name }}ID
+func Parse{{ .Name }}ID(input string) (*{{ .Name }}ID, error) {
+	id, err := parse(input, "{{ .Provider }}")
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing {{ .Description }} ID %q: %+v", input, err)
+	}
+
+	result := {{ .Name }}ID{
+		SubscriptionId: id.SubscriptionID,
+{{- if .Provider }}
+		ResourceGroup:  id.ResourceGroup,
+{{- else }}
+		Name:           id.ResourceGroup,
+{{- end }}
+	}
+{{ if .Provider }}
+{{- range .Segments }}
+	if result.{{ .Field }}, err = id.PopSegment("{{ .Key }}"); err != nil {
+		return nil, fmt.Errorf("Error parsing {{ $.Description }} ID %q: %+v", input, err)
+	}
+{{ end }}
+{{- end }}
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, fmt.Errorf("Error parsing {{ .Description }} ID %q: %+v", input, err)
+	}
+
+	return &result, nil
+}
+
+// String returns the Resource ID for this {{ .Description }}
+func (id {{ .Name }}ID) String() string {
+	return fmt.Sprintf("{{ path . }}", id.SubscriptionId{{ if .Provider }}, id.ResourceGroup{{ end }}{{ range .Segments }}, id.{{ .Field }}{{ end }})
+}
+
+// Validate{{ .Name }}ID validates that the specified value is a {{ .Description }} ID
+// and is intended to be used as the ` + "`ValidateFunc`" + ` on a Schema field
+func Validate{{ .Name }}ID(i interface{}, k string) (warnings []string, errors []error) {
+	return validate(i, k, func(input string) error {
+		_, err := Parse{{ .Name }}ID(input)
+		return err
+	})
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(`// Code generated by generator/main.go; DO NOT EDIT.
+
+package resourceid
+
+import "testing"
+
+func TestParse{{ .Name }}ID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *{{ .Name }}ID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "No Resource Groups Segment",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+{{- if .Provider }}
+		{
+			Name:     "No Provider",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: nil,
+		},
+		{
+			Name:     "Wrong Provider",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/examples/example1",
+			Expected: nil,
+		},
+{{- range $i, $s := .Segments }}
+		{
+			Name:     "Missing {{ $s.Key }} Value",
+			Input:    "{{ partial $ $i }}",
+			Expected: nil,
+		},
+{{- end }}
+{{- else }}
+		{
+			Name:     "Nested within a Provider",
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/examples/example1",
+			Expected: nil,
+		},
+{{- end }}
+		{
+			Name:     "Additional Segment",
+			Input:    "{{ example . false }}/extras/extra1",
+			Expected: nil,
+		},
+		{
+			Name:  "Valid",
+			Input: "{{ example . false }}",
+			Expected: &{{ .Name }}ID{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+{{- if .Provider }}
+				ResourceGroup:  "group1",
+{{- range $i, $s := .Segments }}
+				{{ $s.Field }}: "{{ value $s $i }}",
+{{- end }}
+{{- else }}
+				Name:           "group1",
+{{- end }}
+			},
+		},
+		{
+			Name:  "Valid with Different Casing",
+			Input: "{{ example . true }}",
+			Expected: &{{ .Name }}ID{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+{{- if .Provider }}
+				ResourceGroup:  "group1",
+{{- range $i, $s := .Segments }}
+				{{ $s.Field }}: "{{ value $s $i }}",
+{{- end }}
+{{- else }}
+				Name:           "group1",
+{{- end }}
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse{{ .Name }}ID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+
+		if _, errors := Validate{{ .Name }}ID(v.Input, "id"); len(errors) > 0 {
+			t.Fatalf("Expected no validation errors for %q but got: %+v", v.Input, errors)
+		}
+	}
+}
+
+func Test{{ .Name }}IDString(t *testing.T) {
+	input := "{{ example . false }}"
+	id, err := Parse{{ .Name }}ID(input)
+	if err != nil {
+		t.Fatalf("Error parsing %q: %+v", input, err)
+	}
+
+	if actual := id.String(); actual != input {
+		t.Fatalf("Expected %q but got %q", input, actual)
+	}
+}
+`))
@@ -0,0 +1,49 @@
+// Package resourceid contains strongly-typed Resource IDs for Azure Resources, each of which
+// can be parsed from (and converted back to) the Resource ID returned from the Azure API.
+//
+// The types within this package are generated from the definitions in `generator/main.go` -
+// to add a new Resource ID add it to the list of definitions and then run `go generate`.
+package resourceid
+
+//go:generate go run generator/main.go
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+// parse parses the specified Resource ID and ensures it's for the specified Resource Provider
+// (which can be empty for Resource IDs which aren't within a Resource Provider, e.g. Resource Groups)
+func parse(input string, provider string) (*azure.ResourceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(id.Provider, provider) {
+		if provider == "" {
+			return nil, fmt.Errorf("expected no Resource Provider but got %q", id.Provider)
+		}
+
+		return nil, fmt.Errorf("expected the Resource Provider %q but got %q", provider, id.Provider)
+	}
+
+	return id, nil
+}
+
+// validate is a helper for building a `schema.SchemaValidateFunc` from a parse function
+func validate(i interface{}, k string, parseFunc func(input string) error) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if err := parseFunc(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is an invalid ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
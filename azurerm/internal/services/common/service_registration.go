@@ -3,7 +3,7 @@ package common
 import "github.com/hashicorp/terraform/helper/schema"
 
 // NOTE: unfortunately this has to live in it's own package to avoid a circular reference
-// since the Services will need to access ArmClient which is moving to `internal/common`
+// since the Services will need to access the `clients.Client` in `internal/clients`
 
 type ServiceRegistration interface {
 	// Name is the name of this Service
@@ -8,6 +8,7 @@ import (
 )
 
 type Client struct {
+	AgentPoolsClient         *containerservice.AgentPoolsClient
 	KubernetesClustersClient *containerservice.ManagedClustersClient
 	GroupsClient             *containerinstance.ContainerGroupsClient
 	RegistriesClient         *containerregistry.RegistriesClient
@@ -34,7 +35,11 @@ func BuildClient(o *common.ClientOptions) *Client {
 	KubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&KubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
 
+	AgentPoolsClient := containerservice.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&AgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
+		AgentPoolsClient:         &AgentPoolsClient,
 		KubernetesClustersClient: &KubernetesClustersClient,
 		GroupsClient:             &GroupsClient,
 		RegistriesClient:         &RegistriesClient,
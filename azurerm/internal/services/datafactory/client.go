@@ -10,6 +10,7 @@ type Client struct {
 	FactoriesClient     *datafactory.FactoriesClient
 	LinkedServiceClient *datafactory.LinkedServicesClient
 	PipelinesClient     *datafactory.PipelinesClient
+	TriggersClient      *datafactory.TriggersClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -26,10 +27,14 @@ func BuildClient(o *common.ClientOptions) *Client {
 	PipelinesClient := datafactory.NewPipelinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PipelinesClient.Client, o.ResourceManagerAuthorizer)
 
+	TriggersClient := datafactory.NewTriggersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DatasetClient:       &DatasetClient,
 		FactoriesClient:     &FactoriesClient,
 		LinkedServiceClient: &LinkedServiceClient,
 		PipelinesClient:     &PipelinesClient,
+		TriggersClient:      &TriggersClient,
 	}
 }
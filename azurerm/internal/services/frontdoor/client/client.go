@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2019-04-01/frontdoor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+type Client struct {
+	FrontDoorsClient       *frontdoor.FrontDoorsClient
+	FrontDoorsPolicyClient *frontdoor.PoliciesClient
+}
+
+func BuildClient(o *common.ClientOptions) *Client {
+
+	FrontDoorsClient := frontdoor.NewFrontDoorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&FrontDoorsClient.Client, o.ResourceManagerAuthorizer)
+
+	FrontDoorsPolicyClient := frontdoor.NewPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&FrontDoorsPolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		FrontDoorsClient:       &FrontDoorsClient,
+		FrontDoorsPolicyClient: &FrontDoorsPolicyClient,
+	}
+}
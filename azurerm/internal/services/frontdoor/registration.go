@@ -0,0 +1,23 @@
+package frontdoor
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Front Door"
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_frontdoor":                 resourceArmFrontDoor(),
+		"azurerm_frontdoor_firewall_policy": resourceArmFrontDoorFirewallPolicy(),
+	}
+}
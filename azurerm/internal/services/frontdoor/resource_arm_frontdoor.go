@@ -0,0 +1,1205 @@
+package frontdoor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2019-04-01/frontdoor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFrontDoor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFrontDoorCreateUpdate,
+		Read:   resourceArmFrontDoorRead,
+		Update: resourceArmFrontDoorCreateUpdate,
+		Delete: resourceArmFrontDoorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(6 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateFrontDoorName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"load_balancer_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"enforce_backend_pools_certificate_name_check": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"routing_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"accepted_protocols": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(frontdoor.HTTP),
+									string(frontdoor.HTTPS),
+								}, false),
+							},
+						},
+
+						"patterns_to_match": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 25,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"frontend_endpoints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"forwarding_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backend_pool_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: ValidateBackendPoolRoutingRuleName,
+									},
+
+									"cache_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"cache_use_dynamic_compression": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"cache_query_parameter_strip_directive": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.StripNone),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.StripAll),
+											string(frontdoor.StripNone),
+										}, false),
+									},
+
+									"custom_forwarding_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"forwarding_protocol": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  string(frontdoor.HTTPSOnly),
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.HTTPOnly),
+											string(frontdoor.HTTPSOnly),
+											string(frontdoor.MatchRequest),
+										}, false),
+									},
+								},
+							},
+						},
+
+						"redirect_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"redirect_protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.RedirectProtocolHTTPOnly),
+											string(frontdoor.RedirectProtocolHTTPSOnly),
+											string(frontdoor.RedirectProtocolMatchRequest),
+										}, false),
+									},
+
+									"redirect_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.Found),
+											string(frontdoor.Moved),
+											string(frontdoor.PermanentRedirect),
+											string(frontdoor.TemporaryRedirect),
+										}, false),
+									},
+
+									"custom_host": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"custom_fragment": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"custom_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"custom_query_string": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"backend_pool_load_balancing": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 5000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+
+						"sample_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"successful_samples_required": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"additional_latency_milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"backend_pool_health_probe": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 5000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "/",
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(frontdoor.HTTP),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.HTTP),
+								string(frontdoor.HTTPS),
+							}, false),
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      120,
+							ValidateFunc: validation.IntAtLeast(5),
+						},
+					},
+				},
+			},
+
+			"backend_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+
+						"backend": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"address": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"host_header": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"http_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.PortNumber,
+									},
+
+									"https_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.PortNumber,
+									},
+
+									"priority": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntBetween(1, 5),
+									},
+
+									"weight": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      50,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+
+						"health_probe_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+
+						"load_balancing_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+					},
+				},
+			},
+
+			"frontend_endpoint": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateBackendPoolRoutingRuleName,
+						},
+
+						"host_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"session_affinity_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"session_affinity_ttl_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"web_application_firewall_policy_link_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: resourceid.ValidateFrontDoorFirewallPolicyID,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+
+		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
+			return ValidateFrontDoorSettings(d)
+		},
+	}
+}
+
+func resourceArmFrontDoorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*clients.Client)
+	client := armClient.Frontdoor.FrontDoorsClient
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Front Door creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if flags.RequireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Front Door %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_frontdoor", *existing.ID)
+		}
+	}
+
+	// the ID's of the child resources are needed to link them together, so we build up the ID
+	// of the Front Door rather than waiting for it to be returned from the API
+	frontDoorId := resourceid.FrontDoorID{
+		SubscriptionId: armClient.SubscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+
+	// Front Door is a global resource
+	location := "Global"
+	friendlyName := d.Get("friendly_name").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	enabledState := frontdoor.EnabledStateDisabled
+	if d.Get("load_balancer_enabled").(bool) {
+		enabledState = frontdoor.EnabledStateEnabled
+	}
+
+	enforceCertificateNameCheck := frontdoor.EnforceCertificateNameCheckEnabledStateDisabled
+	if d.Get("enforce_backend_pools_certificate_name_check").(bool) {
+		enforceCertificateNameCheck = frontdoor.EnforceCertificateNameCheckEnabledStateEnabled
+	}
+
+	routingRules, err := expandFrontDoorRoutingRules(d, frontDoorId)
+	if err != nil {
+		return fmt.Errorf("Error expanding `routing_rule`: %+v", err)
+	}
+
+	parameters := frontdoor.FrontDoor{
+		Location: utils.String(location),
+		Properties: &frontdoor.Properties{
+			FriendlyName:          utils.String(friendlyName),
+			RoutingRules:          routingRules,
+			BackendPools:          expandFrontDoorBackendPools(d, frontDoorId),
+			BackendPoolsSettings:  &frontdoor.BackendPoolsSettings{EnforceCertificateNameCheck: enforceCertificateNameCheck},
+			FrontendEndpoints:     expandFrontDoorFrontendEndpoints(d, frontDoorId),
+			HealthProbeSettings:   expandFrontDoorHealthProbeSettings(d, frontDoorId),
+			LoadBalancingSettings: expandFrontDoorLoadBalancingSettings(d, frontDoorId),
+			EnabledState:          enabledState,
+		},
+		Tags: tags.Expand(t, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Front Door %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Front Door %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Front Door %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Front Door %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmFrontDoorRead(d, meta)
+}
+
+func resourceArmFrontDoorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseFrontDoorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Front Door %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Front Door %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("cname", props.Cname)
+		d.Set("friendly_name", props.FriendlyName)
+		d.Set("load_balancer_enabled", props.EnabledState == frontdoor.EnabledStateEnabled)
+
+		enforceCertificateNameCheck := false
+		if settings := props.BackendPoolsSettings; settings != nil {
+			enforceCertificateNameCheck = settings.EnforceCertificateNameCheck == frontdoor.EnforceCertificateNameCheckEnabledStateEnabled
+		}
+		d.Set("enforce_backend_pools_certificate_name_check", enforceCertificateNameCheck)
+
+		routingRules, err := flattenFrontDoorRoutingRules(props.RoutingRules)
+		if err != nil {
+			return fmt.Errorf("Error flattening `routing_rule`: %+v", err)
+		}
+		if err := d.Set("routing_rule", routingRules); err != nil {
+			return fmt.Errorf("Error setting `routing_rule`: %+v", err)
+		}
+
+		backendPools, err := flattenFrontDoorBackendPools(props.BackendPools)
+		if err != nil {
+			return fmt.Errorf("Error flattening `backend_pool`: %+v", err)
+		}
+		if err := d.Set("backend_pool", backendPools); err != nil {
+			return fmt.Errorf("Error setting `backend_pool`: %+v", err)
+		}
+
+		if err := d.Set("backend_pool_health_probe", flattenFrontDoorHealthProbeSettings(props.HealthProbeSettings)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool_health_probe`: %+v", err)
+		}
+
+		if err := d.Set("backend_pool_load_balancing", flattenFrontDoorLoadBalancingSettings(props.LoadBalancingSettings)); err != nil {
+			return fmt.Errorf("Error setting `backend_pool_load_balancing`: %+v", err)
+		}
+
+		if err := d.Set("frontend_endpoint", flattenFrontDoorFrontendEndpoints(props.FrontendEndpoints)); err != nil {
+			return fmt.Errorf("Error setting `frontend_endpoint`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags, meta)
+}
+
+func resourceArmFrontDoorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseFrontDoorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Front Door %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Front Door %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func expandFrontDoorRoutingRules(d *schema.ResourceData, frontDoorId resourceid.FrontDoorID) (*[]frontdoor.RoutingRule, error) {
+	vs := d.Get("routing_rule").([]interface{})
+	results := make([]frontdoor.RoutingRule, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		forwardingConfigs := v["forwarding_configuration"].([]interface{})
+		redirectConfigs := v["redirect_configuration"].([]interface{})
+
+		if len(forwardingConfigs) > 0 && len(redirectConfigs) > 0 {
+			return nil, fmt.Errorf("Conflict between `forwarding_configuration` and `redirect_configuration` for Routing Rule %q (only one can be specified)", name)
+		}
+
+		enabledState := frontdoor.RoutingRuleEnabledStateDisabled
+		if v["enabled"].(bool) {
+			enabledState = frontdoor.RoutingRuleEnabledStateEnabled
+		}
+
+		acceptedProtocols := make([]frontdoor.Protocol, 0)
+		for _, protocol := range v["accepted_protocols"].([]interface{}) {
+			acceptedProtocols = append(acceptedProtocols, frontdoor.Protocol(protocol.(string)))
+		}
+
+		frontendEndpoints := make([]frontdoor.SubResource, 0)
+		for _, endpoint := range v["frontend_endpoints"].([]interface{}) {
+			frontendEndpoints = append(frontendEndpoints, frontdoor.SubResource{
+				ID: utils.String(fmt.Sprintf("%s/frontendEndpoints/%s", frontDoorId.String(), endpoint.(string))),
+			})
+		}
+
+		rule := frontdoor.RoutingRule{
+			ID:   utils.String(fmt.Sprintf("%s/routingRules/%s", frontDoorId.String(), name)),
+			Name: utils.String(name),
+			RoutingRuleProperties: &frontdoor.RoutingRuleProperties{
+				AcceptedProtocols: &acceptedProtocols,
+				FrontendEndpoints: &frontendEndpoints,
+				PatternsToMatch:   utils.ExpandStringSlice(v["patterns_to_match"].([]interface{})),
+				EnabledState:      enabledState,
+			},
+		}
+
+		if len(forwardingConfigs) > 0 && forwardingConfigs[0] != nil {
+			rule.RoutingRuleProperties.RouteConfiguration = expandFrontDoorForwardingConfiguration(forwardingConfigs[0].(map[string]interface{}), frontDoorId)
+		}
+
+		if len(redirectConfigs) > 0 && redirectConfigs[0] != nil {
+			rule.RoutingRuleProperties.RouteConfiguration = expandFrontDoorRedirectConfiguration(redirectConfigs[0].(map[string]interface{}))
+		}
+
+		results = append(results, rule)
+	}
+
+	return &results, nil
+}
+
+func expandFrontDoorForwardingConfiguration(v map[string]interface{}, frontDoorId resourceid.FrontDoorID) frontdoor.ForwardingConfiguration {
+	backendPoolName := v["backend_pool_name"].(string)
+
+	config := frontdoor.ForwardingConfiguration{
+		ForwardingProtocol: frontdoor.ForwardingProtocol(v["forwarding_protocol"].(string)),
+		BackendPool: &frontdoor.SubResource{
+			ID: utils.String(fmt.Sprintf("%s/backendPools/%s", frontDoorId.String(), backendPoolName)),
+		},
+		OdataType: frontdoor.OdataTypeMicrosoftAzureFrontDoorModelsFrontdoorForwardingConfiguration,
+	}
+
+	if customForwardingPath := v["custom_forwarding_path"].(string); customForwardingPath != "" {
+		config.CustomForwardingPath = utils.String(customForwardingPath)
+	}
+
+	if v["cache_enabled"].(bool) {
+		dynamicCompression := frontdoor.DynamicCompressionEnabledDisabled
+		if v["cache_use_dynamic_compression"].(bool) {
+			dynamicCompression = frontdoor.DynamicCompressionEnabledEnabled
+		}
+
+		config.CacheConfiguration = &frontdoor.CacheConfiguration{
+			QueryParameterStripDirective: frontdoor.Query(v["cache_query_parameter_strip_directive"].(string)),
+			DynamicCompression:           dynamicCompression,
+		}
+	}
+
+	return config
+}
+
+func expandFrontDoorRedirectConfiguration(v map[string]interface{}) frontdoor.RedirectConfiguration {
+	config := frontdoor.RedirectConfiguration{
+		RedirectProtocol: frontdoor.RedirectProtocol(v["redirect_protocol"].(string)),
+		RedirectType:     frontdoor.RedirectType(v["redirect_type"].(string)),
+		OdataType:        frontdoor.OdataTypeMicrosoftAzureFrontDoorModelsFrontdoorRedirectConfiguration,
+	}
+
+	if customHost := v["custom_host"].(string); customHost != "" {
+		config.CustomHost = utils.String(customHost)
+	}
+
+	if customFragment := v["custom_fragment"].(string); customFragment != "" {
+		config.CustomFragment = utils.String(customFragment)
+	}
+
+	if customPath := v["custom_path"].(string); customPath != "" {
+		config.CustomPath = utils.String(customPath)
+	}
+
+	if customQueryString := v["custom_query_string"].(string); customQueryString != "" {
+		config.CustomQueryString = utils.String(customQueryString)
+	}
+
+	return config
+}
+
+func flattenFrontDoorRoutingRules(input *[]frontdoor.RoutingRule) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, rule := range *input {
+		output := make(map[string]interface{})
+
+		if rule.ID != nil {
+			output["id"] = *rule.ID
+		}
+
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+
+		if props := rule.RoutingRuleProperties; props != nil {
+			output["enabled"] = props.EnabledState == frontdoor.RoutingRuleEnabledStateEnabled
+
+			acceptedProtocols := make([]interface{}, 0)
+			if props.AcceptedProtocols != nil {
+				for _, protocol := range *props.AcceptedProtocols {
+					acceptedProtocols = append(acceptedProtocols, string(protocol))
+				}
+			}
+			output["accepted_protocols"] = acceptedProtocols
+
+			output["patterns_to_match"] = utils.FlattenStringSlice(props.PatternsToMatch)
+
+			frontendEndpoints := make([]interface{}, 0)
+			if props.FrontendEndpoints != nil {
+				for _, endpoint := range *props.FrontendEndpoints {
+					if endpoint.ID == nil {
+						continue
+					}
+
+					name, err := parseFrontDoorChildResourceName(*endpoint.ID, "frontendEndpoints")
+					if err != nil {
+						return nil, err
+					}
+					frontendEndpoints = append(frontendEndpoints, name)
+				}
+			}
+			output["frontend_endpoints"] = frontendEndpoints
+
+			forwardingConfigs := make([]interface{}, 0)
+			redirectConfigs := make([]interface{}, 0)
+			if routeConfig := props.RouteConfiguration; routeConfig != nil {
+				if config, ok := routeConfig.AsForwardingConfiguration(); ok {
+					forwardingConfig, err := flattenFrontDoorForwardingConfiguration(config)
+					if err != nil {
+						return nil, err
+					}
+					forwardingConfigs = append(forwardingConfigs, forwardingConfig)
+				}
+
+				if config, ok := routeConfig.AsRedirectConfiguration(); ok {
+					redirectConfigs = append(redirectConfigs, flattenFrontDoorRedirectConfiguration(config))
+				}
+			}
+			output["forwarding_configuration"] = forwardingConfigs
+			output["redirect_configuration"] = redirectConfigs
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
+
+func flattenFrontDoorForwardingConfiguration(input *frontdoor.ForwardingConfiguration) (map[string]interface{}, error) {
+	output := map[string]interface{}{
+		"forwarding_protocol":                   string(input.ForwardingProtocol),
+		"cache_enabled":                         false,
+		"cache_use_dynamic_compression":         false,
+		"cache_query_parameter_strip_directive": string(frontdoor.StripNone),
+	}
+
+	if pool := input.BackendPool; pool != nil && pool.ID != nil {
+		name, err := parseFrontDoorChildResourceName(*pool.ID, "backendPools")
+		if err != nil {
+			return nil, err
+		}
+		output["backend_pool_name"] = name
+	}
+
+	if input.CustomForwardingPath != nil {
+		output["custom_forwarding_path"] = *input.CustomForwardingPath
+	}
+
+	if cache := input.CacheConfiguration; cache != nil {
+		output["cache_enabled"] = true
+		output["cache_use_dynamic_compression"] = cache.DynamicCompression == frontdoor.DynamicCompressionEnabledEnabled
+		output["cache_query_parameter_strip_directive"] = string(cache.QueryParameterStripDirective)
+	}
+
+	return output, nil
+}
+
+func flattenFrontDoorRedirectConfiguration(input *frontdoor.RedirectConfiguration) map[string]interface{} {
+	output := map[string]interface{}{
+		"redirect_protocol": string(input.RedirectProtocol),
+		"redirect_type":     string(input.RedirectType),
+	}
+
+	if input.CustomHost != nil {
+		output["custom_host"] = *input.CustomHost
+	}
+
+	if input.CustomFragment != nil {
+		output["custom_fragment"] = *input.CustomFragment
+	}
+
+	if input.CustomPath != nil {
+		output["custom_path"] = *input.CustomPath
+	}
+
+	if input.CustomQueryString != nil {
+		output["custom_query_string"] = *input.CustomQueryString
+	}
+
+	return output
+}
+
+func expandFrontDoorBackendPools(d *schema.ResourceData, frontDoorId resourceid.FrontDoorID) *[]frontdoor.BackendPool {
+	vs := d.Get("backend_pool").([]interface{})
+	results := make([]frontdoor.BackendPool, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		healthProbeName := v["health_probe_name"].(string)
+		loadBalancingName := v["load_balancing_name"].(string)
+
+		backends := make([]frontdoor.Backend, 0)
+		for _, rawBackend := range v["backend"].([]interface{}) {
+			backend := rawBackend.(map[string]interface{})
+
+			enabledState := frontdoor.Disabled
+			if backend["enabled"].(bool) {
+				enabledState = frontdoor.Enabled
+			}
+
+			backends = append(backends, frontdoor.Backend{
+				Address:           utils.String(backend["address"].(string)),
+				BackendHostHeader: utils.String(backend["host_header"].(string)),
+				EnabledState:      enabledState,
+				HTTPPort:          utils.Int32(int32(backend["http_port"].(int))),
+				HTTPSPort:         utils.Int32(int32(backend["https_port"].(int))),
+				Priority:          utils.Int32(int32(backend["priority"].(int))),
+				Weight:            utils.Int32(int32(backend["weight"].(int))),
+			})
+		}
+
+		results = append(results, frontdoor.BackendPool{
+			ID:   utils.String(fmt.Sprintf("%s/backendPools/%s", frontDoorId.String(), name)),
+			Name: utils.String(name),
+			BackendPoolProperties: &frontdoor.BackendPoolProperties{
+				Backends: &backends,
+				HealthProbeSettings: &frontdoor.SubResource{
+					ID: utils.String(fmt.Sprintf("%s/healthProbeSettings/%s", frontDoorId.String(), healthProbeName)),
+				},
+				LoadBalancingSettings: &frontdoor.SubResource{
+					ID: utils.String(fmt.Sprintf("%s/loadBalancingSettings/%s", frontDoorId.String(), loadBalancingName)),
+				},
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenFrontDoorBackendPools(input *[]frontdoor.BackendPool) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, pool := range *input {
+		output := make(map[string]interface{})
+
+		if pool.ID != nil {
+			output["id"] = *pool.ID
+		}
+
+		if pool.Name != nil {
+			output["name"] = *pool.Name
+		}
+
+		if props := pool.BackendPoolProperties; props != nil {
+			backends := make([]interface{}, 0)
+			if props.Backends != nil {
+				for _, backend := range *props.Backends {
+					result := map[string]interface{}{
+						"enabled": backend.EnabledState == frontdoor.Enabled,
+					}
+
+					if backend.Address != nil {
+						result["address"] = *backend.Address
+					}
+					if backend.BackendHostHeader != nil {
+						result["host_header"] = *backend.BackendHostHeader
+					}
+					if backend.HTTPPort != nil {
+						result["http_port"] = int(*backend.HTTPPort)
+					}
+					if backend.HTTPSPort != nil {
+						result["https_port"] = int(*backend.HTTPSPort)
+					}
+					if backend.Priority != nil {
+						result["priority"] = int(*backend.Priority)
+					}
+					if backend.Weight != nil {
+						result["weight"] = int(*backend.Weight)
+					}
+
+					backends = append(backends, result)
+				}
+			}
+			output["backend"] = backends
+
+			if probe := props.HealthProbeSettings; probe != nil && probe.ID != nil {
+				name, err := parseFrontDoorChildResourceName(*probe.ID, "healthProbeSettings")
+				if err != nil {
+					return nil, err
+				}
+				output["health_probe_name"] = name
+			}
+
+			if settings := props.LoadBalancingSettings; settings != nil && settings.ID != nil {
+				name, err := parseFrontDoorChildResourceName(*settings.ID, "loadBalancingSettings")
+				if err != nil {
+					return nil, err
+				}
+				output["load_balancing_name"] = name
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results, nil
+}
+
+func expandFrontDoorFrontendEndpoints(d *schema.ResourceData, frontDoorId resourceid.FrontDoorID) *[]frontdoor.FrontendEndpoint {
+	vs := d.Get("frontend_endpoint").([]interface{})
+	results := make([]frontdoor.FrontendEndpoint, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+
+		sessionAffinityEnabled := frontdoor.SessionAffinityEnabledStateDisabled
+		if v["session_affinity_enabled"].(bool) {
+			sessionAffinityEnabled = frontdoor.SessionAffinityEnabledStateEnabled
+		}
+
+		endpoint := frontdoor.FrontendEndpoint{
+			ID:   utils.String(fmt.Sprintf("%s/frontendEndpoints/%s", frontDoorId.String(), name)),
+			Name: utils.String(name),
+			FrontendEndpointProperties: &frontdoor.FrontendEndpointProperties{
+				HostName:                    utils.String(v["host_name"].(string)),
+				SessionAffinityEnabledState: sessionAffinityEnabled,
+				SessionAffinityTTLSeconds:   utils.Int32(int32(v["session_affinity_ttl_seconds"].(int))),
+			},
+		}
+
+		if policyId := v["web_application_firewall_policy_link_id"].(string); policyId != "" {
+			endpoint.FrontendEndpointProperties.WebApplicationFirewallPolicyLink = &frontdoor.FrontendEndpointUpdateParametersWebApplicationFirewallPolicyLink{
+				ID: utils.String(policyId),
+			}
+		}
+
+		results = append(results, endpoint)
+	}
+
+	return &results
+}
+
+func flattenFrontDoorFrontendEndpoints(input *[]frontdoor.FrontendEndpoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, endpoint := range *input {
+		output := make(map[string]interface{})
+
+		if endpoint.ID != nil {
+			output["id"] = *endpoint.ID
+		}
+
+		if endpoint.Name != nil {
+			output["name"] = *endpoint.Name
+		}
+
+		if props := endpoint.FrontendEndpointProperties; props != nil {
+			if props.HostName != nil {
+				output["host_name"] = *props.HostName
+			}
+
+			output["session_affinity_enabled"] = props.SessionAffinityEnabledState == frontdoor.SessionAffinityEnabledStateEnabled
+
+			if props.SessionAffinityTTLSeconds != nil {
+				output["session_affinity_ttl_seconds"] = int(*props.SessionAffinityTTLSeconds)
+			}
+
+			if link := props.WebApplicationFirewallPolicyLink; link != nil && link.ID != nil {
+				output["web_application_firewall_policy_link_id"] = *link.ID
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandFrontDoorHealthProbeSettings(d *schema.ResourceData, frontDoorId resourceid.FrontDoorID) *[]frontdoor.HealthProbeSettingsModel {
+	vs := d.Get("backend_pool_health_probe").([]interface{})
+	results := make([]frontdoor.HealthProbeSettingsModel, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+
+		results = append(results, frontdoor.HealthProbeSettingsModel{
+			ID:   utils.String(fmt.Sprintf("%s/healthProbeSettings/%s", frontDoorId.String(), name)),
+			Name: utils.String(name),
+			HealthProbeSettingsProperties: &frontdoor.HealthProbeSettingsProperties{
+				IntervalInSeconds: utils.Int32(int32(v["interval_in_seconds"].(int))),
+				Path:              utils.String(v["path"].(string)),
+				Protocol:          frontdoor.Protocol(v["protocol"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenFrontDoorHealthProbeSettings(input *[]frontdoor.HealthProbeSettingsModel) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, probe := range *input {
+		output := make(map[string]interface{})
+
+		if probe.ID != nil {
+			output["id"] = *probe.ID
+		}
+
+		if probe.Name != nil {
+			output["name"] = *probe.Name
+		}
+
+		if props := probe.HealthProbeSettingsProperties; props != nil {
+			output["protocol"] = string(props.Protocol)
+
+			if props.IntervalInSeconds != nil {
+				output["interval_in_seconds"] = int(*props.IntervalInSeconds)
+			}
+
+			if props.Path != nil {
+				output["path"] = *props.Path
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandFrontDoorLoadBalancingSettings(d *schema.ResourceData, frontDoorId resourceid.FrontDoorID) *[]frontdoor.LoadBalancingSettingsModel {
+	vs := d.Get("backend_pool_load_balancing").([]interface{})
+	results := make([]frontdoor.LoadBalancingSettingsModel, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+
+		results = append(results, frontdoor.LoadBalancingSettingsModel{
+			ID:   utils.String(fmt.Sprintf("%s/loadBalancingSettings/%s", frontDoorId.String(), name)),
+			Name: utils.String(name),
+			LoadBalancingSettingsProperties: &frontdoor.LoadBalancingSettingsProperties{
+				SampleSize:                    utils.Int32(int32(v["sample_size"].(int))),
+				SuccessfulSamplesRequired:     utils.Int32(int32(v["successful_samples_required"].(int))),
+				AdditionalLatencyMilliseconds: utils.Int32(int32(v["additional_latency_milliseconds"].(int))),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenFrontDoorLoadBalancingSettings(input *[]frontdoor.LoadBalancingSettingsModel) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, settings := range *input {
+		output := make(map[string]interface{})
+
+		if settings.ID != nil {
+			output["id"] = *settings.ID
+		}
+
+		if settings.Name != nil {
+			output["name"] = *settings.Name
+		}
+
+		if props := settings.LoadBalancingSettingsProperties; props != nil {
+			if props.SampleSize != nil {
+				output["sample_size"] = int(*props.SampleSize)
+			}
+
+			if props.SuccessfulSamplesRequired != nil {
+				output["successful_samples_required"] = int(*props.SuccessfulSamplesRequired)
+			}
+
+			if props.AdditionalLatencyMilliseconds != nil {
+				output["additional_latency_milliseconds"] = int(*props.AdditionalLatencyMilliseconds)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+// parseFrontDoorChildResourceName returns the name of the child resource (e.g. a Backend Pool) from its ID
+func parseFrontDoorChildResourceName(input string, childType string) (string, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return "", err
+	}
+
+	// the Front Door API doesn't consistently case the segments within these ID's, so look them up case-insensitively
+	name, err := id.PopSegment(childType)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing %q: %+v", input, err)
+	}
+
+	return name, nil
+}
@@ -0,0 +1,715 @@
+package frontdoor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/frontdoor/mgmt/2019-04-01/frontdoor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFrontDoorFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFrontDoorFirewallPolicyCreateUpdate,
+		Read:   resourceArmFrontDoorFirewallPolicyRead,
+		Update: resourceArmFrontDoorFirewallPolicyCreateUpdate,
+		Delete: resourceArmFrontDoorFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateFirewallPolicyName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(frontdoor.Prevention),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(frontdoor.Detection),
+					string(frontdoor.Prevention),
+				}, false),
+			},
+
+			"redirect_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"custom_block_response_status_code": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ValidateFunc: validation.IntInSlice([]int{
+					200,
+					403,
+					405,
+					406,
+					429,
+				}),
+			},
+
+			"custom_block_response_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ValidateCustomBlockResponseBody,
+			},
+
+			"custom_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateFirewallPolicyName,
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.Allow),
+								string(frontdoor.Block),
+								string(frontdoor.Log),
+								string(frontdoor.Redirect),
+							}, false),
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(frontdoor.MatchRule),
+								string(frontdoor.RateLimitRule),
+							}, false),
+						},
+
+						"rate_limit_duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"rate_limit_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"match_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.Cookies),
+											string(frontdoor.PostArgs),
+											string(frontdoor.QueryString),
+											string(frontdoor.RemoteAddr),
+											string(frontdoor.RequestBody),
+											string(frontdoor.RequestHeader),
+											string(frontdoor.RequestMethod),
+											string(frontdoor.RequestURI),
+										}, false),
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(frontdoor.Any),
+											string(frontdoor.BeginsWith),
+											string(frontdoor.Contains),
+											string(frontdoor.EndsWith),
+											string(frontdoor.Equal),
+											string(frontdoor.GeoMatch),
+											string(frontdoor.GreaterThan),
+											string(frontdoor.GreaterThanOrEqual),
+											string(frontdoor.IPMatch),
+											string(frontdoor.LessThan),
+											string(frontdoor.LessThanOrEqual),
+											string(frontdoor.RegEx),
+										}, false),
+									},
+
+									"selector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"transforms": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 5,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(frontdoor.Lowercase),
+												string(frontdoor.RemoveNulls),
+												string(frontdoor.Trim),
+												string(frontdoor.Uppercase),
+												string(frontdoor.URLDecode),
+												string(frontdoor.URLEncode),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"managed_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_group_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1000,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"rule_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.NoEmptyStrings,
+												},
+
+												"enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+
+												"action": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(frontdoor.Allow),
+														string(frontdoor.Block),
+														string(frontdoor.Log),
+														string(frontdoor.Redirect),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"frontend_endpoint_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Front Door Firewall Policy creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if flags.RequireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_frontdoor_firewall_policy", *existing.ID)
+		}
+	}
+
+	// Front Door Firewall Policies are global resources
+	location := "Global"
+	t := d.Get("tags").(map[string]interface{})
+
+	enabled := frontdoor.PolicyEnabledStateDisabled
+	if d.Get("enabled").(bool) {
+		enabled = frontdoor.PolicyEnabledStateEnabled
+	}
+
+	policySettings := &frontdoor.PolicySettings{
+		EnabledState: enabled,
+		Mode:         frontdoor.PolicyMode(d.Get("mode").(string)),
+	}
+
+	if redirectUrl := d.Get("redirect_url").(string); redirectUrl != "" {
+		policySettings.RedirectURL = utils.String(redirectUrl)
+	}
+
+	if statusCode := d.Get("custom_block_response_status_code").(int); statusCode != 0 {
+		policySettings.CustomBlockResponseStatusCode = utils.Int32(int32(statusCode))
+	}
+
+	if body := d.Get("custom_block_response_body").(string); body != "" {
+		policySettings.CustomBlockResponseBody = utils.String(body)
+	}
+
+	parameters := frontdoor.WebApplicationFirewallPolicy{
+		Location: utils.String(location),
+		WebApplicationFirewallPolicyProperties: &frontdoor.WebApplicationFirewallPolicyProperties{
+			PolicySettings: policySettings,
+			CustomRules:    expandFrontDoorFirewallPolicyCustomRules(d.Get("custom_rule").([]interface{})),
+			ManagedRules:   expandFrontDoorFirewallPolicyManagedRules(d.Get("managed_rule").([]interface{})),
+		},
+		Tags: tags.Expand(t, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Front Door Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Front Door Firewall Policy %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmFrontDoorFirewallPolicyRead(d, meta)
+}
+
+func resourceArmFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseFrontDoorFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Front Door Firewall Policy %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Front Door Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.WebApplicationFirewallPolicyProperties; props != nil {
+		if settings := props.PolicySettings; settings != nil {
+			d.Set("enabled", settings.EnabledState == frontdoor.PolicyEnabledStateEnabled)
+			d.Set("mode", string(settings.Mode))
+			d.Set("redirect_url", settings.RedirectURL)
+			d.Set("custom_block_response_body", settings.CustomBlockResponseBody)
+
+			statusCode := 0
+			if settings.CustomBlockResponseStatusCode != nil {
+				statusCode = int(*settings.CustomBlockResponseStatusCode)
+			}
+			d.Set("custom_block_response_status_code", statusCode)
+		}
+
+		if err := d.Set("custom_rule", flattenFrontDoorFirewallPolicyCustomRules(props.CustomRules)); err != nil {
+			return fmt.Errorf("Error setting `custom_rule`: %+v", err)
+		}
+
+		if err := d.Set("managed_rule", flattenFrontDoorFirewallPolicyManagedRules(props.ManagedRules)); err != nil {
+			return fmt.Errorf("Error setting `managed_rule`: %+v", err)
+		}
+
+		if err := d.Set("frontend_endpoint_ids", flattenFrontDoorFirewallPolicyFrontendEndpointLinks(props.FrontendEndpointLinks)); err != nil {
+			return fmt.Errorf("Error setting `frontend_endpoint_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags, meta)
+}
+
+func resourceArmFrontDoorFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseFrontDoorFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Front Door Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Front Door Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func expandFrontDoorFirewallPolicyCustomRules(input []interface{}) *frontdoor.CustomRuleList {
+	rules := make([]frontdoor.CustomRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		enabled := frontdoor.CustomRuleEnabledStateDisabled
+		if v["enabled"].(bool) {
+			enabled = frontdoor.CustomRuleEnabledStateEnabled
+		}
+
+		rules = append(rules, frontdoor.CustomRule{
+			Name:                       utils.String(v["name"].(string)),
+			Priority:                   utils.Int32(int32(v["priority"].(int))),
+			EnabledState:               enabled,
+			RuleType:                   frontdoor.RuleType(v["type"].(string)),
+			RateLimitDurationInMinutes: utils.Int32(int32(v["rate_limit_duration_in_minutes"].(int))),
+			RateLimitThreshold:         utils.Int32(int32(v["rate_limit_threshold"].(int))),
+			MatchConditions:            expandFrontDoorFirewallPolicyMatchConditions(v["match_condition"].([]interface{})),
+			Action:                     frontdoor.ActionType(v["action"].(string)),
+		})
+	}
+
+	return &frontdoor.CustomRuleList{
+		Rules: &rules,
+	}
+}
+
+func expandFrontDoorFirewallPolicyMatchConditions(input []interface{}) *[]frontdoor.MatchCondition {
+	results := make([]frontdoor.MatchCondition, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		transforms := make([]frontdoor.TransformType, 0)
+		for _, transform := range v["transforms"].([]interface{}) {
+			transforms = append(transforms, frontdoor.TransformType(transform.(string)))
+		}
+
+		condition := frontdoor.MatchCondition{
+			MatchVariable:   frontdoor.MatchVariable(v["match_variable"].(string)),
+			Operator:        frontdoor.Operator(v["operator"].(string)),
+			NegateCondition: utils.Bool(v["negation_condition"].(bool)),
+			MatchValue:      utils.ExpandStringSlice(v["match_values"].([]interface{})),
+			Transforms:      &transforms,
+		}
+
+		if selector := v["selector"].(string); selector != "" {
+			condition.Selector = utils.String(selector)
+		}
+
+		results = append(results, condition)
+	}
+
+	return &results
+}
+
+func expandFrontDoorFirewallPolicyManagedRules(input []interface{}) *frontdoor.ManagedRuleSetList {
+	ruleSets := make([]frontdoor.ManagedRuleSet, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		overrides := make([]frontdoor.ManagedRuleGroupOverride, 0)
+		for _, rawOverride := range v["override"].([]interface{}) {
+			override := rawOverride.(map[string]interface{})
+
+			rules := make([]frontdoor.ManagedRuleOverride, 0)
+			for _, rawRule := range override["rule"].([]interface{}) {
+				rule := rawRule.(map[string]interface{})
+
+				enabled := frontdoor.ManagedRuleEnabledStateDisabled
+				if rule["enabled"].(bool) {
+					enabled = frontdoor.ManagedRuleEnabledStateEnabled
+				}
+
+				rules = append(rules, frontdoor.ManagedRuleOverride{
+					RuleID:       utils.String(rule["rule_id"].(string)),
+					EnabledState: enabled,
+					Action:       frontdoor.ActionType(rule["action"].(string)),
+				})
+			}
+
+			overrides = append(overrides, frontdoor.ManagedRuleGroupOverride{
+				RuleGroupName: utils.String(override["rule_group_name"].(string)),
+				Rules:         &rules,
+			})
+		}
+
+		ruleSets = append(ruleSets, frontdoor.ManagedRuleSet{
+			RuleSetType:        utils.String(v["type"].(string)),
+			RuleSetVersion:     utils.String(v["version"].(string)),
+			RuleGroupOverrides: &overrides,
+		})
+	}
+
+	return &frontdoor.ManagedRuleSetList{
+		ManagedRuleSets: &ruleSets,
+	}
+}
+
+func flattenFrontDoorFirewallPolicyCustomRules(input *frontdoor.CustomRuleList) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Rules == nil {
+		return results
+	}
+
+	for _, rule := range *input.Rules {
+		output := map[string]interface{}{
+			"action":  string(rule.Action),
+			"enabled": rule.EnabledState == frontdoor.CustomRuleEnabledStateEnabled,
+			"type":    string(rule.RuleType),
+		}
+
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+
+		if rule.Priority != nil {
+			output["priority"] = int(*rule.Priority)
+		}
+
+		if rule.RateLimitDurationInMinutes != nil {
+			output["rate_limit_duration_in_minutes"] = int(*rule.RateLimitDurationInMinutes)
+		}
+
+		if rule.RateLimitThreshold != nil {
+			output["rate_limit_threshold"] = int(*rule.RateLimitThreshold)
+		}
+
+		output["match_condition"] = flattenFrontDoorFirewallPolicyMatchConditions(rule.MatchConditions)
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenFrontDoorFirewallPolicyMatchConditions(input *[]frontdoor.MatchCondition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, condition := range *input {
+		output := map[string]interface{}{
+			"match_variable": string(condition.MatchVariable),
+			"operator":       string(condition.Operator),
+		}
+
+		if condition.Selector != nil {
+			output["selector"] = *condition.Selector
+		}
+
+		if condition.NegateCondition != nil {
+			output["negation_condition"] = *condition.NegateCondition
+		}
+
+		output["match_values"] = utils.FlattenStringSlice(condition.MatchValue)
+
+		transforms := make([]interface{}, 0)
+		if condition.Transforms != nil {
+			for _, transform := range *condition.Transforms {
+				transforms = append(transforms, string(transform))
+			}
+		}
+		output["transforms"] = transforms
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenFrontDoorFirewallPolicyManagedRules(input *frontdoor.ManagedRuleSetList) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.ManagedRuleSets == nil {
+		return results
+	}
+
+	for _, ruleSet := range *input.ManagedRuleSets {
+		output := make(map[string]interface{})
+
+		if ruleSet.RuleSetType != nil {
+			output["type"] = *ruleSet.RuleSetType
+		}
+
+		if ruleSet.RuleSetVersion != nil {
+			output["version"] = *ruleSet.RuleSetVersion
+		}
+
+		overrides := make([]interface{}, 0)
+		if ruleSet.RuleGroupOverrides != nil {
+			for _, override := range *ruleSet.RuleGroupOverrides {
+				o := make(map[string]interface{})
+
+				if override.RuleGroupName != nil {
+					o["rule_group_name"] = *override.RuleGroupName
+				}
+
+				rules := make([]interface{}, 0)
+				if override.Rules != nil {
+					for _, rule := range *override.Rules {
+						r := map[string]interface{}{
+							"action":  string(rule.Action),
+							"enabled": rule.EnabledState == frontdoor.ManagedRuleEnabledStateEnabled,
+						}
+
+						if rule.RuleID != nil {
+							r["rule_id"] = *rule.RuleID
+						}
+
+						rules = append(rules, r)
+					}
+				}
+				o["rule"] = rules
+
+				overrides = append(overrides, o)
+			}
+		}
+		output["override"] = overrides
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenFrontDoorFirewallPolicyFrontendEndpointLinks(input *[]frontdoor.FrontendEndpointLink) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, link := range *input {
+		if link.ID != nil {
+			results = append(results, *link.ID)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,267 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFrontDoorFirewallPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_frontdoor_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "Prevention"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoorFirewallPolicy_requiresImport(t *testing.T) {
+	if !flags.RequireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_frontdoor_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFrontDoorFirewallPolicy_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_frontdoor_firewall_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoorFirewallPolicy_update(t *testing.T) {
+	resourceName := "azurerm_frontdoor_firewall_policy.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mode", "Detection"),
+					resource.TestCheckResourceAttr(resourceName, "custom_block_response_status_code", "403"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.1.type", "RateLimitRule"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule.0.override.0.rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMFrontDoorFirewallPolicy_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFrontDoorFirewallPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Front Door Firewall Policy not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseFrontDoorFirewallPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+		ctx := testAccProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Front Door Firewall Policy %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Frontdoor.FrontDoorsPolicyClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFrontDoorFirewallPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsPolicyClient
+	ctx := testAccProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_frontdoor_firewall_policy" {
+			continue
+		}
+
+		id, err := resourceid.ParseFrontDoorFirewallPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Frontdoor.FrontDoorsPolicyClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Front Door Firewall Policy %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFrontDoorFirewallPolicy_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "acctestwafpolicy%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMFrontDoorFirewallPolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFrontDoorFirewallPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_frontdoor_firewall_policy" "import" {
+  name                = "${azurerm_frontdoor_firewall_policy.test.name}"
+  resource_group_name = "${azurerm_frontdoor_firewall_policy.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccAzureRMFrontDoorFirewallPolicy_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                              = "acctestwafpolicy%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  enabled                           = true
+  mode                              = "Detection"
+  redirect_url                      = "https://www.contoso.com"
+  custom_block_response_status_code = 403
+  custom_block_response_body        = "PGh0bWw+CjxoZWFkZXI+PHRpdGxlPkhlbGxvPC90aXRsZT48L2hlYWRlcj4KPGJvZHk+CkhlbGxvIHdvcmxkCjwvYm9keT4KPC9odG1sPg=="
+
+  custom_rule {
+    name     = "Rule1"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "Block"
+
+    match_condition {
+      match_variable     = "RemoteAddr"
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["192.168.1.0/24", "10.0.0.0/24"]
+    }
+  }
+
+  custom_rule {
+    name                           = "Rule2"
+    enabled                        = true
+    priority                       = 2
+    type                           = "RateLimitRule"
+    rate_limit_duration_in_minutes = 1
+    rate_limit_threshold           = 10
+    action                         = "Block"
+
+    match_condition {
+      match_variable     = "RequestHeader"
+      selector           = "UserAgent"
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["windows"]
+      transforms         = ["Lowercase", "Trim"]
+    }
+  }
+
+  managed_rule {
+    type    = "DefaultRuleSet"
+    version = "preview-0.1"
+
+    override {
+      rule_group_name = "PHP"
+
+      rule {
+        rule_id = "933111"
+        enabled = false
+        action  = "Block"
+      }
+    }
+  }
+
+  tags = {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,434 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMFrontDoor_basic(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.forwarding_configuration.0.backend_pool_name", "backend-pool"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backend_pool.0.backend.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "frontend_endpoint.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "cname"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_requiresImport(t *testing.T) {
+	if !flags.RequireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFrontDoor_requiresImport(ri, location),
+				ExpectError: acceptance.RequiresImportError("azurerm_frontdoor"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_update(t *testing.T) {
+	resourceName := "azurerm_frontdoor.test"
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFrontDoor_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.forwarding_configuration.0.cache_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.1.redirect_configuration.0.redirect_type", "Moved"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMFrontDoor_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFrontDoorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFrontDoor_missingDefaultFrontendEndpoint(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := acceptance.Location()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFrontDoorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMFrontDoor_missingDefaultFrontendEndpoint(ri, location),
+				ExpectError: regexp.MustCompile("a `frontend_endpoint` with the `host_name`"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFrontDoorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Front Door not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseFrontDoorID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsClient
+		ctx := testAccProvider.Meta().(*clients.Client).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Front Door %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Frontdoor.FrontDoorsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFrontDoorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*clients.Client).Frontdoor.FrontDoorsClient
+	ctx := testAccProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_frontdoor" {
+			continue
+		}
+
+		id, err := resourceid.ParseFrontDoorID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Frontdoor.FrontDoorsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Front Door %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMFrontDoor_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                                         = "acctestfd-%d"
+  resource_group_name                          = "${azurerm_resource_group.test.name}"
+  enforce_backend_pools_certificate_name_check = false
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    forwarding_configuration {
+      forwarding_protocol = "MatchRequest"
+      backend_pool_name   = "backend-pool"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "load-balancing-setting"
+  }
+
+  backend_pool_health_probe {
+    name = "health-probe-setting"
+  }
+
+  backend_pool {
+    name = "backend-pool"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    load_balancing_name = "load-balancing-setting"
+    health_probe_name   = "health-probe-setting"
+  }
+
+  frontend_endpoint {
+    name      = "frontend-endpoint"
+    host_name = "acctestfd-%d.azurefd.net"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMFrontDoor_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFrontDoor_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_frontdoor" "import" {
+  name                                         = "${azurerm_frontdoor.test.name}"
+  resource_group_name                          = "${azurerm_frontdoor.test.resource_group_name}"
+  enforce_backend_pools_certificate_name_check = false
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    forwarding_configuration {
+      forwarding_protocol = "MatchRequest"
+      backend_pool_name   = "backend-pool"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "load-balancing-setting"
+  }
+
+  backend_pool_health_probe {
+    name = "health-probe-setting"
+  }
+
+  backend_pool {
+    name = "backend-pool"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    load_balancing_name = "load-balancing-setting"
+    health_probe_name   = "health-probe-setting"
+  }
+
+  frontend_endpoint {
+    name      = "frontend-endpoint"
+    host_name = "acctestfd-%d.azurefd.net"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMFrontDoor_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                                         = "acctestfd-%d"
+  resource_group_name                          = "${azurerm_resource_group.test.name}"
+  friendly_name                                = "acctestfd-%d"
+  enforce_backend_pools_certificate_name_check = true
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    forwarding_configuration {
+      forwarding_protocol                   = "HttpsOnly"
+      backend_pool_name                     = "backend-pool"
+      cache_enabled                         = true
+      cache_use_dynamic_compression         = true
+      cache_query_parameter_strip_directive = "StripAll"
+    }
+  }
+
+  routing_rule {
+    name               = "redirect-rule"
+    accepted_protocols = ["Http"]
+    patterns_to_match  = ["/redirect/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    redirect_configuration {
+      redirect_protocol = "HttpsOnly"
+      redirect_type     = "Moved"
+      custom_host       = "www.example.com"
+      custom_path       = "/redirected"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name                            = "load-balancing-setting"
+    sample_size                     = 6
+    successful_samples_required     = 3
+    additional_latency_milliseconds = 100
+  }
+
+  backend_pool_health_probe {
+    name                = "health-probe-setting"
+    path                = "/health"
+    protocol            = "Https"
+    interval_in_seconds = 60
+  }
+
+  backend_pool {
+    name = "backend-pool"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+      priority    = 2
+      weight      = 75
+    }
+
+    load_balancing_name = "load-balancing-setting"
+    health_probe_name   = "health-probe-setting"
+  }
+
+  frontend_endpoint {
+    name                         = "frontend-endpoint"
+    host_name                    = "acctestfd-%d.azurefd.net"
+    session_affinity_enabled     = true
+    session_affinity_ttl_seconds = 300
+  }
+
+  tags = {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMFrontDoor_missingDefaultFrontendEndpoint(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor" "test" {
+  name                                         = "acctestfd-%d"
+  resource_group_name                          = "${azurerm_resource_group.test.name}"
+  enforce_backend_pools_certificate_name_check = false
+
+  routing_rule {
+    name               = "routing-rule"
+    accepted_protocols = ["Http", "Https"]
+    patterns_to_match  = ["/*"]
+    frontend_endpoints = ["frontend-endpoint"]
+
+    forwarding_configuration {
+      backend_pool_name = "backend-pool"
+    }
+  }
+
+  backend_pool_load_balancing {
+    name = "load-balancing-setting"
+  }
+
+  backend_pool_health_probe {
+    name = "health-probe-setting"
+  }
+
+  backend_pool {
+    name = "backend-pool"
+
+    backend {
+      host_header = "www.bing.com"
+      address     = "www.bing.com"
+      http_port   = 80
+      https_port  = 443
+    }
+
+    load_balancing_name = "load-balancing-setting"
+    health_probe_name   = "health-probe-setting"
+  }
+
+  frontend_endpoint {
+    name      = "frontend-endpoint"
+    host_name = "www.example.com"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,186 @@
+package frontdoor
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func ValidateFrontDoorName(i interface{}, k string) (_ []string, errors []error) {
+	if m, regexErrs := validateRegex(i, k, `^[\da-zA-Z]([-\da-zA-Z]{3,62})[\da-zA-Z]$`); !m {
+		return nil, append(regexErrs, fmt.Errorf(`%q must be between 5 and 64 characters in length, begin and end with a letter or number and may only contain letters, numbers and hyphens.`, k))
+	}
+
+	return nil, nil
+}
+
+// ValidateBackendPoolRoutingRuleName validates the name of the child resources of a Front Door
+// (Backend Pools, Frontend Endpoints, Health Probes, Load Balancing Settings and Routing Rules)
+func ValidateBackendPoolRoutingRuleName(i interface{}, k string) (_ []string, errors []error) {
+	if m, regexErrs := validateRegex(i, k, `^[\da-zA-Z]([-\da-zA-Z]{0,88}[\da-zA-Z])?$`); !m {
+		return nil, append(regexErrs, fmt.Errorf(`%q must be between 1 and 90 characters in length, begin and end with a letter or number and may only contain letters, numbers and hyphens.`, k))
+	}
+
+	return nil, nil
+}
+
+func ValidateFirewallPolicyName(i interface{}, k string) (_ []string, errors []error) {
+	if m, regexErrs := validateRegex(i, k, `^[a-zA-Z][a-zA-Z0-9]{0,127}$`); !m {
+		return nil, append(regexErrs, fmt.Errorf(`%q must be between 1 and 128 characters in length, begin with a letter and may only contain letters and numbers.`, k))
+	}
+
+	return nil, nil
+}
+
+func ValidateCustomBlockResponseBody(i interface{}, k string) (_ []string, errors []error) {
+	// regex pulled from the validation within the Azure SDK, since the body must be base64 encoded
+	if m, regexErrs := validateRegex(i, k, `^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=|[A-Za-z0-9+/]{4})$`); !m {
+		return nil, append(regexErrs, fmt.Errorf(`%q contains invalid characters, %q must be a base64 encoded string.`, k, k))
+	}
+
+	return nil, nil
+}
+
+func validateRegex(i interface{}, k string, pattern string) (bool, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return false, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if m := regexp.MustCompile(pattern).MatchString(v); !m {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// ValidateFrontDoorSettings validates the references between the blocks within a Front Door, since
+// these are linked by name (e.g. a Routing Rule references a Backend Pool by name) - rather than
+// waiting for the API to return an error some time into the apply
+func ValidateFrontDoorSettings(d *schema.ResourceDiff) error {
+	return validateFrontDoorSettings(
+		d.Get("name").(string),
+		d.Get("routing_rule").([]interface{}),
+		d.Get("frontend_endpoint").([]interface{}),
+		d.Get("backend_pool").([]interface{}),
+		d.Get("backend_pool_health_probe").([]interface{}),
+		d.Get("backend_pool_load_balancing").([]interface{}),
+	)
+}
+
+func validateFrontDoorSettings(frontDoorName string, routingRules, frontendEndpoints, backendPools, healthProbes, loadBalancingSettings []interface{}) error {
+	frontendEndpointNames := namesOf(frontendEndpoints)
+	backendPoolNames := namesOf(backendPools)
+	healthProbeNames := namesOf(healthProbes)
+	loadBalancingNames := namesOf(loadBalancingSettings)
+
+	// the default Frontend Endpoint (`{name}.azurefd.net`) must always be defined - however if any
+	// of the Host Names aren't known yet (e.g. they're interpolated) we can't check this until apply
+	if frontDoorName != "" {
+		defaultHostName := fmt.Sprintf("%s.azurefd.net", frontDoorName)
+		foundDefault := false
+		allKnown := true
+		for _, raw := range frontendEndpoints {
+			v, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			hostName := v["host_name"].(string)
+			if hostName == "" {
+				allKnown = false
+				continue
+			}
+
+			if strings.EqualFold(hostName, defaultHostName) {
+				foundDefault = true
+			}
+		}
+
+		if allKnown && !foundDefault {
+			return fmt.Errorf("a `frontend_endpoint` with the `host_name` %q must be defined", defaultHostName)
+		}
+	}
+
+	for _, raw := range routingRules {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ruleName := v["name"].(string)
+
+		forwardingConfigs := v["forwarding_configuration"].([]interface{})
+		redirectConfigs := v["redirect_configuration"].([]interface{})
+		if len(forwardingConfigs) > 0 && len(redirectConfigs) > 0 {
+			return fmt.Errorf("`routing_rule` %q: only one of `forwarding_configuration` or `redirect_configuration` can be specified", ruleName)
+		}
+		if len(forwardingConfigs) == 0 && len(redirectConfigs) == 0 {
+			return fmt.Errorf("`routing_rule` %q: one of `forwarding_configuration` or `redirect_configuration` must be specified", ruleName)
+		}
+
+		for _, endpoint := range v["frontend_endpoints"].([]interface{}) {
+			endpointName, ok := endpoint.(string)
+			if !ok || endpointName == "" {
+				continue
+			}
+
+			if _, exists := frontendEndpointNames[endpointName]; !exists {
+				return fmt.Errorf("`routing_rule` %q references the `frontend_endpoint` %q which is not defined", ruleName, endpointName)
+			}
+		}
+
+		if len(forwardingConfigs) > 0 && forwardingConfigs[0] != nil {
+			forwardingConfig := forwardingConfigs[0].(map[string]interface{})
+			backendPoolName := forwardingConfig["backend_pool_name"].(string)
+			if _, exists := backendPoolNames[backendPoolName]; backendPoolName != "" && !exists {
+				return fmt.Errorf("`routing_rule` %q references the `backend_pool` %q which is not defined", ruleName, backendPoolName)
+			}
+
+			cacheEnabled := forwardingConfig["cache_enabled"].(bool)
+			useDynamicCompression := forwardingConfig["cache_use_dynamic_compression"].(bool)
+			if !cacheEnabled && useDynamicCompression {
+				return fmt.Errorf("`routing_rule` %q: `cache_use_dynamic_compression` can only be set when `cache_enabled` is `true`", ruleName)
+			}
+		}
+	}
+
+	for _, raw := range backendPools {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		poolName := v["name"].(string)
+
+		healthProbeName := v["health_probe_name"].(string)
+		if _, exists := healthProbeNames[healthProbeName]; healthProbeName != "" && !exists {
+			return fmt.Errorf("`backend_pool` %q references the `backend_pool_health_probe` %q which is not defined", poolName, healthProbeName)
+		}
+
+		loadBalancingName := v["load_balancing_name"].(string)
+		if _, exists := loadBalancingNames[loadBalancingName]; loadBalancingName != "" && !exists {
+			return fmt.Errorf("`backend_pool` %q references the `backend_pool_load_balancing` %q which is not defined", poolName, loadBalancingName)
+		}
+	}
+
+	return nil
+}
+
+func namesOf(input []interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := v["name"].(string); ok && name != "" {
+			names[name] = struct{}{}
+		}
+	}
+
+	return names
+}
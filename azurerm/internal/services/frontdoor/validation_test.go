@@ -0,0 +1,308 @@
+package frontdoor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFrontDoorName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "abcd",
+			Expected: false,
+		},
+		{
+			Name:     "abcde",
+			Expected: true,
+		},
+		{
+			Name:     "hello-world1",
+			Expected: true,
+		},
+		{
+			Name:     "-hello",
+			Expected: false,
+		},
+		{
+			Name:     "hello-",
+			Expected: false,
+		},
+		{
+			Name:     "hello_world",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 64),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 65),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, errors := ValidateFrontDoorName(v.Name, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestValidateBackendPoolRoutingRuleName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "a",
+			Expected: true,
+		},
+		{
+			Name:     "backend-pool-1",
+			Expected: true,
+		},
+		{
+			Name:     "-pool",
+			Expected: false,
+		},
+		{
+			Name:     "pool.1",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 90),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 91),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, errors := ValidateBackendPoolRoutingRuleName(v.Name, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestValidateFirewallPolicyName(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "",
+			Expected: false,
+		},
+		{
+			Name:     "a",
+			Expected: true,
+		},
+		{
+			Name:     "examplePolicy1",
+			Expected: true,
+		},
+		{
+			Name:     "1policy",
+			Expected: false,
+		},
+		{
+			Name:     "example-policy",
+			Expected: false,
+		},
+		{
+			Name:     strings.Repeat("a", 128),
+			Expected: true,
+		},
+		{
+			Name:     strings.Repeat("a", 129),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, errors := ValidateFirewallPolicyName(v.Name, "name")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Name, errors)
+		}
+	}
+}
+
+func TestValidateCustomBlockResponseBody(t *testing.T) {
+	testData := []struct {
+		Value    string
+		Expected bool
+	}{
+		{
+			Value:    "",
+			Expected: false,
+		},
+		{
+			Value:    "PGh0bWw+PC9odG1sPg==",
+			Expected: true,
+		},
+		{
+			Value:    "<html></html>",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Value)
+
+		_, errors := ValidateCustomBlockResponseBody(v.Value, "custom_block_response_body")
+		actual := len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %s", v.Expected, actual, v.Value, errors)
+		}
+	}
+}
+
+func TestValidateFrontDoorSettings(t *testing.T) {
+	frontend := func(name, hostName string) interface{} {
+		return map[string]interface{}{
+			"name":      name,
+			"host_name": hostName,
+		}
+	}
+	forwardingRule := func(name string, endpoints []interface{}, backendPoolName string, cacheEnabled bool, useDynamicCompression bool) interface{} {
+		return map[string]interface{}{
+			"name":               name,
+			"frontend_endpoints": endpoints,
+			"forwarding_configuration": []interface{}{
+				map[string]interface{}{
+					"backend_pool_name":             backendPoolName,
+					"cache_enabled":                 cacheEnabled,
+					"cache_use_dynamic_compression": useDynamicCompression,
+				},
+			},
+			"redirect_configuration": []interface{}{},
+		}
+	}
+	backendPool := func(name, healthProbeName, loadBalancingName string) interface{} {
+		return map[string]interface{}{
+			"name":                name,
+			"health_probe_name":   healthProbeName,
+			"load_balancing_name": loadBalancingName,
+		}
+	}
+	named := func(name string) interface{} {
+		return map[string]interface{}{
+			"name": name,
+		}
+	}
+
+	testData := []struct {
+		Name              string
+		RoutingRules      []interface{}
+		FrontendEndpoints []interface{}
+		BackendPools      []interface{}
+		ExpectError       bool
+	}{
+		{
+			Name:              "Valid",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool1", true, true)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       false,
+		},
+		{
+			Name:              "Missing Default Frontend Endpoint",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool1", false, false)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "www.example.com")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       true,
+		},
+		{
+			Name:              "Unknown Frontend Endpoint Host Name",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool1", false, false)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       false,
+		},
+		{
+			Name:              "Undefined Frontend Endpoint",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint2"}, "pool1", false, false)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       true,
+		},
+		{
+			Name:              "Undefined Backend Pool",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool2", false, false)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       true,
+		},
+		{
+			Name:              "Dynamic Compression Without Caching",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool1", false, true)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       true,
+		},
+		{
+			Name: "No Route Configuration",
+			RoutingRules: []interface{}{
+				map[string]interface{}{
+					"name":                     "rule1",
+					"frontend_endpoints":       []interface{}{"endpoint1"},
+					"forwarding_configuration": []interface{}{},
+					"redirect_configuration":   []interface{}{},
+				},
+			},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb1")},
+			ExpectError:       true,
+		},
+		{
+			Name:              "Undefined Health Probe",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool1", false, false)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe2", "lb1")},
+			ExpectError:       true,
+		},
+		{
+			Name:              "Undefined Load Balancing Settings",
+			RoutingRules:      []interface{}{forwardingRule("rule1", []interface{}{"endpoint1"}, "pool1", false, false)},
+			FrontendEndpoints: []interface{}{frontend("endpoint1", "example.azurefd.net")},
+			BackendPools:      []interface{}{backendPool("pool1", "probe1", "lb2")},
+			ExpectError:       true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateFrontDoorSettings("example", v.RoutingRules, v.FrontendEndpoints, v.BackendPools, []interface{}{named("probe1")}, []interface{}{named("lb1")})
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// NestedItemType is the type of item stored within a Key Vault which can be soft-deleted
+type NestedItemType string
+
+const (
+	NestedItemTypeCertificate NestedItemType = "Certificate"
+	NestedItemTypeKey         NestedItemType = "Key"
+	NestedItemTypeSecret      NestedItemType = "Secret"
+)
+
+// DeletedNestedItemExists returns whether a soft-deleted Certificate, Key or Secret with the
+// specified name exists within the Key Vault
+func (client Client) DeletedNestedItemExists(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string) (bool, error) {
+	resp, err := client.getDeletedNestedItem(ctx, itemType, keyVaultBaseUrl, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return false, nil
+		}
+
+		// retrieving soft-deleted items requires the `list` permission (which may not be granted) and is
+		// unsupported when Soft Delete isn't enabled on the Key Vault - in which case there's nothing to recover
+		if utils.ResponseWasStatusCode(resp, http.StatusForbidden) || utils.ResponseWasStatusCode(resp, http.StatusBadRequest) {
+			log.Printf("[DEBUG] Unable to check for a soft-deleted %s %q (Key Vault %q) - assuming there's nothing to recover: %+v", itemType, name, keyVaultBaseUrl, err)
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Error checking for a soft-deleted %s %q (Key Vault %q): %+v", itemType, name, keyVaultBaseUrl, err)
+	}
+
+	return true, nil
+}
+
+// RecoverDeletedNestedItem recovers the soft-deleted Certificate, Key or Secret with the specified name
+// and then waits for it to become available within the Key Vault
+func (client Client) RecoverDeletedNestedItem(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Recovering soft-deleted %s %q (Key Vault %q)", itemType, name, keyVaultBaseUrl)
+	var err error
+	switch itemType {
+	case NestedItemTypeCertificate:
+		_, err = client.ManagementClient.RecoverDeletedCertificate(ctx, keyVaultBaseUrl, name)
+	case NestedItemTypeKey:
+		_, err = client.ManagementClient.RecoverDeletedKey(ctx, keyVaultBaseUrl, name)
+	case NestedItemTypeSecret:
+		_, err = client.ManagementClient.RecoverDeletedSecret(ctx, keyVaultBaseUrl, name)
+	default:
+		return fmt.Errorf("Unsupported Nested Item Type %q", itemType)
+	}
+	if err != nil {
+		return fmt.Errorf("Error recovering soft-deleted %s %q (Key Vault %q): %+v", itemType, name, keyVaultBaseUrl, err)
+	}
+
+	// recovery happens asynchronously, so wait for the item to become available again
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Recovering"},
+		Target:                    []string{"Available"},
+		Refresh:                   client.nestedItemRefreshFunc(ctx, itemType, keyVaultBaseUrl, name, "Recovering", "Available"),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted %s %q (Key Vault %q) to be recovered: %+v", itemType, name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// PurgeDeletedNestedItem waits for the Certificate, Key or Secret with the specified name to appear
+// in the list of soft-deleted items, and then purges it from the Key Vault
+func (client Client) PurgeDeletedNestedItem(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string, timeout time.Duration) error {
+	// deletion happens asynchronously, so the item may not be available to purge yet
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Deleting"},
+		Target:     []string{"Deleted"},
+		Refresh:    client.deletedNestedItemRefreshFunc(ctx, itemType, keyVaultBaseUrl, name, "Deleting", "Deleted"),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q (Key Vault %q) to be soft-deleted: %+v", itemType, name, keyVaultBaseUrl, err)
+	}
+
+	log.Printf("[DEBUG] Purging soft-deleted %s %q (Key Vault %q)", itemType, name, keyVaultBaseUrl)
+	var err error
+	switch itemType {
+	case NestedItemTypeCertificate:
+		_, err = client.ManagementClient.PurgeDeletedCertificate(ctx, keyVaultBaseUrl, name)
+	case NestedItemTypeKey:
+		_, err = client.ManagementClient.PurgeDeletedKey(ctx, keyVaultBaseUrl, name)
+	case NestedItemTypeSecret:
+		_, err = client.ManagementClient.PurgeDeletedSecret(ctx, keyVaultBaseUrl, name)
+	default:
+		return fmt.Errorf("Unsupported Nested Item Type %q", itemType)
+	}
+	if err != nil {
+		return fmt.Errorf("Error purging soft-deleted %s %q (Key Vault %q): %+v", itemType, name, keyVaultBaseUrl, err)
+	}
+
+	// purging also happens asynchronously - and a new item with the same name can't be created until it's completed
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"Purging"},
+		Target:     []string{"Purged"},
+		Refresh:    client.deletedNestedItemRefreshFunc(ctx, itemType, keyVaultBaseUrl, name, "Purged", "Purging"),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted %s %q (Key Vault %q) to be purged: %+v", itemType, name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func (client Client) getNestedItem(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string) (autorest.Response, error) {
+	switch itemType {
+	case NestedItemTypeCertificate:
+		resp, err := client.ManagementClient.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+		return resp.Response, err
+	case NestedItemTypeKey:
+		resp, err := client.ManagementClient.GetKey(ctx, keyVaultBaseUrl, name, "")
+		return resp.Response, err
+	case NestedItemTypeSecret:
+		resp, err := client.ManagementClient.GetSecret(ctx, keyVaultBaseUrl, name, "")
+		return resp.Response, err
+	}
+
+	return autorest.Response{}, fmt.Errorf("Unsupported Nested Item Type %q", itemType)
+}
+
+func (client Client) getDeletedNestedItem(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string) (autorest.Response, error) {
+	switch itemType {
+	case NestedItemTypeCertificate:
+		resp, err := client.ManagementClient.GetDeletedCertificate(ctx, keyVaultBaseUrl, name)
+		return resp.Response, err
+	case NestedItemTypeKey:
+		resp, err := client.ManagementClient.GetDeletedKey(ctx, keyVaultBaseUrl, name)
+		return resp.Response, err
+	case NestedItemTypeSecret:
+		resp, err := client.ManagementClient.GetDeletedSecret(ctx, keyVaultBaseUrl, name)
+		return resp.Response, err
+	}
+
+	return autorest.Response{}, fmt.Errorf("Unsupported Nested Item Type %q", itemType)
+}
+
+// nestedItemRefreshFunc returns `notFoundState` until the item exists, at which point `foundState` is returned
+func (client Client) nestedItemRefreshFunc(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string, notFoundState string, foundState string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.getNestedItem(ctx, itemType, keyVaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return resp, notFoundState, nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving %s %q (Key Vault %q): %+v", itemType, name, keyVaultBaseUrl, err)
+		}
+
+		return resp, foundState, nil
+	}
+}
+
+// deletedNestedItemRefreshFunc returns `notFoundState` until the soft-deleted item exists, at which point `foundState` is returned
+func (client Client) deletedNestedItemRefreshFunc(ctx context.Context, itemType NestedItemType, keyVaultBaseUrl string, name string, notFoundState string, foundState string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.getDeletedNestedItem(ctx, itemType, keyVaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return resp, notFoundState, nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving soft-deleted %s %q (Key Vault %q): %+v", itemType, name, keyVaultBaseUrl, err)
+		}
+
+		return resp, foundState, nil
+	}
+}
@@ -1,11 +1,13 @@
-package azurerm
+package maps
 
 import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,7 +19,7 @@ func dataSourceArmMapsAccount() *schema.Resource {
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: maps.ValidateName(),
+				ValidateFunc: ValidateName(),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -27,7 +29,7 @@ func dataSourceArmMapsAccount() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags": tags.Schema(),
 
 			"x_ms_client_id": {
 				Type:     schema.TypeString,
@@ -50,8 +52,8 @@ func dataSourceArmMapsAccount() *schema.Resource {
 }
 
 func dataSourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).maps.AccountsClient
+	ctx := meta.(*clients.Client).StopContext
+	client := meta.(*clients.Client).Maps.AccountsClient
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -62,7 +64,7 @@ func dataSourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Maps Account %q was not found in Resource Group %q", name, resourceGroup)
 		}
 
-		return fmt.Errorf("Error making Read request on Maps Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Maps Account %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -78,13 +80,11 @@ func dataSourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
 
 	keysResp, err := client.ListKeys(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error reading Access Keys request for Maps Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error reading Access Keys request for Maps Account %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("primary_access_key", keysResp.PrimaryKey)
 	d.Set("secondary_access_key", keysResp.SecondaryKey)
 
-	flattenAndSetTags(d, resp.Tags)
-
-	return nil
+	return tags.FlattenAndSet(d, resp.Tags, meta)
 }
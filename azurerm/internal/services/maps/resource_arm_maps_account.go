@@ -1,15 +1,19 @@
-package azurerm
+package maps
 
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/maps/mgmt/2018-05-01/maps"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	mapsint "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/flags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,12 +27,19 @@ func resourceArmMapsAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: mapsint.ValidateName(),
+				ValidateFunc: ValidateName(),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -43,7 +54,7 @@ func resourceArmMapsAccount() *schema.Resource {
 				}, false),
 			},
 
-			"tags": tagsSchema(),
+			"tags": tags.Schema(),
 
 			"x_ms_client_id": {
 				Type:     schema.TypeString,
@@ -66,21 +77,22 @@ func resourceArmMapsAccount() *schema.Resource {
 }
 
 func resourceArmMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).maps.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Maps.AccountsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Maps Account creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	t := d.Get("tags").(map[string]interface{})
 	sku := d.Get("sku_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if flags.RequireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Maps Account %q (Resource Group %q): %+v", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Maps Account %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -94,16 +106,16 @@ func resourceArmMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}
 		Sku: &maps.Sku{
 			Name: &sku,
 		},
-		Tags: expandTags(tags),
+		Tags: tags.Expand(t, meta),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error creating/updating Maps Account %q (Resource Group %q) %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating/updating Maps Account %q (Resource Group %q) %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Maps Account %q (Resource Group %q) %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Maps Account %q (Resource Group %q) %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -116,8 +128,9 @@ func resourceArmMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).maps.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Maps.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -133,7 +146,7 @@ func resourceArmMapsAccountRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Maps Account %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error making Read request on Maps Account %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -147,19 +160,18 @@ func resourceArmMapsAccountRead(d *schema.ResourceData, meta interface{}) error
 
 	keysResp, err := client.ListKeys(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read Access Keys request on Maps Account %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error making Read Access Keys request on Maps Account %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 	d.Set("primary_access_key", keysResp.PrimaryKey)
 	d.Set("secondary_access_key", keysResp.SecondaryKey)
 
-	flattenAndSetTags(d, resp.Tags)
-
-	return nil
+	return tags.FlattenAndSet(d, resp.Tags, meta)
 }
 
 func resourceArmMapsAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).maps.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*clients.Client).Maps.AccountsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -169,7 +181,7 @@ func resourceArmMapsAccountDelete(d *schema.ResourceData, meta interface{}) erro
 	name := id.Path["accounts"]
 
 	if _, err := client.Delete(ctx, resGroup, name); err != nil {
-		return fmt.Errorf("Error deleting Maps Account %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting Maps Account %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	return nil
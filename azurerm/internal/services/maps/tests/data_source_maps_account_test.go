@@ -1,4 +1,4 @@
-package azurerm
+package tests
 
 import (
 	"fmt"
@@ -6,15 +6,16 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 )
 
 func TestAccDataSourceAzureRMMapsAccount(t *testing.T) {
 	dataSourceName := "data.azurerm_maps_account.test"
 	rInt := tf.AccRandTimeInt()
-	location := testLocation()
+	location := acceptance.Location()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:  func() { testAccPreCheck(t) },
+		PreCheck:  func() { acceptance.PreCheck(t) },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
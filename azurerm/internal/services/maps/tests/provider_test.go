@@ -0,0 +1,16 @@
+package tests
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+var testAccProvider *schema.Provider
+var testAccProviders map[string]terraform.ResourceProvider
+
+func init() {
+	testAccProvider = azurerm.Provider().(*schema.Provider)
+	testAccProviders = acceptance.Providers(testAccProvider)
+}
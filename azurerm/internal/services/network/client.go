@@ -2,6 +2,7 @@ package network
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	privatelink "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
@@ -9,6 +10,7 @@ type Client struct {
 	ApplicationGatewaysClient       *network.ApplicationGatewaysClient
 	ApplicationSecurityGroupsClient *network.ApplicationSecurityGroupsClient
 	AzureFirewallsClient            *network.AzureFirewallsClient
+	BastionHostsClient              *privatelink.BastionHostsClient
 	ConnectionMonitorsClient        *network.ConnectionMonitorsClient
 	DDOSProtectionPlansClient       *network.DdosProtectionPlansClient
 	ExpressRouteAuthsClient         *network.ExpressRouteCircuitAuthorizationsClient
@@ -17,8 +19,12 @@ type Client struct {
 	InterfacesClient                *network.InterfacesClient
 	LoadBalancersClient             *network.LoadBalancersClient
 	LocalNetworkGatewaysClient      *network.LocalNetworkGatewaysClient
+	NatGatewayClient                *privatelink.NatGatewaysClient
+	NatGatewaySubnetsClient         *privatelink.SubnetsClient
 	ProfileClient                   *network.ProfilesClient
 	PacketCapturesClient            *network.PacketCapturesClient
+	PrivateEndpointsClient          *privatelink.PrivateEndpointsClient
+	PrivateLinkServicesClient       *privatelink.PrivateLinkServicesClient
 	PublicIPsClient                 *network.PublicIPAddressesClient
 	PublicIPPrefixesClient          *network.PublicIPPrefixesClient
 	RoutesClient                    *network.RoutesClient
@@ -45,6 +51,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	AzureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&AzureFirewallsClient.Client, o.ResourceManagerAuthorizer)
 
+	BastionHostsClient := privatelink.NewBastionHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&BastionHostsClient.Client, o.ResourceManagerAuthorizer)
+
 	ConnectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ConnectionMonitorsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -69,6 +78,14 @@ func BuildClient(o *common.ClientOptions) *Client {
 	LocalNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LocalNetworkGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	NatGatewayClient := privatelink.NewNatGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&NatGatewayClient.Client, o.ResourceManagerAuthorizer)
+
+	// the NAT Gateway association needs a newer API version of Subnets than SubnetsClient,
+	// since the older version doesn't expose the NAT Gateway associated with a Subnet
+	NatGatewaySubnetsClient := privatelink.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&NatGatewaySubnetsClient.Client, o.ResourceManagerAuthorizer)
+
 	ProfileClient := network.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ProfileClient.Client, o.ResourceManagerAuthorizer)
 
@@ -81,6 +98,12 @@ func BuildClient(o *common.ClientOptions) *Client {
 	VnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetPeeringsClient.Client, o.ResourceManagerAuthorizer)
 
+	PrivateEndpointsClient := privatelink.NewPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PrivateEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	PrivateLinkServicesClient := privatelink.NewPrivateLinkServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&PrivateLinkServicesClient.Client, o.ResourceManagerAuthorizer)
+
 	PublicIPsClient := network.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PublicIPsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -118,6 +141,7 @@ func BuildClient(o *common.ClientOptions) *Client {
 		ApplicationGatewaysClient:       &ApplicationGatewaysClient,
 		ApplicationSecurityGroupsClient: &ApplicationSecurityGroupsClient,
 		AzureFirewallsClient:            &AzureFirewallsClient,
+		BastionHostsClient:              &BastionHostsClient,
 		ConnectionMonitorsClient:        &ConnectionMonitorsClient,
 		DDOSProtectionPlansClient:       &DDOSProtectionPlansClient,
 		ExpressRouteAuthsClient:         &ExpressRouteAuthsClient,
@@ -126,8 +150,12 @@ func BuildClient(o *common.ClientOptions) *Client {
 		InterfacesClient:                &InterfacesClient,
 		LoadBalancersClient:             &LoadBalancersClient,
 		LocalNetworkGatewaysClient:      &LocalNetworkGatewaysClient,
+		NatGatewayClient:                &NatGatewayClient,
+		NatGatewaySubnetsClient:         &NatGatewaySubnetsClient,
 		ProfileClient:                   &ProfileClient,
 		PacketCapturesClient:            &PacketCapturesClient,
+		PrivateEndpointsClient:          &PrivateEndpointsClient,
+		PrivateLinkServicesClient:       &PrivateLinkServicesClient,
 		PublicIPsClient:                 &PublicIPsClient,
 		PublicIPPrefixesClient:          &PublicIPPrefixesClient,
 		RoutesClient:                    &RoutesClient,
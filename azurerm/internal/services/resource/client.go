@@ -4,14 +4,16 @@ import (
 	providers "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	deployments "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
-	GroupsClient      *resources.GroupsClient
-	DeploymentsClient *resources.DeploymentsClient
-	LocksClient       *locks.ManagementLocksClient
-	ProvidersClient   *providers.ProvidersClient
+	GroupsClient           *resources.GroupsClient
+	DeploymentsClient      *deployments.DeploymentsClient
+	GenericResourcesClient *GenericResourcesClient
+	LocksClient            *locks.ManagementLocksClient
+	ProvidersClient        *providers.ProvidersClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -19,9 +21,13 @@ func BuildClient(o *common.ClientOptions) *Client {
 	LocksClient := locks.NewManagementLocksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LocksClient.Client, o.ResourceManagerAuthorizer)
 
-	DeploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	// Deployments use a newer API version since this supports Management Group scoped Deployments
+	DeploymentsClient := deployments.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DeploymentsClient.Client, o.ResourceManagerAuthorizer)
 
+	GenericResourcesClient := NewGenericResourcesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&GenericResourcesClient.Client, o.ResourceManagerAuthorizer)
+
 	GroupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&GroupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -30,9 +36,10 @@ func BuildClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ProvidersClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		GroupsClient:      &GroupsClient,
-		DeploymentsClient: &DeploymentsClient,
-		LocksClient:       &LocksClient,
-		ProvidersClient:   &ProvidersClient,
+		GroupsClient:           &GroupsClient,
+		DeploymentsClient:      &DeploymentsClient,
+		GenericResourcesClient: &GenericResourcesClient,
+		LocksClient:            &LocksClient,
+		ProvidersClient:        &ProvidersClient,
 	}
 }
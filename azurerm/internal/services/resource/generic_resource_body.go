@@ -0,0 +1,69 @@
+package resource
+
+// readOnlyFields are the top-level fields which Azure returns for every Resource, which are either
+// read-only or managed via a separate field on `azurerm_resource`
+var readOnlyFields = []string{"etag", "id", "location", "name", "type"}
+
+// ProjectBody returns the fields from the remote (API) representation of a Resource which are present in the
+// configured representation, such that fields which are populated by Azure (for example a `provisioningState`,
+// `resourceGuid` or default values) don't cause a diff - whilst changes to the configured fields are still detected.
+//
+// Lists are projected item-by-item when the lengths match, otherwise the remote list is returned as-is so
+// that the difference is surfaced.
+func ProjectBody(configured interface{}, remote interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+
+		output := make(map[string]interface{})
+		for key, value := range c {
+			if v, exists := r[key]; exists {
+				output[key] = ProjectBody(value, v)
+			}
+		}
+		return output
+
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok || len(r) != len(c) {
+			return remote
+		}
+
+		output := make([]interface{}, 0, len(r))
+		for i, value := range c {
+			output = append(output, ProjectBody(value, r[i]))
+		}
+		return output
+	}
+
+	return remote
+}
+
+// RemoveReadOnlyFields returns a copy of the remote (API) representation of a Resource without the top-level
+// fields which can't be configured within the `body` - and without the `provisioningState` of the Resource
+func RemoveReadOnlyFields(remote map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for key, value := range remote {
+		output[key] = value
+	}
+
+	for _, field := range readOnlyFields {
+		delete(output, field)
+	}
+
+	if props, ok := output["properties"].(map[string]interface{}); ok {
+		properties := make(map[string]interface{})
+		for key, value := range props {
+			if key == "provisioningState" {
+				continue
+			}
+			properties[key] = value
+		}
+		output["properties"] = properties
+	}
+
+	return output
+}
@@ -0,0 +1,111 @@
+package resource
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProjectBody(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Configured string
+		Remote     string
+		Expected   string
+	}{
+		{
+			Name:       "Empty",
+			Configured: `{}`,
+			Remote:     `{"properties": {"provisioningState": "Succeeded"}}`,
+			Expected:   `{}`,
+		},
+		{
+			Name:       "Server Populated Fields are Ignored",
+			Configured: `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+			Remote:     `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}, "provisioningState": "Succeeded", "resourceGuid": "abc123"}}`,
+			Expected:   `{"properties": {"addressSpace": {"addressPrefixes": ["10.0.0.0/16"]}}}`,
+		},
+		{
+			Name:       "Changed Value",
+			Configured: `{"properties": {"enabled": true}}`,
+			Remote:     `{"properties": {"enabled": false}}`,
+			Expected:   `{"properties": {"enabled": false}}`,
+		},
+		{
+			Name:       "Removed Value",
+			Configured: `{"properties": {"enabled": true, "name": "example"}}`,
+			Remote:     `{"properties": {"name": "example"}}`,
+			Expected:   `{"properties": {"name": "example"}}`,
+		},
+		{
+			Name:       "Lists of the same Length are Projected",
+			Configured: `{"rules": [{"name": "first"}, {"name": "second"}]}`,
+			Remote:     `{"rules": [{"name": "first", "id": "1"}, {"name": "second", "id": "2"}]}`,
+			Expected:   `{"rules": [{"name": "first"}, {"name": "second"}]}`,
+		},
+		{
+			Name:       "Lists of a different Length are returned as-is",
+			Configured: `{"rules": [{"name": "first"}]}`,
+			Remote:     `{"rules": [{"name": "first", "id": "1"}, {"name": "second", "id": "2"}]}`,
+			Expected:   `{"rules": [{"name": "first", "id": "1"}, {"name": "second", "id": "2"}]}`,
+		},
+		{
+			Name:       "Changed Type",
+			Configured: `{"value": {"nested": true}}`,
+			Remote:     `{"value": "flattened"}`,
+			Expected:   `{"value": "flattened"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var configured, remote, expected interface{}
+		if err := json.Unmarshal([]byte(v.Configured), &configured); err != nil {
+			t.Fatalf("Error unmarshalling %q: %+v", v.Configured, err)
+		}
+		if err := json.Unmarshal([]byte(v.Remote), &remote); err != nil {
+			t.Fatalf("Error unmarshalling %q: %+v", v.Remote, err)
+		}
+		if err := json.Unmarshal([]byte(v.Expected), &expected); err != nil {
+			t.Fatalf("Error unmarshalling %q: %+v", v.Expected, err)
+		}
+
+		actual := ProjectBody(configured, remote)
+
+		actualJson, _ := json.Marshal(actual)
+		expectedJson, _ := json.Marshal(expected)
+		if string(actualJson) != string(expectedJson) {
+			t.Fatalf("Expected %s but got %s", expectedJson, actualJson)
+		}
+	}
+}
+
+func TestRemoveReadOnlyFields(t *testing.T) {
+	input := map[string]interface{}{
+		"id":       "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		"name":     "network1",
+		"type":     "Microsoft.Network/virtualNetworks",
+		"location": "westeurope",
+		"etag":     "W/\"abc123\"",
+		"tags": map[string]interface{}{
+			"environment": "testing",
+		},
+		"properties": map[string]interface{}{
+			"provisioningState":    "Succeeded",
+			"enableDdosProtection": false,
+		},
+	}
+
+	actual := RemoveReadOnlyFields(input)
+
+	actualJson, _ := json.Marshal(actual)
+	expected := `{"properties":{"enableDdosProtection":false},"tags":{"environment":"testing"}}`
+	if string(actualJson) != expected {
+		t.Fatalf("Expected %s but got %s", expected, actualJson)
+	}
+
+	// the input shouldn't be modified
+	if _, ok := input["properties"].(map[string]interface{})["provisioningState"]; !ok {
+		t.Fatalf("Expected the input to be unmodified")
+	}
+}
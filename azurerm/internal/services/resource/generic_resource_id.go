@@ -0,0 +1,129 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenericResourceID is the ID of an arbitrary Resource, split into the components used by `azurerm_resource`
+type GenericResourceID struct {
+	// ParentId is the ID of the Subscription, Resource Group or Resource which this Resource is nested within
+	ParentId string
+
+	// Type is the fully qualified Resource Type, e.g. `Microsoft.Network/virtualNetworks/subnets`
+	Type string
+
+	Name string
+}
+
+// ID builds the Resource ID for this Resource
+//
+// Where the Parent is a Resource in the same Resource Provider this is a Child Resource (e.g. a Subnet within a
+// Virtual Network) - otherwise this Resource is nested within the Parent using `/providers/` (e.g. a Resource
+// within a Resource Group, or an Extension Resource such as a Lock on a Virtual Network).
+func (id GenericResourceID) ID() (string, error) {
+	if id.Name == "" {
+		return "", fmt.Errorf("`name` cannot be empty")
+	}
+
+	parentId := strings.TrimSuffix(id.ParentId, "/")
+	if !strings.HasPrefix(strings.ToLower(parentId), "/subscriptions/") {
+		return "", fmt.Errorf("the Parent ID %q must start with `/subscriptions/`", id.ParentId)
+	}
+
+	namespace, types, err := splitResourceType(id.Type)
+	if err != nil {
+		return "", err
+	}
+
+	parentNamespace, parentTypes := resourceTypeFromID(parentId)
+	if parentNamespace != "" && strings.EqualFold(parentNamespace, namespace) {
+		// this is a Child Resource, so the Type has to be nested exactly one level below the Parent
+		if len(types) != len(parentTypes)+1 {
+			return "", fmt.Errorf("the Type %q isn't a Child Resource of the Parent %q", id.Type, id.ParentId)
+		}
+		for i, parentType := range parentTypes {
+			if !strings.EqualFold(parentType, types[i]) {
+				return "", fmt.Errorf("the Type %q isn't a Child Resource of the Parent %q", id.Type, id.ParentId)
+			}
+		}
+
+		return fmt.Sprintf("%s/%s/%s", parentId, types[len(types)-1], id.Name), nil
+	}
+
+	if len(types) != 1 {
+		return "", fmt.Errorf("the Type %q is a Child Resource, so the Parent ID must be a Resource of the Type %q", id.Type, strings.Join(append([]string{namespace}, types[:len(types)-1]...), "/"))
+	}
+
+	return fmt.Sprintf("%s/providers/%s/%s/%s", parentId, namespace, types[0], id.Name), nil
+}
+
+// ParseGenericResourceID parses the Resource ID of an arbitrary Resource into its Parent ID, Type and Name
+func ParseGenericResourceID(input string) (*GenericResourceID, error) {
+	input = strings.TrimSuffix(input, "/")
+
+	index := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if index == -1 || !strings.HasPrefix(strings.ToLower(input), "/subscriptions/") {
+		return nil, fmt.Errorf("Error parsing Resource ID %q: expected an ID in the format `/subscriptions/{subscriptionId}/.../providers/{namespace}/{type}/{name}`", input)
+	}
+
+	// the components are `{namespace}/{type}/{name}` optionally followed by `/{childType}/{childName}`
+	components := strings.Split(input[index+len("/providers/"):], "/")
+	if len(components) < 3 || len(components)%2 == 0 {
+		return nil, fmt.Errorf("Error parsing Resource ID %q: the number of segments following `/providers/` was invalid", input)
+	}
+	for _, component := range components {
+		if component == "" {
+			return nil, fmt.Errorf("Error parsing Resource ID %q: ID contained an empty segment", input)
+		}
+	}
+
+	types := []string{components[0]}
+	for i := 1; i < len(components); i += 2 {
+		types = append(types, components[i])
+	}
+
+	name := components[len(components)-1]
+	parentId := input[:len(input)-len(name)-len(types[len(types)-1])-2]
+	if len(types) == 2 {
+		parentId = input[:index]
+	}
+
+	return &GenericResourceID{
+		ParentId: parentId,
+		Type:     strings.Join(types, "/"),
+		Name:     name,
+	}, nil
+}
+
+func splitResourceType(input string) (string, []string, error) {
+	segments := strings.Split(input, "/")
+	if len(segments) < 2 {
+		return "", nil, fmt.Errorf("the Type %q must be in the format `{namespace}/{type}`, e.g. `Microsoft.Network/virtualNetworks`", input)
+	}
+
+	for _, segment := range segments {
+		if segment == "" {
+			return "", nil, fmt.Errorf("the Type %q contained an empty segment", input)
+		}
+	}
+
+	return segments[0], segments[1:], nil
+}
+
+// resourceTypeFromID returns the Namespace and Types of the Resource with the specified ID, which are empty
+// when the ID is for a Subscription or Resource Group
+func resourceTypeFromID(input string) (string, []string) {
+	index := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if index == -1 {
+		return "", nil
+	}
+
+	components := strings.Split(input[index+len("/providers/"):], "/")
+	types := make([]string, 0)
+	for i := 1; i < len(components); i += 2 {
+		types = append(types, components[i])
+	}
+
+	return components[0], types
+}
@@ -0,0 +1,224 @@
+package resource
+
+import (
+	"testing"
+)
+
+func TestGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    GenericResourceID
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:  "Empty",
+			Input: GenericResourceID{},
+			Error: true,
+		},
+		{
+			Name: "Missing Name",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks",
+			},
+			Error: true,
+		},
+		{
+			Name: "Parent isn't a Resource ID",
+			Input: GenericResourceID{
+				ParentId: "group1",
+				Type:     "Microsoft.Network/virtualNetworks",
+				Name:     "network1",
+			},
+			Error: true,
+		},
+		{
+			Name: "Type without a Namespace",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				Type:     "virtualNetworks",
+				Name:     "network1",
+			},
+			Error: true,
+		},
+		{
+			Name: "Subscription Parent",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111",
+				Type:     "Microsoft.Security/autoProvisioningSettings",
+				Name:     "default",
+			},
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Security/autoProvisioningSettings/default",
+		},
+		{
+			Name: "Resource Group Parent",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks",
+				Name:     "network1",
+			},
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			Name: "Resource Group Parent with a Child Type",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+			Error: true,
+		},
+		{
+			Name: "Child Resource",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			Name: "Child Resource of the wrong Parent Type",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+			Error: true,
+		},
+		{
+			Name: "Extension Resource",
+			Input: GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Authorization/locks",
+				Name:     "lock1",
+			},
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := v.Input.ID()
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *GenericResourceID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Resource Group",
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Expected: nil,
+		},
+		{
+			Name:     "Missing Name",
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			Expected: nil,
+		},
+		{
+			Name:     "Empty Segment",
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network//network1",
+			Expected: nil,
+		},
+		{
+			Name:  "Subscription Parent",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Security/autoProvisioningSettings/default",
+			Expected: &GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111",
+				Type:     "Microsoft.Security/autoProvisioningSettings",
+				Name:     "default",
+			},
+		},
+		{
+			Name:  "Resource Group Parent",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+				Type:     "Microsoft.Network/virtualNetworks",
+				Name:     "network1",
+			},
+		},
+		{
+			Name:  "Child Resource",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Network/virtualNetworks/subnets",
+				Name:     "subnet1",
+			},
+		},
+		{
+			Name:  "Extension Resource",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &GenericResourceID{
+				ParentId: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Type:     "Microsoft.Authorization/locks",
+				Name:     "lock1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ParseGenericResourceID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual.ParentId != v.Expected.ParentId {
+			t.Fatalf("Expected ParentId to be %q but got %q", v.Expected.ParentId, actual.ParentId)
+		}
+
+		if actual.Type != v.Expected.Type {
+			t.Fatalf("Expected Type to be %q but got %q", v.Expected.Type, actual.Type)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected Name to be %q but got %q", v.Expected.Name, actual.Name)
+		}
+
+		// the ID should round-trip
+		id, err := actual.ID()
+		if err != nil {
+			t.Fatalf("Expected the ID to round-trip but got an error: %+v", err)
+		}
+		if id != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, id)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package resource
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// GenericResourcesClient allows arbitrary Resources to be managed by their Resource ID
+//
+// Unlike the `ByID` methods on the SDK's `resources.Client` (which always use the API Version of the
+// Resources API) the API Version for the Resource Type in question must be specified.
+type GenericResourcesClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// GenericResource is the raw JSON representation of a Resource
+type GenericResource struct {
+	autorest.Response `json:"-"`
+
+	Value map[string]interface{}
+}
+
+func NewGenericResourcesClientWithBaseURI(baseURI string) GenericResourcesClient {
+	return GenericResourcesClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+// CreateOrUpdate creates or updates the Resource with the specified ID, returning a Future which can be polled for completion
+func (client GenericResourcesClient) CreateOrUpdate(ctx context.Context, resourceId string, apiVersion string, body map[string]interface{}) (future azure.Future, err error) {
+	req, err := client.preparer(ctx, autorest.AsPut(), resourceId, apiVersion, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(body))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	return client.sendAsync(req, "CreateOrUpdate")
+}
+
+// Delete deletes the Resource with the specified ID, returning a Future which can be polled for completion
+func (client GenericResourcesClient) Delete(ctx context.Context, resourceId string, apiVersion string) (future azure.Future, err error) {
+	req, err := client.preparer(ctx, autorest.AsDelete(), resourceId, apiVersion)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	return client.sendAsync(req, "Delete")
+}
+
+// Get retrieves the raw JSON representation of the Resource with the specified ID
+func (client GenericResourcesClient) Get(ctx context.Context, resourceId string, apiVersion string) (result GenericResource, err error) {
+	req, err := client.preparer(ctx, autorest.AsGet(), resourceId, apiVersion)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Value),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client GenericResourcesClient) preparer(ctx context.Context, method autorest.PrepareDecorator, resourceId string, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceId": strings.TrimPrefix(resourceId, "/"),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		method,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client GenericResourcesClient) sendAsync(req *http.Request, operation string) (future azure.Future, err error) {
+	resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", operation, resp, "Failure sending request")
+	}
+
+	// the initial response is validated when building the Future, which surfaces any API error
+	future, err = azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "resource.GenericResourcesClient", operation, resp, "Failure sending request")
+	}
+
+	return future, nil
+}
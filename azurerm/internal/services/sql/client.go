@@ -2,22 +2,32 @@ package sql
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlpreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type Client struct {
-	DatabasesClient                       *sql.DatabasesClient
-	DatabaseThreatDetectionPoliciesClient *sql.DatabaseThreatDetectionPoliciesClient
-	ElasticPoolsClient                    *sql.ElasticPoolsClient
-	FirewallRulesClient                   *sql.FirewallRulesClient
-	ServersClient                         *sql.ServersClient
-	ServerAzureADAdministratorsClient     *sql.ServerAzureADAdministratorsClient
-	VirtualNetworkRulesClient             *sql.VirtualNetworkRulesClient
+	BackupLongTermRetentionPoliciesClient  *BackupLongTermRetentionPoliciesClient
+	BackupShortTermRetentionPoliciesClient *sqlpreview.BackupShortTermRetentionPoliciesClient
+	DatabasesClient                        *sql.DatabasesClient
+	DatabaseThreatDetectionPoliciesClient  *sql.DatabaseThreatDetectionPoliciesClient
+	ElasticPoolsClient                     *sql.ElasticPoolsClient
+	FailoverGroupsClient                   *sql.FailoverGroupsClient
+	FirewallRulesClient                    *sql.FirewallRulesClient
+	ServersClient                          *sql.ServersClient
+	ServerAzureADAdministratorsClient      *sql.ServerAzureADAdministratorsClient
+	VirtualNetworkRulesClient              *sql.VirtualNetworkRulesClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
 
 	// SQL Azure
+	BackupLongTermRetentionPoliciesClient := NewBackupLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&BackupLongTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	BackupShortTermRetentionPoliciesClient := sqlpreview.NewBackupShortTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&BackupShortTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	DatabasesClient := sql.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatabasesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -27,6 +37,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ElasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ElasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
+	FailoverGroupsClient := sql.NewFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&FailoverGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	FirewallRulesClient := sql.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FirewallRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -40,12 +53,15 @@ func BuildClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&VirtualNetworkRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		DatabasesClient:                       &DatabasesClient,
-		DatabaseThreatDetectionPoliciesClient: &DatabaseThreatDetectionPoliciesClient,
-		ElasticPoolsClient:                    &ElasticPoolsClient,
-		FirewallRulesClient:                   &FirewallRulesClient,
-		ServersClient:                         &ServersClient,
-		ServerAzureADAdministratorsClient:     &ServerAzureADAdministratorsClient,
-		VirtualNetworkRulesClient:             &VirtualNetworkRulesClient,
+		BackupLongTermRetentionPoliciesClient:  &BackupLongTermRetentionPoliciesClient,
+		BackupShortTermRetentionPoliciesClient: &BackupShortTermRetentionPoliciesClient,
+		DatabasesClient:                        &DatabasesClient,
+		DatabaseThreatDetectionPoliciesClient:  &DatabaseThreatDetectionPoliciesClient,
+		ElasticPoolsClient:                     &ElasticPoolsClient,
+		FailoverGroupsClient:                   &FailoverGroupsClient,
+		FirewallRulesClient:                    &FirewallRulesClient,
+		ServersClient:                          &ServersClient,
+		ServerAzureADAdministratorsClient:      &ServerAzureADAdministratorsClient,
+		VirtualNetworkRulesClient:              &VirtualNetworkRulesClient,
 	}
 }
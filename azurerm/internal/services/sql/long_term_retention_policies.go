@@ -0,0 +1,125 @@
+package sql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const longTermRetentionPoliciesAPIVersion = "2017-03-01-preview"
+
+// BackupLongTermRetentionPoliciesClient manages the Long Term Retention Policy of a SQL Database
+//
+// This exists since the `BackupLongTermRetentionPoliciesClient` in the vendored SDK only supports the
+// (deprecated) Recovery Services Vault based policies - rather than the weekly/monthly/yearly retention
+// periods which are configured on the Database itself.
+type BackupLongTermRetentionPoliciesClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+// BackupLongTermRetentionPolicy is the Long Term Retention Policy of a SQL Database
+type BackupLongTermRetentionPolicy struct {
+	autorest.Response `json:"-"`
+
+	ID         *string                                  `json:"id,omitempty"`
+	Name       *string                                  `json:"name,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+	Properties *BackupLongTermRetentionPolicyProperties `json:"properties,omitempty"`
+}
+
+// BackupLongTermRetentionPolicyProperties are the retention periods of a Long Term Retention Policy, where
+// each retention period is an ISO8601 duration and a value of `PT0S` means the backups aren't retained
+type BackupLongTermRetentionPolicyProperties struct {
+	WeeklyRetention  *string `json:"weeklyRetention,omitempty"`
+	MonthlyRetention *string `json:"monthlyRetention,omitempty"`
+	YearlyRetention  *string `json:"yearlyRetention,omitempty"`
+	WeekOfYear       *int32  `json:"weekOfYear,omitempty"`
+}
+
+func NewBackupLongTermRetentionPoliciesClientWithBaseURI(baseURI string, subscriptionID string) BackupLongTermRetentionPoliciesClient {
+	return BackupLongTermRetentionPoliciesClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+// CreateOrUpdate sets the Long Term Retention Policy of the specified Database, returning a Future which can be polled for completion
+func (client BackupLongTermRetentionPoliciesClient) CreateOrUpdate(ctx context.Context, resourceGroup, serverName, databaseName string, properties BackupLongTermRetentionPolicyProperties) (future azure.Future, err error) {
+	body := map[string]interface{}{
+		"properties": properties,
+	}
+
+	req, err := client.preparer(ctx, autorest.AsPut(), resourceGroup, serverName, databaseName, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(body))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "sql.BackupLongTermRetentionPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "sql.BackupLongTermRetentionPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	// the initial response is validated when building the Future, which surfaces any API error
+	future, err = azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "sql.BackupLongTermRetentionPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return future, nil
+}
+
+// Get retrieves the Long Term Retention Policy of the specified Database
+func (client BackupLongTermRetentionPoliciesClient) Get(ctx context.Context, resourceGroup, serverName, databaseName string) (result BackupLongTermRetentionPolicy, err error) {
+	req, err := client.preparer(ctx, autorest.AsGet(), resourceGroup, serverName, databaseName)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "sql.BackupLongTermRetentionPoliciesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "sql.BackupLongTermRetentionPoliciesClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "sql.BackupLongTermRetentionPoliciesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client BackupLongTermRetentionPoliciesClient) preparer(ctx context.Context, method autorest.PrepareDecorator, resourceGroup, serverName, databaseName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"databaseName":      autorest.Encode("path", databaseName),
+		"policyName":        autorest.Encode("path", "default"),
+		"resourceGroupName": autorest.Encode("path", resourceGroup),
+		"serverName":        autorest.Encode("path", serverName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": longTermRetentionPoliciesAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		method,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Sql/servers/{serverName}/databases/{databaseName}/backupLongTermRetentionPolicies/{policyName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,40 @@
+// Package accounts is a client for the Account-level Blob Service properties in the Storage Data Plane API,
+// following the same conventions as the `giovanni` Storage SDK used for the other Storage Data Plane clients.
+//
+// NOTE: the version of `giovanni` vendored doesn't contain this package - this can be replaced with the
+// `accounts` package from `giovanni` once it's upgraded.
+package accounts
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// APIVersion is the version of the API used for all Storage API Operations
+const APIVersion = "2018-11-09"
+
+// Client is the base client for the Blob Service of a Storage Account.
+type Client struct {
+	autorest.Client
+	BaseURI string
+}
+
+// New creates an instance of the Client client.
+func New() Client {
+	return NewWithEnvironment(azure.PublicCloud)
+}
+
+// NewWithEnvironment creates an instance of the Client client.
+func NewWithEnvironment(environment azure.Environment) Client {
+	return Client{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("storage/%s", APIVersion)),
+		BaseURI: environment.StorageEndpointSuffix,
+	}
+}
+
+// getBlobEndpoint returns the endpoint for Blob API Operations on this storage account
+func getBlobEndpoint(baseUri string, accountName string) string {
+	return fmt.Sprintf("https://%s.blob.%s", accountName, baseUri)
+}
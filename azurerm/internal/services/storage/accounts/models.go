@@ -0,0 +1,42 @@
+package accounts
+
+import "github.com/Azure/go-autorest/autorest"
+
+// StorageServiceProperties are the properties of the Blob Service within a Storage Account.
+//
+// Elements which are omitted (nil) are left as-is by the API when setting these properties.
+type StorageServiceProperties struct {
+	Cors                  *Cors                  `xml:"Cors,omitempty"`
+	DefaultServiceVersion *string                `xml:"DefaultServiceVersion,omitempty"`
+	DeleteRetentionPolicy *DeleteRetentionPolicy `xml:"DeleteRetentionPolicy,omitempty"`
+	StaticWebsite         *StaticWebsite         `xml:"StaticWebsite,omitempty"`
+}
+
+type GetServicePropertiesResult struct {
+	autorest.Response
+
+	StorageServiceProperties
+}
+
+type Cors struct {
+	CorsRule []CorsRule `xml:"CorsRule"`
+}
+
+type CorsRule struct {
+	AllowedOrigins  string `xml:"AllowedOrigins"`
+	AllowedMethods  string `xml:"AllowedMethods"`
+	AllowedHeaders  string `xml:"AllowedHeaders"`
+	ExposedHeaders  string `xml:"ExposedHeaders"`
+	MaxAgeInSeconds int    `xml:"MaxAgeInSeconds"`
+}
+
+type DeleteRetentionPolicy struct {
+	Enabled bool `xml:"Enabled"`
+	Days    int  `xml:"Days,omitempty"`
+}
+
+type StaticWebsite struct {
+	Enabled              bool   `xml:"Enabled"`
+	IndexDocument        string `xml:"IndexDocument,omitempty"`
+	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+}
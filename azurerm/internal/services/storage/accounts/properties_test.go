@@ -0,0 +1,94 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSetServicePropertiesPreparer(t *testing.T) {
+	client := New()
+	input := StorageServiceProperties{
+		Cors: &Cors{
+			CorsRule: []CorsRule{},
+		},
+		DeleteRetentionPolicy: &DeleteRetentionPolicy{
+			Enabled: true,
+			Days:    7,
+		},
+		StaticWebsite: &StaticWebsite{
+			Enabled:       true,
+			IndexDocument: "index.html",
+		},
+	}
+
+	req, err := client.SetServicePropertiesPreparer(context.Background(), "example", input)
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	if req.Method != http.MethodPut {
+		t.Fatalf("Expected a PUT request but got %q", req.Method)
+	}
+
+	expectedUrl := "https://example.blob.core.windows.net?comp=properties&restype=service"
+	if req.URL.String() != expectedUrl {
+		t.Fatalf("Expected the URL %q but got %q", expectedUrl, req.URL.String())
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("Error reading body: %+v", err)
+	}
+
+	expectedBody := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<StorageServiceProperties><Cors></Cors><DeleteRetentionPolicy><Enabled>true</Enabled><Days>7</Days></DeleteRetentionPolicy><StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument></StaticWebsite></StorageServiceProperties>"
+	if string(body) != expectedBody {
+		t.Fatalf("Expected the body %q but got %q", expectedBody, string(body))
+	}
+}
+
+func TestGetServicePropertiesResponder(t *testing.T) {
+	body := `<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceProperties>
+  <Cors>
+    <CorsRule>
+      <AllowedMethods>GET,PUT</AllowedMethods>
+      <AllowedOrigins>*</AllowedOrigins>
+      <AllowedHeaders>x-ms-*</AllowedHeaders>
+      <ExposedHeaders>x-ms-*</ExposedHeaders>
+      <MaxAgeInSeconds>200</MaxAgeInSeconds>
+    </CorsRule>
+  </Cors>
+  <DefaultServiceVersion>2018-11-09</DefaultServiceVersion>
+  <DeleteRetentionPolicy><Enabled>true</Enabled><Days>14</Days></DeleteRetentionPolicy>
+  <StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument><ErrorDocument404Path>404.html</ErrorDocument404Path></StaticWebsite>
+</StorageServiceProperties>`
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+
+	result, err := New().GetServicePropertiesResponder(resp)
+	if err != nil {
+		t.Fatalf("Error parsing response: %+v", err)
+	}
+
+	if result.Cors == nil || len(result.Cors.CorsRule) != 1 || !strings.EqualFold(result.Cors.CorsRule[0].AllowedMethods, "GET,PUT") {
+		t.Fatalf("Expected a single CORS Rule but got %+v", result.Cors)
+	}
+
+	if result.DefaultServiceVersion == nil || *result.DefaultServiceVersion != "2018-11-09" {
+		t.Fatalf("Expected the Default Service Version to be `2018-11-09` but got %+v", result.DefaultServiceVersion)
+	}
+
+	if result.DeleteRetentionPolicy == nil || !result.DeleteRetentionPolicy.Enabled || result.DeleteRetentionPolicy.Days != 14 {
+		t.Fatalf("Expected a Delete Retention Policy of 14 days but got %+v", result.DeleteRetentionPolicy)
+	}
+
+	if result.StaticWebsite == nil || result.StaticWebsite.IndexDocument != "index.html" || result.StaticWebsite.ErrorDocument404Path != "404.html" {
+		t.Fatalf("Expected a Static Website but got %+v", result.StaticWebsite)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/authorizers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/containers"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/file/directories"
@@ -20,32 +21,39 @@ import (
 )
 
 type Client struct {
-	QueuesClient queues.Client
-	// this is currently unexported since we only use it to look up the account key
-	// we could export/use this in the future - but there's no point it being public
-	// until that time
-	accountsClient storage.AccountsClient
-	environment    az.Environment
+	AccountsClient           storage.AccountsClient
+	AccountsDataPlaneClient  accounts.Client
+	ManagementPoliciesClient storage.ManagementPoliciesClient
+	QueuesClient             queues.Client
+	environment              az.Environment
 }
 
 // NOTE: this temporarily diverges from the other clients until we move this client in here
 // once we have this, can take an Options like everything else
 func BuildClient(accountsClient storage.AccountsClient, options *common.ClientOptions) *Client {
+	accountsDataPlaneClient := accounts.NewWithEnvironment(options.Environment)
+	options.ConfigureClient(&accountsDataPlaneClient.Client, options.StorageAuthorizer)
+
+	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
+
 	queuesClient := queues.New()
 	options.ConfigureClient(&queuesClient.Client, options.StorageAuthorizer)
 
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
 	return &Client{
-		accountsClient: accountsClient,
-		environment:    options.Environment,
+		environment: options.Environment,
 
-		QueuesClient: queuesClient,
+		AccountsClient:           accountsClient,
+		AccountsDataPlaneClient:  accountsDataPlaneClient,
+		ManagementPoliciesClient: managementPoliciesClient,
+		QueuesClient:             queuesClient,
 	}
 }
 
 func (client Client) FindResourceGroup(ctx context.Context, accountName string) (*string, error) {
-	accounts, err := client.accountsClient.List(ctx)
+	accounts, err := client.AccountsClient.List(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("Error listing Storage Accounts (to find Resource Group for %q): %s", accountName, err)
 	}
@@ -147,7 +155,7 @@ func (client Client) TablesClient(ctx context.Context, resourceGroup, accountNam
 }
 
 func (client Client) findAccountKey(ctx context.Context, resourceGroup, accountName string) (*string, error) {
-	props, err := client.accountsClient.ListKeys(ctx, resourceGroup, accountName)
+	props, err := client.AccountsClient.ListKeys(ctx, resourceGroup, accountName)
 	if err != nil {
 		return nil, fmt.Errorf("Error Listing Keys for Storage Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
 	}
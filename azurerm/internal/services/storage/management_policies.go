@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// CreateOrUpdateManagementPolicy sets the Management Policy for the specified Storage Account.
+//
+// This exists since the `CreateOrUpdate` method in the vendored SDK discards the `properties` of the
+// Management Policy when building the request - as such we build the request ourselves and then use
+// the Sender/Responder from the SDK to send it and parse the response.
+func (client Client) CreateOrUpdateManagementPolicy(ctx context.Context, resourceGroup, accountName string, properties storage.ManagementPolicyProperties) (result storage.ManagementPolicy, err error) {
+	policiesClient := client.ManagementPoliciesClient
+
+	pathParameters := map[string]interface{}{
+		"accountName":          autorest.Encode("path", accountName),
+		"managementPolicyName": autorest.Encode("path", "default"),
+		"resourceGroupName":    autorest.Encode("path", resourceGroup),
+		"subscriptionId":       autorest.Encode("path", policiesClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": "2019-04-01",
+	}
+
+	body := map[string]interface{}{
+		"properties": properties,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(policiesClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{accountName}/managementPolicies/{managementPolicyName}", pathParameters),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(queryParameters))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := policiesClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = policiesClient.CreateOrUpdateResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return
+}
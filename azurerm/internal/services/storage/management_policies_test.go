@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestCreateOrUpdateManagementPolicySendsProperties(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedPath := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/managementPolicies/default"
+		if r.Method != http.MethodPut || r.URL.Path != expectedPath {
+			t.Errorf("Expected a PUT to %q but got a %s to %q", expectedPath, r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Error decoding request body: %+v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "policy1"}`))
+	}))
+	defer server.Close()
+
+	client := Client{
+		ManagementPoliciesClient: storage.NewManagementPoliciesClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000"),
+	}
+	properties := storage.ManagementPolicyProperties{
+		Policy: &storage.ManagementPolicySchema{
+			Rules: &[]storage.ManagementPolicyRule{
+				{
+					Name:    utils.String("rule1"),
+					Enabled: utils.Bool(true),
+					Type:    utils.String("Lifecycle"),
+				},
+			},
+		},
+	}
+
+	result, err := client.CreateOrUpdateManagementPolicy(context.Background(), "group1", "account1", properties)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if result.ID == nil || *result.ID != "policy1" {
+		t.Fatalf("Expected the response to be parsed but got %+v", result)
+	}
+
+	props, ok := received["properties"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected `properties` to be sent but got %+v", received)
+	}
+
+	rules := props["policy"].(map[string]interface{})["rules"].([]interface{})
+	if len(rules) != 1 || rules[0].(map[string]interface{})["name"] != "rule1" {
+		t.Fatalf("Expected a single rule named `rule1` but got %+v", rules)
+	}
+}
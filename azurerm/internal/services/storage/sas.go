@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ServiceSASSignedVersion is the version of the Storage API used to sign Service SAS Tokens - notably
+// from this version the Signed Resource and Snapshot Time form part of the string-to-sign for Blobs
+const ServiceSASSignedVersion = "2018-11-09"
+
+// ServiceSASOptions are the options used to compute a Service SAS Token, which is signed locally using
+// the Storage Account's Access Key: https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+type ServiceSASOptions struct {
+	AccountName string
+	AccountKey  string
+
+	// Permissions, Start and Expiry can be omitted when they're defined in the Stored Access Policy
+	// referenced by the Identifier
+	Permissions string
+	Start       string
+	Expiry      string
+	Identifier  string
+
+	// IPRange is either a single IP Address or a range of IP Addresses separated by a hyphen
+	IPRange  string
+	Protocol string
+
+	// these override the response headers returned when the Blob is retrieved using this SAS Token,
+	// and are only supported for Blobs and Containers
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	ContentType        string
+}
+
+// ComputeContainerSASToken computes a Service SAS Token granting access to the specified Blob Container
+func ComputeContainerSASToken(options ServiceSASOptions, containerName string) (string, error) {
+	canonicalizedResource := fmt.Sprintf("/blob/%s/%s", options.AccountName, containerName)
+	return computeBlobServiceSASToken(options, canonicalizedResource, "c")
+}
+
+// ComputeBlobSASToken computes a Service SAS Token granting access to the specified Blob
+func ComputeBlobSASToken(options ServiceSASOptions, containerName string, blobName string) (string, error) {
+	canonicalizedResource := fmt.Sprintf("/blob/%s/%s/%s", options.AccountName, containerName, blobName)
+	return computeBlobServiceSASToken(options, canonicalizedResource, "b")
+}
+
+// ComputeQueueSASToken computes a Service SAS Token granting access to the specified Queue
+func ComputeQueueSASToken(options ServiceSASOptions, queueName string) (string, error) {
+	canonicalizedResource := fmt.Sprintf("/queue/%s/%s", options.AccountName, queueName)
+
+	stringToSign := strings.Join([]string{
+		options.Permissions,
+		options.Start,
+		options.Expiry,
+		canonicalizedResource,
+		options.Identifier,
+		options.IPRange,
+		options.Protocol,
+		ServiceSASSignedVersion,
+	}, "\n")
+
+	signature, err := signServiceSAS(options.AccountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	values := serviceSASQueryValues(options, signature)
+	return "?" + values.Encode(), nil
+}
+
+func computeBlobServiceSASToken(options ServiceSASOptions, canonicalizedResource string, signedResource string) (string, error) {
+	// the Signed Snapshot Time is intentionally empty, since SAS Tokens for Snapshots aren't supported
+	stringToSign := strings.Join([]string{
+		options.Permissions,
+		options.Start,
+		options.Expiry,
+		canonicalizedResource,
+		options.Identifier,
+		options.IPRange,
+		options.Protocol,
+		ServiceSASSignedVersion,
+		signedResource,
+		"",
+		options.CacheControl,
+		options.ContentDisposition,
+		options.ContentEncoding,
+		options.ContentLanguage,
+		options.ContentType,
+	}, "\n")
+
+	signature, err := signServiceSAS(options.AccountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	values := serviceSASQueryValues(options, signature)
+	values.Set("sr", signedResource)
+	setIfNotEmpty(values, "rscc", options.CacheControl)
+	setIfNotEmpty(values, "rscd", options.ContentDisposition)
+	setIfNotEmpty(values, "rsce", options.ContentEncoding)
+	setIfNotEmpty(values, "rscl", options.ContentLanguage)
+	setIfNotEmpty(values, "rsct", options.ContentType)
+
+	return "?" + values.Encode(), nil
+}
+
+func serviceSASQueryValues(options ServiceSASOptions, signature string) url.Values {
+	values := url.Values{}
+	values.Set("sv", ServiceSASSignedVersion)
+	values.Set("sig", signature)
+	setIfNotEmpty(values, "sp", options.Permissions)
+	setIfNotEmpty(values, "st", options.Start)
+	setIfNotEmpty(values, "se", options.Expiry)
+	setIfNotEmpty(values, "si", options.Identifier)
+	setIfNotEmpty(values, "sip", options.IPRange)
+	setIfNotEmpty(values, "spr", options.Protocol)
+	return values
+}
+
+func signServiceSAS(accountKey string, stringToSign string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the Storage Account Key: %+v", err)
+	}
+
+	hasher := hmac.New(sha256.New, key)
+	if _, err := hasher.Write([]byte(stringToSign)); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func setIfNotEmpty(values url.Values, key string, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
@@ -0,0 +1,114 @@
+package storage
+
+import "testing"
+
+const (
+	// this Access Key was for a real Storage Account which has since been deleted
+	testSASAccountName = "azurermtestsa0"
+	testSASAccountKey  = "2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw=="
+)
+
+func TestComputeContainerSASToken(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Options  ServiceSASOptions
+		Expected string
+	}{
+		{
+			Name: "Permissions",
+			Options: ServiceSASOptions{
+				AccountName: testSASAccountName,
+				AccountKey:  testSASAccountKey,
+				Permissions: "rwl",
+				Start:       "2019-01-01T00:00:00Z",
+				Expiry:      "2019-01-02T00:00:00Z",
+				Protocol:    "https",
+			},
+			Expected: "?se=2019-01-02T00%3A00%3A00Z&sig=ulxM9Yg7UkWhr3qcZ4JMLeJepQC7tKzK7jWrhPKIBaw%3D&sp=rwl&spr=https&sr=c&st=2019-01-01T00%3A00%3A00Z&sv=2018-11-09",
+		},
+		{
+			Name: "Stored Access Policy with Headers",
+			Options: ServiceSASOptions{
+				AccountName:  testSASAccountName,
+				AccountKey:   testSASAccountKey,
+				Identifier:   "policy1",
+				IPRange:      "168.1.5.60-168.1.5.70",
+				Protocol:     "https,http",
+				CacheControl: "max-age=5",
+				ContentType:  "application/json",
+			},
+			Expected: "?rscc=max-age%3D5&rsct=application%2Fjson&si=policy1&sig=ABhvuE84VCXtP1yK1yHsMREB1Ydc2fzl5TVLat0a2OU%3D&sip=168.1.5.60-168.1.5.70&spr=https%2Chttp&sr=c&sv=2018-11-09",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := ComputeContainerSASToken(v.Options, "images")
+		if err != nil {
+			t.Fatalf("Error computing SAS Token: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestComputeBlobSASToken(t *testing.T) {
+	options := ServiceSASOptions{
+		AccountName:        testSASAccountName,
+		AccountKey:         testSASAccountKey,
+		Permissions:        "r",
+		Start:              "2019-01-01T00:00:00Z",
+		Expiry:             "2019-01-02T00:00:00Z",
+		Protocol:           "https",
+		ContentDisposition: "attachment; filename=example.txt",
+		ContentEncoding:    "gzip",
+		ContentLanguage:    "en-GB",
+	}
+	expected := "?rscd=attachment%3B+filename%3Dexample.txt&rsce=gzip&rscl=en-GB&se=2019-01-02T00%3A00%3A00Z&sig=milRe1KUK6tti0eXbg1voKNzbgJjdGQs7OVdfrTZR5w%3D&sp=r&spr=https&sr=b&st=2019-01-01T00%3A00%3A00Z&sv=2018-11-09"
+
+	actual, err := ComputeBlobSASToken(options, "images", "example.txt")
+	if err != nil {
+		t.Fatalf("Error computing SAS Token: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestComputeQueueSASToken(t *testing.T) {
+	options := ServiceSASOptions{
+		AccountName: testSASAccountName,
+		AccountKey:  testSASAccountKey,
+		Permissions: "raup",
+		Start:       "2019-01-01T00:00:00Z",
+		Expiry:      "2019-01-02T00:00:00Z",
+		IPRange:     "10.0.0.1",
+		Protocol:    "https",
+	}
+	expected := "?se=2019-01-02T00%3A00%3A00Z&sig=eFYvQzTBSNjWlW0TtWltYbtExmP92MzuTcFqZag1KtY%3D&sip=10.0.0.1&sp=raup&spr=https&st=2019-01-01T00%3A00%3A00Z&sv=2018-11-09"
+
+	actual, err := ComputeQueueSASToken(options, "messages")
+	if err != nil {
+		t.Fatalf("Error computing SAS Token: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestComputeServiceSASTokenInvalidKey(t *testing.T) {
+	options := ServiceSASOptions{
+		AccountName: testSASAccountName,
+		AccountKey:  "not-base64!",
+		Permissions: "r",
+	}
+
+	if _, err := ComputeQueueSASToken(options, "messages"); err == nil {
+		t.Fatalf("Expected an error when the Account Key isn't base64 encoded but didn't get one")
+	}
+}
@@ -0,0 +1,99 @@
+package tags
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ProviderDefaults are the tags configured in the `default_tags` block of a Provider block. These are held on
+// the Provider's meta (`clients.Client`) such that each (e.g. aliased) Provider block uses its own defaults
+type ProviderDefaults struct {
+	// Tags are merged into the tags of every resource using `Schema()` or `ForceNewSchema()`
+	Tags map[string]string
+
+	// Ignored are the names of any tags (for example those assigned by Azure Policy) which should be ignored on read
+	Ignored []string
+}
+
+// NewProviderDefaults returns the ProviderDefaults for the tags and ignored tag names configured in the Provider block
+func NewProviderDefaults(defaults map[string]interface{}, ignored []string) ProviderDefaults {
+	output := make(map[string]string, len(defaults))
+	for k, v := range defaults {
+		//Validate should have ignored this error already
+		value, _ := TagValueToString(v)
+		output[k] = value
+	}
+
+	return ProviderDefaults{
+		Tags:    output,
+		Ignored: append([]string{}, ignored...),
+	}
+}
+
+// providerDefaultsMeta is implemented by the Provider's meta (`clients.Client`) - which can't be referenced
+// directly since it (indirectly) imports this package
+type providerDefaultsMeta interface {
+	TagDefaults() ProviderDefaults
+}
+
+// defaultsFromMeta returns the ProviderDefaults for the Provider block which the meta belongs to
+func defaultsFromMeta(meta interface{}) ProviderDefaults {
+	if v, ok := meta.(providerDefaultsMeta); ok {
+		return v.TagDefaults()
+	}
+
+	return ProviderDefaults{}
+}
+
+// SupportsDefaults returns whether the `tags` for this resource use `Schema()` or `ForceNewSchema()`,
+// and as such have the `default_tags` defined in the Provider block merged into them
+func SupportsDefaults(resource *schema.Resource) bool {
+	s, ok := resource.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional || !s.Computed || s.ValidateFunc == nil {
+		return false
+	}
+
+	return reflect.ValueOf(s.ValidateFunc).Pointer() == reflect.ValueOf(Validate).Pointer()
+}
+
+// CustomizeDiffWithDefaults returns a CustomizeDiffFunc which merges the `default_tags` defined in the Provider
+// block into the planned tags for the resource (where tags defined on the resource win) - such that defaults
+// which have already been applied don't show a diff, and those which haven't (for example when a new tag is
+// added to the `default_tags` block) do. The existing CustomizeDiffFunc (if any) is called first.
+func CustomizeDiffWithDefaults(existing schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		if existing != nil {
+			if err := existing(d, meta); err != nil {
+				return err
+			}
+		}
+
+		// when the tags aren't known until apply the default tags are merged in via `Expand`
+		if !d.NewValueKnown("tags") {
+			return nil
+		}
+
+		defaults := defaultsFromMeta(meta).Tags
+		if len(defaults) == 0 {
+			return nil
+		}
+
+		planned, _ := d.Get("tags").(map[string]interface{})
+
+		merged := make(map[string]interface{}, len(planned)+len(defaults))
+		for k, v := range defaults {
+			merged[k] = v
+		}
+		for k, v := range planned {
+			merged[k] = v
+		}
+
+		if reflect.DeepEqual(planned, merged) {
+			return nil
+		}
+
+		// where the defaults have already been applied to an existing resource, this removes the diff
+		return d.SetNew("tags", merged)
+	}
+}
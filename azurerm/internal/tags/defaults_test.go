@@ -0,0 +1,339 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// testMeta stands in for the Provider's meta (`clients.Client`)
+type testMeta struct {
+	defaults ProviderDefaults
+}
+
+func (m testMeta) TagDefaults() ProviderDefaults {
+	return m.defaults
+}
+
+func TestExpandWithDefaults(t *testing.T) {
+	meta := testMeta{
+		defaults: NewProviderDefaults(map[string]interface{}{
+			"environment": "production",
+			"cost-center": 1234,
+		}, nil),
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:  "Defaults Only",
+			Input: map[string]interface{}{},
+			Expected: map[string]string{
+				"environment": "production",
+				"cost-center": "1234",
+			},
+		},
+		{
+			Name: "Merged",
+			Input: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]string{
+				"environment": "production",
+				"cost-center": "1234",
+				"hello":       "world",
+			},
+		},
+		{
+			Name: "Resource Wins",
+			Input: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: map[string]string{
+				"environment": "staging",
+				"cost-center": "1234",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := make(map[string]string)
+		for k, v := range Expand(v.Input, meta) {
+			actual[k] = *v
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestExpandWithDefaultsPerProvider(t *testing.T) {
+	// each (e.g. aliased) Provider block has its own defaults
+	first := testMeta{
+		defaults: NewProviderDefaults(map[string]interface{}{"environment": "production"}, nil),
+	}
+	second := testMeta{
+		defaults: NewProviderDefaults(map[string]interface{}{"environment": "staging"}, nil),
+	}
+
+	if v := *Expand(map[string]interface{}{}, first)["environment"]; v != "production" {
+		t.Fatalf("Expected `environment` to be %q but got %q", "production", v)
+	}
+	if v := *Expand(map[string]interface{}{}, second)["environment"]; v != "staging" {
+		t.Fatalf("Expected `environment` to be %q but got %q", "staging", v)
+	}
+	if actual := Expand(map[string]interface{}{}, nil); len(actual) != 0 {
+		t.Fatalf("Expected no tags without defaults but got %+v", actual)
+	}
+}
+
+func TestFlattenAndSetWithIgnoredTags(t *testing.T) {
+	meta := testMeta{
+		defaults: NewProviderDefaults(nil, []string{"CreatedByPolicy"}),
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": Schema()}, map[string]interface{}{})
+	input := map[string]*string{
+		"hello":           utils.String("world"),
+		"createdbypolicy": utils.String("true"),
+	}
+	if err := FlattenAndSet(d, input, meta); err != nil {
+		t.Fatalf("Error setting tags: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"hello": "world",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestCustomizeDiffWithDefaultsChanges(t *testing.T) {
+	meta := testMeta{
+		defaults: NewProviderDefaults(map[string]interface{}{
+			"environment": "production",
+		}, nil),
+	}
+
+	testData := []struct {
+		Name         string
+		State        map[string]string
+		Config       map[string]interface{}
+		ExpectChange bool
+	}{
+		{
+			Name: "Default Tag Only In State",
+			State: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "production",
+				"tags.hello":       "world",
+			},
+			Config: map[string]interface{}{
+				"hello": "world",
+			},
+			ExpectChange: false,
+		},
+		{
+			Name: "Default Tag Value Changed",
+			State: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "staging",
+				"tags.hello":       "world",
+			},
+			Config: map[string]interface{}{
+				"hello": "world",
+			},
+			ExpectChange: true,
+		},
+		{
+			Name: "Default Tag Overridden",
+			State: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "production",
+				"tags.hello":       "world",
+			},
+			Config: map[string]interface{}{
+				"environment": "staging",
+				"hello":       "world",
+			},
+			ExpectChange: true,
+		},
+		{
+			Name: "Default Tag Not Applied",
+			State: map[string]string{
+				"tags.%":     "1",
+				"tags.hello": "world",
+			},
+			Config: map[string]interface{}{
+				"hello": "world",
+			},
+			ExpectChange: true,
+		},
+		{
+			Name: "Default Tag Replaced",
+			State: map[string]string{
+				"tags.%":     "2",
+				"tags.owner": "someone",
+				"tags.hello": "world",
+			},
+			Config: map[string]interface{}{
+				"hello": "world",
+			},
+			ExpectChange: true,
+		},
+		{
+			Name: "Resource Tag Removed",
+			State: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "production",
+				"tags.hello":       "world",
+			},
+			Config: map[string]interface{}{
+				"other": "value",
+			},
+			ExpectChange: true,
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+	}
+	if !SupportsDefaults(resource) {
+		t.Fatalf("Expected the resource to support the default tags")
+	}
+	resource.CustomizeDiff = CustomizeDiffWithDefaults(nil)
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := &terraform.InstanceState{
+			ID:         "test",
+			Attributes: v.State,
+		}
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"tags": v.Config,
+		})
+		if err != nil {
+			t.Fatalf("Error building config: %+v", err)
+		}
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), meta)
+		if err != nil {
+			t.Fatalf("Error computing diff: %+v", err)
+		}
+
+		hasChange := diff != nil && len(diff.Attributes) > 0
+		if hasChange != v.ExpectChange {
+			t.Fatalf("Expected a change to be %t but got %t: %+v", v.ExpectChange, hasChange, diff)
+		}
+	}
+}
+
+func TestCustomizeDiffWithDefaults(t *testing.T) {
+	meta := testMeta{
+		defaults: NewProviderDefaults(map[string]interface{}{
+			"environment": "production",
+			"owner":       "platform",
+		}, nil),
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+		CustomizeDiff: CustomizeDiffWithDefaults(nil),
+	}
+
+	// `owner` has been added to the `default_tags` since the resource was last applied
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"tags.%":           "2",
+			"tags.environment": "production",
+			"tags.hello":       "world",
+		},
+	}
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("Error computing diff: %+v", err)
+	}
+	if diff == nil {
+		t.Fatalf("Expected a diff but didn't get one")
+	}
+
+	expected := map[string]string{
+		"tags.%":     "3",
+		"tags.owner": "platform",
+	}
+	if len(diff.Attributes) != len(expected) {
+		t.Fatalf("Expected %d attributes to change but got %d: %+v", len(expected), len(diff.Attributes), diff)
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("Expected %q to change but it didn't: %+v", k, diff)
+		}
+		if attr.New != v {
+			t.Fatalf("Expected the new value for %q to be %q but got %q", k, v, attr.New)
+		}
+	}
+}
+
+func TestSupportsDefaults(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Schema   map[string]*schema.Schema
+		Expected bool
+	}{
+		{
+			Name:     "No Tags",
+			Schema:   map[string]*schema.Schema{},
+			Expected: false,
+		},
+		{
+			Name:     "Tags",
+			Schema:   map[string]*schema.Schema{"tags": Schema()},
+			Expected: true,
+		},
+		{
+			Name:     "Force New Tags",
+			Schema:   map[string]*schema.Schema{"tags": ForceNewSchema()},
+			Expected: true,
+		},
+		{
+			Name:     "Data Source Tags",
+			Schema:   map[string]*schema.Schema{"tags": DataSourceSchema()},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := SupportsDefaults(&schema.Resource{Schema: v.Schema}); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
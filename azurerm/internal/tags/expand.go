@@ -1,7 +1,15 @@
 package tags
 
-func Expand(tagsMap map[string]interface{}) map[string]*string {
-	output := make(map[string]*string, len(tagsMap))
+// Expand converts the tags defined on a resource into the format used by the Azure SDK, merging in any
+// `default_tags` defined in the Provider block the meta belongs to - where tags defined on the resource win
+func Expand(tagsMap map[string]interface{}, meta interface{}) map[string]*string {
+	defaults := defaultsFromMeta(meta).Tags
+	output := make(map[string]*string, len(tagsMap)+len(defaults))
+
+	for k, v := range defaults {
+		value := v
+		output[k] = &value
+	}
 
 	for i, v := range tagsMap {
 		//Validate should have ignored this error already
@@ -11,7 +11,7 @@ func TestExpand(t *testing.T) {
 	testData["key2"] = 21
 	testData["key3"] = "value3"
 
-	expanded := Expand(testData)
+	expanded := Expand(testData, nil)
 
 	if len(expanded) != 3 {
 		t.Fatalf("Expected 3 results in expanded tag map, got %d", len(expanded))
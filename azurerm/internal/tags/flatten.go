@@ -21,8 +21,10 @@ func Flatten(tagMap map[string]*string) map[string]interface{} {
 	return output
 }
 
-func FlattenAndSet(d *schema.ResourceData, tagMap map[string]*string) error {
-	flattened := Flatten(tagMap)
+// FlattenAndSet sets the tags returned from Azure into the state, omitting any tags which the Provider
+// block the meta belongs to has been configured to ignore (for example those assigned by Azure Policy)
+func FlattenAndSet(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) error {
+	flattened := Flatten(Filter(tagMap, defaultsFromMeta(meta).Ignored...))
 	if err := d.Set("tags", flattened); err != nil {
 		return fmt.Errorf("Error setting `tags`: %s", err)
 	}
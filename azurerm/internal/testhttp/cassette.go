@@ -0,0 +1,122 @@
+package testhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecordingDirEnvVar is the Environment Variable used to override the directory Cassettes are stored in
+const RecordingDirEnvVar = "ARM_TEST_RECORDING_DIR"
+
+const defaultRecordingDir = "testdata/recordings"
+
+// Interaction is a single request/response pair within a Cassette
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	used bool
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+// Cassette is the set of Interactions recorded for a single Acceptance Test
+type Cassette struct {
+	Name         string         `json:"name"`
+	Interactions []*Interaction `json:"interactions"`
+
+	path string
+	mu   sync.Mutex
+}
+
+func cassettePath(name string) string {
+	dir := os.Getenv(RecordingDirEnvVar)
+	if dir == "" {
+		dir = defaultRecordingDir
+	}
+
+	// sub-tests contain a `/` which we don't want to treat as a directory
+	fileName := strings.Replace(name, "/", "_", -1)
+	return filepath.Join(dir, fmt.Sprintf("%s.json", fileName))
+}
+
+func newCassette(name string) *Cassette {
+	return &Cassette{
+		Name:         name,
+		Interactions: make([]*Interaction, 0),
+		path:         cassettePath(name),
+	}
+}
+
+func loadCassette(name string) (*Cassette, error) {
+	path := cassettePath(name)
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Cassette %q: %+v", path, err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		return nil, fmt.Errorf("Error parsing Cassette %q: %+v", path, err)
+	}
+	cassette.path = path
+
+	return &cassette, nil
+}
+
+// add appends the Interaction to the Cassette and persists it to disk
+func (c *Cassette) add(interaction *Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("Error creating directory for Cassette %q: %+v", c.path, err)
+	}
+
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error serializing Cassette %q: %+v", c.path, err)
+	}
+
+	if err := ioutil.WriteFile(c.path, contents, 0644); err != nil {
+		return fmt.Errorf("Error writing Cassette %q: %+v", c.path, err)
+	}
+
+	return nil
+}
+
+// next returns the first unused Interaction matching the Method and URL - and marks it as used
+func (c *Cassette) next(method, url string) (*Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, interaction := range c.Interactions {
+		if interaction.used {
+			continue
+		}
+
+		if strings.EqualFold(interaction.Request.Method, method) && interaction.Request.URL == url {
+			interaction.used = true
+			return interaction, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No unused Interaction was found in Cassette %q for %s %s", c.path, method, url)
+}
@@ -0,0 +1,207 @@
+package testhttp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/testhttp/fakearm"
+)
+
+// RecordedSubscriptionId is the Subscription ID which replaces the real Subscription ID within a Cassette
+const RecordedSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+var (
+	currentCassetteLock sync.Mutex
+	currentCassette     *Cassette
+
+	fakeServerLock sync.Mutex
+	fakeServer     *fakearm.Server
+)
+
+// Start begins recording into (or replaying from) the Cassette for the specified Acceptance Test.
+//
+// Since a single Cassette is active at any one time, Acceptance Tests must be run with `-parallel 1`
+// when recording or replaying.
+func Start(name string) error {
+	var cassette *Cassette
+
+	switch CurrentMode() {
+	case ModeRecord:
+		cassette = newCassette(name)
+	case ModeReplay:
+		c, err := loadCassette(name)
+		if err != nil {
+			return err
+		}
+		cassette = c
+	default:
+		return nil
+	}
+
+	currentCassetteLock.Lock()
+	currentCassette = cassette
+	currentCassetteLock.Unlock()
+
+	return nil
+}
+
+func activeCassette() *Cassette {
+	currentCassetteLock.Lock()
+	defer currentCassetteLock.Unlock()
+
+	return currentCassette
+}
+
+// FakeServer returns the in-process fake of Azure Resource Manager used when running in `fake` mode
+func FakeServer() *fakearm.Server {
+	fakeServerLock.Lock()
+	defer fakeServerLock.Unlock()
+
+	if fakeServer == nil {
+		fakeServer = fakearm.NewServer()
+	}
+
+	return fakeServer
+}
+
+// Configure wraps the Sender of the specified client based on the current Mode - which is a no-op when running live.
+//
+// When running offline the client is also configured not to authenticate or wait between polling requests.
+func Configure(client *autorest.Client, subscriptionId string) {
+	mode := CurrentMode()
+	switch mode {
+	case ModeRecord:
+		client.Sender = recordingSender(client.Sender, subscriptionId)
+	case ModeReplay:
+		client.Sender = replayingSender()
+	case ModeFake:
+		client.Sender = FakeServer().Sender()
+	default:
+		return
+	}
+
+	log.Printf("[DEBUG] Configured the HTTP Client in %q mode", string(mode))
+
+	if IsOffline() {
+		client.Authorizer = autorest.NullAuthorizer{}
+		client.PollingDelay = 0
+		client.RetryDuration = 0
+	}
+}
+
+func recordingSender(sender autorest.Sender, subscriptionId string) autorest.Sender {
+	sanitize := func(input string) string {
+		if subscriptionId == "" {
+			return input
+		}
+
+		return strings.Replace(input, subscriptionId, RecordedSubscriptionId, -1)
+	}
+
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		var requestBody []byte
+		if r.Body != nil {
+			b, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return nil, fmt.Errorf("Error reading the Request Body: %+v", err)
+			}
+			r.Body.Close()
+			requestBody = b
+			r.Body = ioutil.NopCloser(bytes.NewReader(requestBody))
+		}
+
+		resp, err := sender.Do(r)
+		if err != nil {
+			return resp, err
+		}
+
+		cassette := activeCassette()
+		if cassette == nil {
+			return resp, err
+		}
+
+		var responseBody []byte
+		if resp.Body != nil {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("Error reading the Response Body: %+v", err)
+			}
+			resp.Body.Close()
+			responseBody = b
+			resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+		}
+
+		headers := make(map[string][]string)
+		for key, values := range resp.Header {
+			sanitized := make([]string, 0, len(values))
+			for _, v := range values {
+				sanitized = append(sanitized, sanitize(v))
+			}
+			headers[key] = sanitized
+		}
+
+		// secrets (e.g. passwords, access keys and connection strings) mustn't be persisted into the Cassette
+		interaction := &Interaction{
+			Request: Request{
+				Method: r.Method,
+				URL:    redactString(sanitize(r.URL.String())),
+				Body:   redactBody(sanitize(string(requestBody))),
+			},
+			Response: Response{
+				StatusCode: resp.StatusCode,
+				Headers:    redactHeaders(headers),
+				Body:       redactBody(sanitize(string(responseBody))),
+			},
+		}
+		if err := cassette.add(interaction); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	})
+}
+
+func replayingSender() autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		cassette := activeCassette()
+		if cassette == nil {
+			return nil, fmt.Errorf("Unable to replay %s %s: no Cassette has been started", r.Method, r.URL.String())
+		}
+
+		interaction, err := cassette.next(r.Method, r.URL.String())
+		if err != nil {
+			return nil, err
+		}
+
+		header := make(http.Header)
+		for key, values := range interaction.Response.Headers {
+			for _, v := range values {
+				header.Add(key, v)
+			}
+		}
+
+		// there's no need to wait between polling requests when replaying
+		if header.Get(autorest.HeaderRetryAfter) != "" {
+			header.Set(autorest.HeaderRetryAfter, "0")
+		}
+
+		body := []byte(interaction.Response.Body)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       r,
+		}, nil
+	})
+}
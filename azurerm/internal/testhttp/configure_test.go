@@ -0,0 +1,117 @@
+package testhttp
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/testhttp/fakearm"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testhttp")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv(RecordingDirEnvVar, dir)
+	defer os.Unsetenv(RecordingDirEnvVar)
+	defer os.Unsetenv(ModeEnvVar)
+
+	ctx := context.TODO()
+	realSubscriptionId := "11111111-1111-1111-1111-111111111111"
+
+	// record against the fake, which stands in for Azure
+	os.Setenv(ModeEnvVar, string(ModeRecord))
+	if err := Start(t.Name()); err != nil {
+		t.Fatalf("Error starting recording: %+v", err)
+	}
+
+	recordClient := resources.NewGroupsClient(realSubscriptionId)
+	recordClient.Sender = fakearm.NewServer().Sender()
+	Configure(&recordClient.Client, realSubscriptionId)
+
+	input := resources.Group{
+		Location: to.StringPtr("westeurope"),
+	}
+	if _, err := recordClient.CreateOrUpdate(ctx, "recorded", input); err != nil {
+		t.Fatalf("Error creating Resource Group: %+v", err)
+	}
+	future, err := recordClient.Delete(ctx, "recorded")
+	if err != nil {
+		t.Fatalf("Error deleting Resource Group: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, recordClient.Client); err != nil {
+		t.Fatalf("Error waiting for deletion of Resource Group: %+v", err)
+	}
+
+	cassette, err := loadCassette(t.Name())
+	if err != nil {
+		t.Fatalf("Error loading Cassette: %+v", err)
+	}
+	if len(cassette.Interactions) != 4 {
+		t.Fatalf("Expected 4 Interactions but got %d", len(cassette.Interactions))
+	}
+	for _, interaction := range cassette.Interactions {
+		if strings.Contains(interaction.Request.URL, realSubscriptionId) || strings.Contains(interaction.Response.Body, realSubscriptionId) {
+			t.Fatalf("Expected the Subscription ID to be sanitized but got %+v", interaction)
+		}
+	}
+
+	// then replay, without the fake
+	os.Setenv(ModeEnvVar, string(ModeReplay))
+	if err := Start(t.Name()); err != nil {
+		t.Fatalf("Error starting replay: %+v", err)
+	}
+
+	replayClient := resources.NewGroupsClient(RecordedSubscriptionId)
+	Configure(&replayClient.Client, RecordedSubscriptionId)
+
+	group, err := replayClient.CreateOrUpdate(ctx, "recorded", input)
+	if err != nil {
+		t.Fatalf("Error replaying creation of Resource Group: %+v", err)
+	}
+	if *group.ID != "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/recorded" {
+		t.Fatalf("Unexpected ID %q", *group.ID)
+	}
+	future, err = replayClient.Delete(ctx, "recorded")
+	if err != nil {
+		t.Fatalf("Error replaying deletion of Resource Group: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, replayClient.Client); err != nil {
+		t.Fatalf("Error replaying wait for deletion of Resource Group: %+v", err)
+	}
+
+	// every Interaction has been used, so further requests should fail
+	if _, err := replayClient.Get(ctx, "recorded"); err == nil {
+		t.Fatalf("Expected an error when no Interaction remains but didn't get one")
+	}
+}
+
+func TestRandIntIsDeterministic(t *testing.T) {
+	first := RandInt()
+	second := RandInt()
+	if first == second {
+		t.Fatalf("Expected successive values to differ but got %d twice", first)
+	}
+
+	for _, v := range []int{first, second} {
+		if v < 100000000000000000 || v > 999999999999999999 {
+			t.Fatalf("Expected an 18 digit value but got %d", v)
+		}
+	}
+
+	// values are derived from the Test name and a per-Test counter
+	randIntCountersLock.Lock()
+	delete(randIntCounters, "TestRandIntIsDeterministic")
+	randIntCountersLock.Unlock()
+
+	if v := RandInt(); v != first {
+		t.Fatalf("Expected %d but got %d", first, v)
+	}
+}
@@ -0,0 +1,76 @@
+package fakearm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// operationPollCount is the number of times an Operation returns `202 Accepted` before it completes,
+// which ensures that clients actually poll for the result of Long Running Operations
+const operationPollCount = 1
+
+type operation struct {
+	remainingPolls int
+	complete       func()
+}
+
+// startOperation registers a Long Running Operation, returning its ID
+func (s *Server) startOperation(complete func()) string {
+	s.operationCount++
+	operationId := fmt.Sprintf("operation-%d", s.operationCount)
+	s.operations[operationId] = &operation{
+		remainingPolls: operationPollCount,
+		complete:       complete,
+	}
+	return operationId
+}
+
+func (s *Server) operationResult(w http.ResponseWriter, r *http.Request, subscriptionId, operationId string) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	op, exists := s.operations[operationId]
+	if !exists {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The Operation %q was not found", operationId))
+		return
+	}
+
+	if op.remainingPolls > 0 {
+		op.remainingPolls--
+		writeAccepted(w, r, subscriptionId, operationId)
+		return
+	}
+
+	if op.complete != nil {
+		op.complete()
+		op.complete = nil
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func baseUri(r *http.Request) string {
+	scheme := r.URL.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	host := r.URL.Host
+	if host == "" {
+		host = r.Host
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, host)
+}
+
+// writeAccepted returns a `202 Accepted` with the Location to poll for the result of the Operation
+func writeAccepted(w http.ResponseWriter, r *http.Request, subscriptionId string, operationId string) {
+	location := fmt.Sprintf("%s/subscriptions/%s/operationresults/%s", baseUri(r), subscriptionId, operationId)
+
+	w.Header().Set("Location", location)
+	w.Header().Set(autorest.HeaderRetryAfter, "0")
+	w.WriteHeader(http.StatusAccepted)
+}
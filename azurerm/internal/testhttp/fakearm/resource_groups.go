@@ -0,0 +1,175 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type resourceGroup struct {
+	SubscriptionId    string
+	Name              string
+	Location          string
+	ManagedBy         string
+	Tags              map[string]string
+	ProvisioningState string
+}
+
+type resourceGroupProperties struct {
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+type resourceGroupPayload struct {
+	ID         string                   `json:"id,omitempty"`
+	Name       string                   `json:"name,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	ManagedBy  *string                  `json:"managedBy,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Properties *resourceGroupProperties `json:"properties,omitempty"`
+}
+
+func resourceGroupKey(subscriptionId, name string) string {
+	// Resource Group names are case-insensitive
+	return strings.ToLower(fmt.Sprintf("%s/%s", subscriptionId, name))
+}
+
+func (g resourceGroup) id() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", g.SubscriptionId, g.Name)
+}
+
+func (g resourceGroup) payload() resourceGroupPayload {
+	location := g.Location
+	payload := resourceGroupPayload{
+		ID:       g.id(),
+		Name:     g.Name,
+		Type:     "Microsoft.Resources/resourceGroups",
+		Location: &location,
+		Properties: &resourceGroupProperties{
+			ProvisioningState: g.ProvisioningState,
+		},
+	}
+
+	if g.ManagedBy != "" {
+		managedBy := g.ManagedBy
+		payload.ManagedBy = &managedBy
+	}
+
+	if len(g.Tags) > 0 {
+		tags := g.Tags
+		payload.Tags = &tags
+	}
+
+	return payload
+}
+
+func (s *Server) resourceGroup(w http.ResponseWriter, r *http.Request, subscriptionId, name string) {
+	key := resourceGroupKey(subscriptionId, name)
+	existing, exists := s.resourceGroups[key]
+
+	switch r.Method {
+	case http.MethodHead:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodGet:
+		if !exists {
+			writeResourceGroupNotFound(w, name)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing.payload())
+
+	case http.MethodPut:
+		var input resourceGroupPayload
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid: %+v", err))
+			return
+		}
+
+		if input.Location == nil || *input.Location == "" {
+			writeError(w, http.StatusBadRequest, "LocationRequired", "The location property is required for this definition.")
+			return
+		}
+
+		if exists {
+			if !strings.EqualFold(existing.Location, *input.Location) {
+				message := fmt.Sprintf("Invalid resource group location '%s'. The Resource group already exists in location '%s'.", *input.Location, existing.Location)
+				writeError(w, http.StatusConflict, "InvalidResourceGroupLocation", message)
+				return
+			}
+
+			if existing.ProvisioningState == "Deleting" {
+				writeError(w, http.StatusConflict, "ResourceGroupBeingDeleted", fmt.Sprintf("The resource group '%s' is in deprovisioning state and cannot perform this operation.", name))
+				return
+			}
+		}
+
+		group := &resourceGroup{
+			SubscriptionId:    subscriptionId,
+			Name:              name,
+			Location:          *input.Location,
+			ProvisioningState: "Succeeded",
+		}
+		if exists {
+			// the casing of the name/location is retained from when the Resource Group was created
+			group.Name = existing.Name
+			group.Location = existing.Location
+		}
+		if input.ManagedBy != nil {
+			group.ManagedBy = *input.ManagedBy
+		}
+		if input.Tags != nil {
+			group.Tags = *input.Tags
+		}
+		s.resourceGroups[key] = group
+
+		statusCode := http.StatusCreated
+		if exists {
+			statusCode = http.StatusOK
+		}
+		writeJSON(w, statusCode, group.payload())
+
+	case http.MethodPatch:
+		if !exists {
+			writeResourceGroupNotFound(w, name)
+			return
+		}
+
+		var input resourceGroupPayload
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("The request content was invalid: %+v", err))
+			return
+		}
+
+		if input.ManagedBy != nil {
+			existing.ManagedBy = *input.ManagedBy
+		}
+		if input.Tags != nil {
+			existing.Tags = *input.Tags
+		}
+		writeJSON(w, http.StatusOK, existing.payload())
+
+	case http.MethodDelete:
+		if !exists {
+			writeResourceGroupNotFound(w, name)
+			return
+		}
+
+		existing.ProvisioningState = "Deleting"
+		operationId := s.startOperation(func() {
+			delete(s.resourceGroups, key)
+		})
+		writeAccepted(w, r, subscriptionId, operationId)
+
+	default:
+		writeMethodNotAllowed(w, r)
+	}
+}
+
+func writeResourceGroupNotFound(w http.ResponseWriter, name string) {
+	writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", name))
+}
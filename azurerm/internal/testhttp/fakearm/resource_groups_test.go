@@ -0,0 +1,170 @@
+package fakearm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+const testSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func testGroupsClient(server *Server) resources.GroupsClient {
+	client := resources.NewGroupsClient(testSubscriptionId)
+	client.Authorizer = autorest.NullAuthorizer{}
+	client.Sender = server.Sender()
+	client.PollingDelay = 0
+	return client
+}
+
+func TestResourceGroupLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	client := testGroupsClient(NewServer())
+
+	resp, err := client.Get(ctx, "example")
+	if err == nil {
+		t.Fatalf("Expected an error retrieving a Resource Group which doesn't exist but didn't get one")
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 but got a %d", resp.StatusCode)
+	}
+
+	input := resources.Group{
+		Location: to.StringPtr("westeurope"),
+		Tags: map[string]*string{
+			"environment": to.StringPtr("testing"),
+		},
+	}
+	created, err := client.CreateOrUpdate(ctx, "example", input)
+	if err != nil {
+		t.Fatalf("Error creating Resource Group: %+v", err)
+	}
+	if created.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected a 201 but got a %d", created.StatusCode)
+	}
+	if *created.ID != "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example" {
+		t.Fatalf("Unexpected ID %q", *created.ID)
+	}
+
+	// Resource Group names are case-insensitive
+	existing, err := client.Get(ctx, "EXAMPLE")
+	if err != nil {
+		t.Fatalf("Error retrieving Resource Group: %+v", err)
+	}
+	if *existing.Name != "example" {
+		t.Fatalf("Expected the Name to be %q but got %q", "example", *existing.Name)
+	}
+	if *existing.Properties.ProvisioningState != "Succeeded" {
+		t.Fatalf("Expected the Provisioning State to be `Succeeded` but got %q", *existing.Properties.ProvisioningState)
+	}
+	if v := existing.Tags["environment"]; v == nil || *v != "testing" {
+		t.Fatalf("Expected the tag `environment` to be `testing` but got %+v", existing.Tags)
+	}
+
+	input.Tags = map[string]*string{}
+	updated, err := client.CreateOrUpdate(ctx, "example", input)
+	if err != nil {
+		t.Fatalf("Error updating Resource Group: %+v", err)
+	}
+	if updated.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 but got a %d", updated.StatusCode)
+	}
+	if len(updated.Tags) != 0 {
+		t.Fatalf("Expected no tags but got %+v", updated.Tags)
+	}
+
+	exists, err := client.CheckExistence(ctx, "example")
+	if err != nil {
+		t.Fatalf("Error checking for the existence of the Resource Group: %+v", err)
+	}
+	if exists.StatusCode != http.StatusNoContent {
+		t.Fatalf("Expected a 204 but got a %d", exists.StatusCode)
+	}
+
+	future, err := client.Delete(ctx, "example")
+	if err != nil {
+		t.Fatalf("Error deleting Resource Group: %+v", err)
+	}
+
+	// the Resource Group remains until the Long Running Operation has been polled
+	deleting, err := client.Get(ctx, "example")
+	if err != nil {
+		t.Fatalf("Error retrieving Resource Group: %+v", err)
+	}
+	if *deleting.Properties.ProvisioningState != "Deleting" {
+		t.Fatalf("Expected the Provisioning State to be `Deleting` but got %q", *deleting.Properties.ProvisioningState)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("Error waiting for the deletion of the Resource Group: %+v", err)
+	}
+
+	resp, err = client.Get(ctx, "example")
+	if err == nil {
+		t.Fatalf("Expected an error retrieving a deleted Resource Group but didn't get one")
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 but got a %d", resp.StatusCode)
+	}
+}
+
+func TestResourceGroupCreateValidation(t *testing.T) {
+	ctx := context.TODO()
+	client := testGroupsClient(NewServer())
+
+	testData := []struct {
+		Name           string
+		Location       *string
+		ExpectedStatus int
+	}{
+		{
+			Name:           "Valid",
+			Location:       to.StringPtr("westeurope"),
+			ExpectedStatus: http.StatusCreated,
+		},
+		{
+			Name:           "Same Location",
+			Location:       to.StringPtr("westeurope"),
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "Different Location",
+			Location:       to.StringPtr("northeurope"),
+			ExpectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		input := resources.Group{
+			Location: v.Location,
+		}
+		resp, _ := client.CreateOrUpdate(ctx, "validation", input)
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected a %d but got a %d", v.ExpectedStatus, resp.StatusCode)
+		}
+	}
+}
+
+func TestResourceGroupCreateWithoutLocation(t *testing.T) {
+	server := NewServer()
+
+	// the SDK validates the location client-side, so this needs to be sent directly
+	body := strings.NewReader(`{"tags": {}}`)
+	request := httptest.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", body)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("Expected a 400 but got a %d", recorder.Code)
+	}
+	if !strings.Contains(recorder.Body.String(), "LocationRequired") {
+		t.Fatalf("Expected the error code `LocationRequired` but got %q", recorder.Body.String())
+	}
+}
@@ -0,0 +1,107 @@
+// Package fakearm provides an in-process fake of (a subset of) Azure Resource Manager, which allows
+// the Provider to be exercised without network access or an Azure Subscription.
+//
+// At this time only Resource Groups are supported - including the Long Running Operation which is
+// used to delete them.
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Server is an in-process fake of Azure Resource Manager
+type Server struct {
+	mu sync.Mutex
+
+	resourceGroups map[string]*resourceGroup
+	operations     map[string]*operation
+	operationCount int
+}
+
+// NewServer returns a new (empty) fake of Azure Resource Manager
+func NewServer() *Server {
+	return &Server{
+		resourceGroups: make(map[string]*resourceGroup),
+		operations:     make(map[string]*operation),
+	}
+}
+
+// Sender returns an autorest.Sender which serves requests from this Server without using the network
+func (s *Server) Sender() autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, r)
+
+		resp := recorder.Result()
+		resp.Request = r
+		return resp, nil
+	})
+}
+
+// ServeHTTP implements http.Handler - allowing this Server to also be used with `httptest.NewServer`
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		writeError(w, http.StatusNotFound, "InvalidRequestUri", fmt.Sprintf("The request URI %q is not supported", r.URL.Path))
+		return
+	}
+	subscriptionId := segments[1]
+
+	switch {
+	case len(segments) == 3 && strings.EqualFold(segments[2], "providers"):
+		s.listProviders(w, r)
+
+	case len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups"):
+		s.resourceGroup(w, r, subscriptionId, segments[3])
+
+	case len(segments) == 4 && strings.EqualFold(segments[2], "operationresults"):
+		s.operationResult(w, r, subscriptionId, segments[3])
+
+	default:
+		writeError(w, http.StatusNotFound, "NoRegisteredProviderFound", fmt.Sprintf("The fake Resource Manager doesn't support the request URI %q", r.URL.Path))
+	}
+}
+
+func (s *Server) listProviders(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": []interface{}{},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+
+	if body != nil {
+		// the body is always a type we control, so this can't fail
+		json.NewEncoder(w).Encode(body) // nolint: errcheck
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The HTTP Method %q is not supported for %q", r.Method, r.URL.Path))
+}
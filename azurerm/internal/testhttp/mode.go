@@ -0,0 +1,61 @@
+// Package testhttp allows the HTTP requests made by the Provider during the Acceptance Tests to be
+// recorded and replayed, or sent to an in-process fake of Azure Resource Manager - meaning that
+// (a subset of) the Acceptance Tests can be run without access to an Azure Subscription.
+//
+// The mode is determined by the `ARM_TEST_HTTP_MODE` Environment Variable:
+//
+//   - `live` (the default) sends requests to Azure as usual
+//   - `record` sends requests to Azure and records each request/response into a Cassette
+//   - `replay` replays the responses from a previously recorded Cassette, without any network access
+//   - `fake` sends requests to an in-process fake of Azure Resource Manager, without any network access
+package testhttp
+
+import (
+	"os"
+	"strings"
+)
+
+type Mode string
+
+const (
+	// ModeLive sends requests to Azure as usual
+	ModeLive Mode = "live"
+
+	// ModeRecord sends requests to Azure and records them into a Cassette
+	ModeRecord Mode = "record"
+
+	// ModeReplay replays requests from a previously recorded Cassette
+	ModeReplay Mode = "replay"
+
+	// ModeFake sends requests to an in-process fake of Azure Resource Manager
+	ModeFake Mode = "fake"
+)
+
+// ModeEnvVar is the Environment Variable used to configure the Mode
+const ModeEnvVar = "ARM_TEST_HTTP_MODE"
+
+// CurrentMode returns the Mode configured via the `ARM_TEST_HTTP_MODE` Environment Variable
+func CurrentMode() Mode {
+	switch Mode(strings.ToLower(os.Getenv(ModeEnvVar))) {
+	case ModeRecord:
+		return ModeRecord
+	case ModeReplay:
+		return ModeReplay
+	case ModeFake:
+		return ModeFake
+	default:
+		return ModeLive
+	}
+}
+
+// IsOffline returns whether requests are served without any network access
+func IsOffline() bool {
+	mode := CurrentMode()
+	return mode == ModeReplay || mode == ModeFake
+}
+
+// IsRecording returns whether requests are being recorded into or replayed from a Cassette
+func IsRecording() bool {
+	mode := CurrentMode()
+	return mode == ModeRecord || mode == ModeReplay
+}
@@ -0,0 +1,59 @@
+package testhttp
+
+import (
+	"fmt"
+	"hash/fnv"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	randIntCountersLock sync.Mutex
+	randIntCounters     = make(map[string]int)
+)
+
+// RandInt returns an 18 digit integer which is deterministic for the calling Test function - such that
+// the names of the resources created during an Acceptance Test match those within the Cassette.
+//
+// Successive calls from within the same Test function return different values.
+func RandInt() int {
+	testName := callingTestName()
+
+	randIntCountersLock.Lock()
+	count := randIntCounters[testName]
+	randIntCounters[testName] = count + 1
+	randIntCountersLock.Unlock()
+
+	h := fnv.New64a()
+	h.Write([]byte(fmt.Sprintf("%s-%d", testName, count))) // nolint: errcheck
+
+	// ensure the value is always 18 digits, to match `tf.AccRandTimeInt`
+	return int(h.Sum64()%900000000000000000) + 100000000000000000
+}
+
+// callingTestName returns the name of the `TestXxx` function in the call stack
+func callingTestName() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		// e.g. `github.com/terraform-providers/terraform-provider-azurerm/azurerm.TestAccAzureRMResourceGroup_basic`
+		name := frame.Function
+		if i := strings.LastIndex(name, "."); i != -1 {
+			name = name[i+1:]
+		}
+		if strings.HasPrefix(name, "Test") {
+			return name
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return ""
+}
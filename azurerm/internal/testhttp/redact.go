@@ -0,0 +1,147 @@
+package testhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the value of any secrets within a Cassette
+const RedactedValue = "REDACTED"
+
+// sensitiveFields are the (case-insensitive) names of JSON fields which contain secrets, in addition to
+// any field whose name contains one of the `sensitiveFieldFragments`
+var sensitiveFields = map[string]struct{}{
+	"accesskey":                  {},
+	"accesstoken":                {},
+	"key1":                       {},
+	"key2":                       {},
+	"primaryaccesskey":           {},
+	"primarykey":                 {},
+	"primarymasterkey":           {},
+	"primaryreadonlymasterkey":   {},
+	"sastoken":                   {},
+	"secondaryaccesskey":         {},
+	"secondarykey":               {},
+	"secondarymasterkey":         {},
+	"secondaryreadonlymasterkey": {},
+	"sharedkey":                  {},
+}
+
+var sensitiveFieldFragments = []string{
+	"connectionstring",
+	"password",
+	"secret",
+}
+
+// sensitiveHeaders are the headers which contain secrets
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Ocp-Apim-Subscription-Key",
+	"Set-Cookie",
+	"X-Ms-Authorization-Auxiliary",
+}
+
+// sensitiveValuesRegex matches the secrets within Connection Strings and SAS Tokens
+var sensitiveValuesRegex = regexp.MustCompile(`(?i)((?:AccountKey|SharedAccessKey|SharedAccessSignature|Password|Pwd|sig)=)([^;&"\s]+)`)
+
+// redactBody replaces any secrets within the (request or response) body with `RedactedValue`
+func redactBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	// numbers are decoded as a json.Number so that these are serialized as-is
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil || decoder.More() {
+		return redactString(body)
+	}
+
+	redacted, err := json.Marshal(redactJSON(parsed))
+	if err != nil {
+		return redactString(body)
+	}
+
+	return string(redacted)
+}
+
+func redactJSON(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		// the keys returned from a `listKeys` are in the form `{"keyName": "key1", "value": "..."}`
+		_, hasKeyName := v["keyName"]
+
+		for key, value := range v {
+			if isSensitiveField(key) || (hasKeyName && strings.EqualFold(key, "value")) {
+				if _, ok := value.(string); ok {
+					v[key] = RedactedValue
+					continue
+				}
+			}
+
+			v[key] = redactJSON(value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+		return v
+
+	case string:
+		return redactString(v)
+	}
+
+	return input
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := sensitiveFields[name]; ok {
+		return true
+	}
+
+	for _, fragment := range sensitiveFieldFragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactString replaces the secrets within any Connection Strings/SAS Tokens in the input
+func redactString(input string) string {
+	return sensitiveValuesRegex.ReplaceAllString(input, "${1}"+RedactedValue)
+}
+
+// redactHeaders replaces the values of any headers containing secrets with `RedactedValue`
+func redactHeaders(headers map[string][]string) map[string][]string {
+	for key, values := range headers {
+		sensitive := false
+		for _, header := range sensitiveHeaders {
+			if http.CanonicalHeaderKey(key) == header {
+				sensitive = true
+				break
+			}
+		}
+
+		redacted := make([]string, 0, len(values))
+		for _, v := range values {
+			if sensitive {
+				redacted = append(redacted, RedactedValue)
+				continue
+			}
+
+			redacted = append(redacted, redactString(v))
+		}
+		headers[key] = redacted
+	}
+
+	return headers
+}
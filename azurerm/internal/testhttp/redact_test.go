@@ -0,0 +1,103 @@
+package testhttp
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "No Secrets",
+			Input:    `{"name": "example", "properties": {"count": 12345678901234567890, "enabled": true}}`,
+			Expected: `{"name": "example", "properties": {"count": 12345678901234567890, "enabled": true}}`,
+		},
+		{
+			Name:     "Administrator Password",
+			Input:    `{"location": "westeurope", "properties": {"administratorLogin": "psqladmin", "administratorLoginPassword": "H@Sh1CoR3!"}}`,
+			Expected: `{"location": "westeurope", "properties": {"administratorLogin": "psqladmin", "administratorLoginPassword": "REDACTED"}}`,
+		},
+		{
+			Name:     "List Keys",
+			Input:    `{"keys": [{"keyName": "key1", "value": "abc123==", "permissions": "FULL"}, {"keyName": "key2", "value": "def456==", "permissions": "FULL"}]}`,
+			Expected: `{"keys": [{"keyName": "key1", "value": "REDACTED", "permissions": "FULL"}, {"keyName": "key2", "value": "REDACTED", "permissions": "FULL"}]}`,
+		},
+		{
+			Name:     "Access Keys",
+			Input:    `{"primaryKey": "abc123==", "secondaryKey": "def456==", "primaryConnectionString": "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=root;SharedAccessKey=abc123=="}`,
+			Expected: `{"primaryKey": "REDACTED", "secondaryKey": "REDACTED", "primaryConnectionString": "REDACTED"}`,
+		},
+		{
+			Name:     "Connection String within another Field",
+			Input:    `{"properties": {"value": "DefaultEndpointsProtocol=https;AccountName=example;AccountKey=abc123==;EndpointSuffix=core.windows.net"}}`,
+			Expected: `{"properties": {"value": "DefaultEndpointsProtocol=https;AccountName=example;AccountKey=REDACTED;EndpointSuffix=core.windows.net"}}`,
+		},
+		{
+			Name:     "SAS Token",
+			Input:    `{"properties": {"uri": "https://example.blob.core.windows.net/container/blob?sv=2018-11-09&sr=b&sig=abc%2B123%3D&se=2019-01-01"}}`,
+			Expected: `{"properties": {"uri": "https://example.blob.core.windows.net/container/blob?sv=2018-11-09&sr=b&sig=REDACTED&se=2019-01-01"}}`,
+		},
+		{
+			Name:     "Not JSON",
+			Input:    `AccountName=example;AccountKey=abc123==`,
+			Expected: `AccountName=example;AccountKey=REDACTED`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := redactBody(v.Input)
+		if !jsonEqual(t, actual, v.Expected) {
+			t.Fatalf("Expected %s but got %s", v.Expected, actual)
+		}
+
+		if strings.Contains(actual, "abc123") || strings.Contains(actual, "H@Sh1CoR3!") {
+			t.Fatalf("Expected the secrets to be redacted but got %s", actual)
+		}
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	input := map[string][]string{
+		"Authorization":        {"Bearer abc123"},
+		"Set-Cookie":           {"session=abc123"},
+		"Content-Type":         {"application/json"},
+		"Azure-Asyncoperation": {"https://management.azure.com/operations/1?api-version=2019-01-01&sig=abc123"},
+	}
+	expected := map[string][]string{
+		"Authorization":        {RedactedValue},
+		"Set-Cookie":           {RedactedValue},
+		"Content-Type":         {"application/json"},
+		"Azure-Asyncoperation": {"https://management.azure.com/operations/1?api-version=2019-01-01&sig=REDACTED"},
+	}
+
+	actual := redactHeaders(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func jsonEqual(t *testing.T, actual, expected string) bool {
+	var a, e interface{}
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		// not JSON, so compare as-is
+		return actual == expected
+	}
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		t.Fatalf("Expected %s to be valid JSON: %+v", actual, err)
+	}
+
+	return reflect.DeepEqual(a, e)
+}
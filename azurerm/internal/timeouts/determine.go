@@ -0,0 +1,43 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ForCreate returns the context wrapped with the timeout for a Create operation
+func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+}
+
+// ForCreateUpdate returns the context wrapped with the timeout for a combined Create/Update operation
+//
+// If the resource is being created, the Create timeout is used - otherwise the Update timeout is used.
+func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	if d.IsNewResource() {
+		return ForCreate(ctx, d)
+	}
+
+	return ForUpdate(ctx, d)
+}
+
+// ForDelete returns the context wrapped with the timeout for a Delete operation
+func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+}
+
+// ForRead returns the context wrapped with the timeout for a Read operation
+func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+}
+
+// ForUpdate returns the context wrapped with the timeout for an Update operation
+func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
+	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+}
+
+func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, timeout)
+}
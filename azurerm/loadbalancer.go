@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -22,9 +23,8 @@ func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error)
 	return resGroup, name, nil
 }
 
-func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
-	client := meta.(*ArmClient).network.LoadBalancersClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveLoadBalancerById(ctx context.Context, loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	client := meta.(*ArmClient).Network.LoadBalancersClient
 
 	resGroup, name, err := resourceGroupAndLBNameFromId(loadBalancerId)
 	if err != nil {
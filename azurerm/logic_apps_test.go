@@ -27,7 +27,7 @@ func testCheckAzureRMLogicAppActionExists(resourceName string) resource.TestChec
 		workflowName := id.Path["workflows"]
 		resourceGroup := id.ResourceGroup
 
-		client := testAccProvider.Meta().(*ArmClient).logic.WorkflowsClient
+		client := testAccProvider.Meta().(*ArmClient).Logic.WorkflowsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, workflowName)
@@ -75,7 +75,7 @@ func testCheckAzureRMLogicAppTriggerExists(resourceName string) resource.TestChe
 		workflowName := id.Path["workflows"]
 		resourceGroup := id.ResourceGroup
 
-		client := testAccProvider.Meta().(*ArmClient).logic.WorkflowsClient
+		client := testAccProvider.Meta().(*ArmClient).Logic.WorkflowsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, workflowName)
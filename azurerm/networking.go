@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -52,9 +53,8 @@ func parseRouteTableName(routeTableId string) (string, error) {
 	return id.Path["routeTables"], nil
 }
 
-func retrieveErcByResourceId(resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
-	ercClient := meta.(*ArmClient).network.ExpressRouteCircuitsClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveErcByResourceId(ctx context.Context, resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
+	ercClient := meta.(*ArmClient).Network.ExpressRouteCircuitsClient
 
 	resGroup, name, err := extractResourceGroupAndErcName(resourceId)
 	if err != nil {
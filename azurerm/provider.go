@@ -12,25 +12,24 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/frontdoor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	// NOTE: as part of migrating Data Sources/Resources into Packages - we should be able to use
-	// the Service Registration interface to gradually migrate Data Sources/Resources over to the
-	// new pattern.
-	// However this requires that the following be done first:
-	//  1. Migrating the top level functions into the internal package
-	//		e.g. deprecated.go, locks.go
-	//	2. Switch the remaining resources over to the new Storage SDK
-	//		(so we can remove `getBlobStorageClientForStorageAccount` from `config.go`)
-	//	3. Finish migrating the SDK Clients into Packages
-	//	4. Making the SDK Clients public in the ArmClient prior to moving
-	//	5. Migrating the `ArmClient` from `common.go` into internal/common/clients.go
-	//
-	// For the moment/until that's done, we'll have to continue defining these inline
-	supportedServices := []common.ServiceRegistration{}
+	// NOTE: Data Sources/Resources are being gradually migrated into their Service Package, where
+	// each Service exposes a Registration once it contains Data Sources/Resources. Until a Service has
+	// been migrated its Data Sources/Resources continue to be defined inline below - and the Service Client
+	// continues to live alongside them, so that it can be exposed via the `clients.Client` without a
+	// circular reference.
+	supportedServices := []common.ServiceRegistration{
+		frontdoor.Registration{},
+		maps.Registration{},
+	}
 
 	dataSources := map[string]*schema.Resource{
 		"azurerm_api_management":                         dataSourceApiManagementService(),
@@ -49,6 +48,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_availability_set":                       dataSourceArmAvailabilitySet(),
 		"azurerm_azuread_application":                    dataSourceArmAzureADApplication(),
 		"azurerm_azuread_service_principal":              dataSourceArmActiveDirectoryServicePrincipal(),
+		"azurerm_bastion_host":                           dataSourceArmBastionHost(),
 		"azurerm_batch_account":                          dataSourceArmBatchAccount(),
 		"azurerm_batch_certificate":                      dataSourceArmBatchCertificate(),
 		"azurerm_batch_pool":                             dataSourceArmBatchPool(),
@@ -67,7 +67,6 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_firewall":                               dataSourceArmFirewall(),
 		"azurerm_image":                                  dataSourceArmImage(),
 		"azurerm_hdinsight_cluster":                      dataSourceArmHDInsightSparkCluster(),
-		"azurerm_maps_account":                           dataSourceArmMapsAccount(),
 		"azurerm_key_vault_access_policy":                dataSourceArmKeyVaultAccessPolicy(),
 		"azurerm_key_vault_key":                          dataSourceArmKeyVaultKey(),
 		"azurerm_key_vault_secret":                       dataSourceArmKeyVaultSecret(),
@@ -90,6 +89,8 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_notification_hub":                       dataSourceNotificationHub(),
 		"azurerm_platform_image":                         dataSourceArmPlatformImage(),
 		"azurerm_policy_definition":                      dataSourceArmPolicyDefinition(),
+		"azurerm_private_endpoint":                       dataSourceArmPrivateEndpoint(),
+		"azurerm_private_link_service":                   dataSourceArmPrivateLinkService(),
 		"azurerm_public_ip":                              dataSourceArmPublicIP(),
 		"azurerm_public_ips":                             dataSourceArmPublicIPs(),
 		"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
@@ -108,6 +109,9 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_stream_analytics_job":                   dataSourceArmStreamAnalyticsJob(),
 		"azurerm_storage_account_sas":                    dataSourceArmStorageAccountSharedAccessSignature(),
 		"azurerm_storage_account":                        dataSourceArmStorageAccount(),
+		"azurerm_storage_blob_sas":                       dataSourceArmStorageBlobSharedAccessSignature(),
+		"azurerm_storage_container_sas":                  dataSourceArmStorageContainerSharedAccessSignature(),
+		"azurerm_storage_queue_sas":                      dataSourceArmStorageQueueSharedAccessSignature(),
 		"azurerm_subnet":                                 dataSourceArmSubnet(),
 		"azurerm_subscription":                           dataSourceArmSubscription(),
 		"azurerm_subscriptions":                          dataSourceArmSubscriptions(),
@@ -168,6 +172,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_azuread_application":                                resourceArmActiveDirectoryApplication(),
 		"azurerm_azuread_service_principal_password":                 resourceArmActiveDirectoryServicePrincipalPassword(),
 		"azurerm_azuread_service_principal":                          resourceArmActiveDirectoryServicePrincipal(),
+		"azurerm_bastion_host":                                       resourceArmBastionHost(),
 		"azurerm_batch_account":                                      resourceArmBatchAccount(),
 		"azurerm_batch_application":                                  resourceArmBatchApplication(),
 		"azurerm_batch_certificate":                                  resourceArmBatchCertificate(),
@@ -181,19 +186,34 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_container_service":                                  resourceArmContainerService(),
 		"azurerm_cosmosdb_account":                                   resourceArmCosmosDbAccount(),
 		"azurerm_cosmosdb_cassandra_keyspace":                        resourceArmCosmosDbCassandraKeyspace(),
+		"azurerm_cosmosdb_gremlin_database":                          resourceArmCosmosDbGremlinDatabase(),
+		"azurerm_cosmosdb_gremlin_graph":                             resourceArmCosmosDbGremlinGraph(),
 		"azurerm_cosmosdb_mongo_collection":                          resourceArmCosmosDbMongoCollection(),
 		"azurerm_cosmosdb_mongo_database":                            resourceArmCosmosDbMongoDatabase(),
+		"azurerm_cosmosdb_sql_container":                             resourceArmCosmosDbSQLContainer(),
 		"azurerm_cosmosdb_sql_database":                              resourceArmCosmosDbSQLDatabase(),
 		"azurerm_cosmosdb_table":                                     resourceArmCosmosDbTable(),
 		"azurerm_data_factory":                                       resourceArmDataFactory(),
+		"azurerm_data_factory_dataset_delimited_text":                resourceArmDataFactoryDatasetDelimitedText(),
+		"azurerm_data_factory_dataset_json":                          resourceArmDataFactoryDatasetJSON(),
 		"azurerm_data_factory_dataset_mysql":                         resourceArmDataFactoryDatasetMySQL(),
+		"azurerm_data_factory_dataset_parquet":                       resourceArmDataFactoryDatasetParquet(),
 		"azurerm_data_factory_dataset_postgresql":                    resourceArmDataFactoryDatasetPostgreSQL(),
 		"azurerm_data_factory_dataset_sql_server_table":              resourceArmDataFactoryDatasetSQLServerTable(),
+		"azurerm_data_factory_linked_service_azure_blob_storage":     resourceArmDataFactoryLinkedServiceAzureBlobStorage(),
+		"azurerm_data_factory_linked_service_azure_sql_database":     resourceArmDataFactoryLinkedServiceAzureSQLDatabase(),
+		"azurerm_data_factory_linked_service_cosmosdb":               resourceArmDataFactoryLinkedServiceCosmosDb(),
 		"azurerm_data_factory_linked_service_data_lake_storage_gen2": resourceArmDataFactoryLinkedServiceDataLakeStorageGen2(),
+		"azurerm_data_factory_linked_service_http":                   resourceArmDataFactoryLinkedServiceHTTP(),
+		"azurerm_data_factory_linked_service_key_vault":              resourceArmDataFactoryLinkedServiceKeyVault(),
 		"azurerm_data_factory_linked_service_mysql":                  resourceArmDataFactoryLinkedServiceMySQL(),
 		"azurerm_data_factory_linked_service_postgresql":             resourceArmDataFactoryLinkedServicePostgreSQL(),
+		"azurerm_data_factory_linked_service_rest":                   resourceArmDataFactoryLinkedServiceREST(),
+		"azurerm_data_factory_linked_service_sftp":                   resourceArmDataFactoryLinkedServiceSFTP(),
 		"azurerm_data_factory_linked_service_sql_server":             resourceArmDataFactoryLinkedServiceSQLServer(),
 		"azurerm_data_factory_pipeline":                              resourceArmDataFactoryPipeline(),
+		"azurerm_data_factory_trigger_schedule":                      resourceArmDataFactoryTriggerSchedule(),
+		"azurerm_data_factory_trigger_tumbling_window":               resourceArmDataFactoryTriggerTumblingWindow(),
 		"azurerm_data_lake_analytics_account":                        resourceArmDataLakeAnalyticsAccount(),
 		"azurerm_data_lake_analytics_firewall_rule":                  resourceArmDataLakeAnalyticsFirewallRule(),
 		"azurerm_data_lake_store_file":                               resourceArmDataLakeStoreFile(),
@@ -254,6 +274,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_key_vault_secret":                                   resourceArmKeyVaultSecret(),
 		"azurerm_key_vault":                                          resourceArmKeyVault(),
 		"azurerm_kubernetes_cluster":                                 resourceArmKubernetesCluster(),
+		"azurerm_kubernetes_cluster_node_pool":                       resourceArmKubernetesClusterNodePool(),
 		"azurerm_lb_backend_address_pool":                            resourceArmLoadBalancerBackendAddressPool(),
 		"azurerm_lb_nat_pool":                                        resourceArmLoadBalancerNatPool(),
 		"azurerm_lb_nat_rule":                                        resourceArmLoadBalancerNatRule(),
@@ -275,7 +296,6 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_managed_disk":                                       resourceArmManagedDisk(),
 		"azurerm_management_group":                                   resourceArmManagementGroup(),
 		"azurerm_management_lock":                                    resourceArmManagementLock(),
-		"azurerm_maps_account":                                       resourceArmMapsAccount(),
 		"azurerm_mariadb_configuration":                              resourceArmMariaDbConfiguration(),
 		"azurerm_mariadb_database":                                   resourceArmMariaDbDatabase(),
 		"azurerm_mariadb_firewall_rule":                              resourceArmMariaDBFirewallRule(),
@@ -295,6 +315,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_mysql_firewall_rule":                                resourceArmMySqlFirewallRule(),
 		"azurerm_mysql_server":                                       resourceArmMySqlServer(),
 		"azurerm_mysql_virtual_network_rule":                         resourceArmMySqlVirtualNetworkRule(),
+		"azurerm_nat_gateway":                                        resourceArmNatGateway(),
 		"azurerm_network_connection_monitor":                         resourceArmNetworkConnectionMonitor(),
 		"azurerm_network_ddos_protection_plan":                       resourceArmNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                                  resourceArmNetworkInterface(),
@@ -322,6 +343,9 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_private_dns_zone":                                                       resourceArmPrivateDnsZone(),
 		"azurerm_private_dns_a_record":                                                   resourceArmPrivateDnsARecord(),
 		"azurerm_private_dns_cname_record":                                               resourceArmPrivateDnsCNameRecord(),
+		"azurerm_private_endpoint":                                                       resourceArmPrivateEndpoint(),
+		"azurerm_private_endpoint_connection":                                            resourceArmPrivateEndpointConnection(),
+		"azurerm_private_link_service":                                                   resourceArmPrivateLinkService(),
 		"azurerm_public_ip":                                                              resourceArmPublicIp(),
 		"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
 		"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
@@ -330,6 +354,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_redis_cache":                                                            resourceArmRedisCache(),
 		"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
 		"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
+		"azurerm_resource":                                                               resourceArmResource(),
 		"azurerm_resource_group":                                                         resourceArmResourceGroup(),
 		"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 		"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
@@ -358,11 +383,13 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_sql_active_directory_administrator":                                     resourceArmSqlAdministrator(),
 		"azurerm_sql_database":                                                           resourceArmSqlDatabase(),
 		"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
+		"azurerm_sql_failover_group":                                                     resourceArmSqlFailoverGroup(),
 		"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
 		"azurerm_sql_server":                                                             resourceArmSqlServer(),
 		"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
 		"azurerm_storage_account":                                                        resourceArmStorageAccount(),
 		"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
+		"azurerm_storage_management_policy":                                              resourceArmStorageManagementPolicy(),
 		"azurerm_storage_container":                                                      resourceArmStorageContainer(),
 		"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 		"azurerm_storage_share":                                                          resourceArmStorageShare(),
@@ -378,6 +405,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_stream_analytics_stream_input_blob":                                     resourceArmStreamAnalyticsStreamInputBlob(),
 		"azurerm_stream_analytics_stream_input_eventhub":                                 resourceArmStreamAnalyticsStreamInputEventHub(),
 		"azurerm_stream_analytics_stream_input_iothub":                                   resourceArmStreamAnalyticsStreamInputIoTHub(),
+		"azurerm_subnet_nat_gateway_association":                                         resourceArmSubnetNatGatewayAssociation(),
 		"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 		"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 		"azurerm_subnet":                                                                 resourceArmSubnet(),
@@ -416,6 +444,13 @@ func Provider() terraform.ResourceProvider {
 		}
 	}
 
+	// any `default_tags` which haven't been applied to an existing resource need to be added to the diff
+	for _, v := range resources {
+		if tags.SupportsDefaults(v) {
+			v.CustomizeDiff = tags.CustomizeDiffWithDefaults(v.CustomizeDiff)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -499,6 +534,64 @@ func Provider() terraform.ResourceProvider {
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: tags.Validate,
+							Description:  "Tags which should be assigned to every resource which supports tags, unless overridden on the resource.",
+						},
+
+						"ignore_tags": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							Description: "The names of tags which should be ignored when reading resources, such as those assigned by Azure Policy.",
+						},
+					},
+				},
+			},
+
+			"features": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"purge_soft_delete_on_destroy": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Should Key Vaults, and the Certificates, Keys and Secrets within them, be purged when they're destroyed, rather than being left in a soft-deleted state?",
+									},
+
+									"recover_soft_deleted_key_vaults": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Should a soft-deleted Key Vault, Certificate, Key or Secret with the same name be recovered when the resource is created?",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Advanced feature flags
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
@@ -561,6 +654,10 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		client.StopContext = p.StopContext()
+		client.Features = expandProviderFeatures(d.Get("features").([]interface{}))
+
+		defaultTags, ignoredTags := expandProviderDefaultTags(d.Get("default_tags").([]interface{}))
+		client.DefaultTags = tags.NewProviderDefaults(defaultTags, ignoredTags)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -573,7 +670,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
-			providerList, err := client.resource.ProvidersClient.List(ctx, nil, "")
+			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
@@ -584,7 +681,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 				availableResourceProviders := providerList.Values()
 				requiredResourceProviders := requiredResourceProviders()
 
-				err := ensureResourceProvidersAreRegistered(ctx, *client.resource.ProvidersClient, availableResourceProviders, requiredResourceProviders)
+				err := ensureResourceProvidersAreRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders)
 				if err != nil {
 					return nil, fmt.Errorf("Error ensuring Resource Providers are registered: %s", err)
 				}
@@ -595,6 +692,42 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+func expandProviderDefaultTags(input []interface{}) (map[string]interface{}, []string) {
+	defaultTags := make(map[string]interface{})
+	ignoredTags := make([]string, 0)
+	if len(input) == 0 || input[0] == nil {
+		return defaultTags, ignoredTags
+	}
+
+	v := input[0].(map[string]interface{})
+	if t, ok := v["tags"].(map[string]interface{}); ok {
+		defaultTags = t
+	}
+	if names, ok := v["ignore_tags"].([]interface{}); ok {
+		for _, name := range names {
+			ignoredTags = append(ignoredTags, name.(string))
+		}
+	}
+
+	return defaultTags, ignoredTags
+}
+
+func expandProviderFeatures(input []interface{}) features.UserFeatures {
+	output := features.Default()
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	if raw, ok := v["key_vault"].([]interface{}); ok && len(raw) > 0 && raw[0] != nil {
+		keyVault := raw[0].(map[string]interface{})
+		output.KeyVault.PurgeSoftDeleteOnDestroy = keyVault["purge_soft_delete_on_destroy"].(bool)
+		output.KeyVault.RecoverSoftDeletedKeyVaults = keyVault["recover_soft_deleted_key_vaults"].(bool)
+	}
+
+	return output
+}
+
 // ignoreCaseStateFunc is a StateFunc from helper/schema that converts the
 // supplied value to lower before saving to state for consistency.
 func ignoreCaseStateFunc(val interface{}) string {
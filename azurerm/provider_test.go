@@ -11,7 +11,8 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/terraform-providers/terraform-provider-azuread/azuread"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -19,10 +20,7 @@ var testAccProvider *schema.Provider
 
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
-		"azurerm": testAccProvider,
-		"azuread": azuread.Provider().(*schema.Provider),
-	}
+	testAccProviders = acceptance.Providers(testAccProvider)
 }
 
 func TestProvider(t *testing.T) {
@@ -35,30 +33,72 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
-func testAccPreCheck(t *testing.T) {
-	variables := []string{
-		"ARM_CLIENT_ID",
-		"ARM_CLIENT_SECRET",
-		"ARM_SUBSCRIPTION_ID",
-		"ARM_TENANT_ID",
-		"ARM_TEST_LOCATION",
-		"ARM_TEST_LOCATION_ALT",
+func TestExpandProviderFeatures(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name:     "Not Configured",
+			Input:    []interface{}{},
+			Expected: features.Default(),
+		},
+		{
+			Name:     "Empty Features Block",
+			Input:    []interface{}{nil},
+			Expected: features.Default(),
+		},
+		{
+			Name: "Empty Key Vault Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{},
+				},
+			},
+			Expected: features.Default(),
+		},
+		{
+			Name: "Purge on Destroy without Recovery",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: false,
+				},
+			},
+		},
 	}
 
-	for _, variable := range variables {
-		value := os.Getenv(variable)
-		if value == "" {
-			t.Fatalf("`%s` must be set for acceptance tests!", variable)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := expandProviderFeatures(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
 		}
 	}
 }
 
+func testAccPreCheck(t *testing.T) {
+	acceptance.PreCheck(t)
+}
+
 func testLocation() string {
-	return os.Getenv("ARM_TEST_LOCATION")
+	return acceptance.Location()
 }
 
 func testAltLocation() string {
-	return os.Getenv("ARM_TEST_LOCATION_ALT")
+	return acceptance.AltLocation()
 }
 
 func testArmEnvironmentName() string {
@@ -103,6 +143,5 @@ func testGetAzureConfig(t *testing.T) *authentication.Config {
 }
 
 func testRequiresImportError(resourceName string) *regexp.Regexp {
-	message := "to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
-	return regexp.MustCompile(fmt.Sprintf(message, resourceName))
+	return acceptance.RequiresImportError(resourceName)
 }
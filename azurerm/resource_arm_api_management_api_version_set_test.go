@@ -150,7 +150,7 @@ func TestAccAzureRMApiManagementApiVersionSet_update(t *testing.T) {
 }
 
 func testCheckAzureRMApiManagementApiVersionSetDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).apiManagement.ApiVersionSetClient
+	client := testAccProvider.Meta().(*ArmClient).ApiManagement.ApiVersionSetClient
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_api_management_api_version_set" {
 			continue
@@ -185,7 +185,7 @@ func testCheckAzureRMApiManagementApiVersionSetExists(resourceName string) resou
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		serviceName := rs.Primary.Attributes["api_management_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).apiManagement.ApiVersionSetClient
+		client := testAccProvider.Meta().(*ArmClient).ApiManagement.ApiVersionSetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, serviceName, name)
 		if err != nil {
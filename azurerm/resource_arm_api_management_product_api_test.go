@@ -65,7 +65,7 @@ func TestAccAzureRMAPIManagementProductApi_requiresImport(t *testing.T) {
 }
 
 func testCheckAzureRMAPIManagementProductApiDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).apiManagement.ProductApisClient
+	client := testAccProvider.Meta().(*ArmClient).ApiManagement.ProductApisClient
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_api_management_product_api" {
 			continue
@@ -101,7 +101,7 @@ func testCheckAzureRMAPIManagementProductApiExists(resourceName string) resource
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 		serviceName := rs.Primary.Attributes["api_management_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).apiManagement.ProductApisClient
+		client := testAccProvider.Meta().(*ArmClient).ApiManagement.ProductApisClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.CheckEntityExists(ctx, resourceGroup, serviceName, productId, apiName)
 		if err != nil {
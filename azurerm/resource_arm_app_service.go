@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +26,13 @@ func resourceArmAppService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -175,8 +184,9 @@ func resourceArmAppService() *schema.Resource {
 }
 
 func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.AppServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM App Service creation.")
 
@@ -187,7 +197,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing App Service %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing App Service %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -202,7 +212,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	available, err := client.CheckNameAvailability(ctx, availabilityRequest)
 	if err != nil {
-		return fmt.Errorf("Error checking if the name %q was available: %+v", name, err)
+		return fmt.Errorf("Error checking if the name %q was available: %+v", name, tf.WrapArmError(err))
 	}
 
 	if !*available.NameAvailable {
@@ -219,7 +229,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -245,17 +255,17 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	createFuture, err := client.CreateOrUpdate(ctx, resGroup, name, siteEnvelope)
 	if err != nil {
-		return fmt.Errorf("Error creating App Service %q (Resource Group %q): %s", name, resGroup, err)
+		return fmt.Errorf("Error creating App Service %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 	}
 
 	err = createFuture.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		return fmt.Errorf("Error waiting for App Service %q (Resource Group %q) to be created: %s", name, resGroup, err)
+		return fmt.Errorf("Error waiting for App Service %q (Resource Group %q) to be created: %s", name, resGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %s", name, resGroup, err)
+		return fmt.Errorf("Error retrieving App Service %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read App Service %q (resource group %q) ID", name, resGroup)
@@ -281,7 +291,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 		SiteLogsConfigProperties: &logsConfig}
 
 	if _, err := client.UpdateDiagnosticLogsConfig(ctx, resGroup, name, logs); err != nil {
-		return fmt.Errorf("Error updating diagnostic logs config for App Service %q (Resource Group %q): %+s", name, resGroup, err)
+		return fmt.Errorf("Error updating diagnostic logs config for App Service %q (Resource Group %q): %+s", name, resGroup, tf.WrapArmError(err))
 	}
 
 	backupRaw := d.Get("backup").([]interface{})
@@ -296,8 +306,9 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.AppServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Web.AppServicesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -317,7 +328,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	siteConfig := azure.ExpandAppServiceSiteConfig(d.Get("site_config"))
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -348,7 +359,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.CreateOrUpdateConfiguration(ctx, resGroup, name, siteConfigResource); err != nil {
-			return fmt.Errorf("Error updating Configuration for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Configuration for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 	}
 
@@ -375,7 +386,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.UpdateDiagnosticLogsConfig(ctx, resGroup, name, logsResource); err != nil {
-			return fmt.Errorf("Error updating Diagnostics Logs for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Diagnostics Logs for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 	}
 
@@ -429,7 +440,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.UpdateAzureStorageAccounts(ctx, resGroup, name, properties); err != nil {
-			return fmt.Errorf("Error updating Storage Accounts for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Storage Accounts for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 	}
 
@@ -441,14 +452,14 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if _, err := client.UpdateConnectionStrings(ctx, resGroup, name, properties); err != nil {
-			return fmt.Errorf("Error updating Connection Strings for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Connection Strings for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 	}
 
 	if d.HasChange("identity") {
 		site, err := client.Get(ctx, resGroup, name)
 		if err != nil {
-			return fmt.Errorf("Error getting configuration for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error getting configuration for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 
 		appServiceIdentity := azure.ExpandAppServiceIdentity(d)
@@ -457,11 +468,11 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		future, err := client.CreateOrUpdate(ctx, resGroup, name, site)
 
 		if err != nil {
-			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, err)
+			return fmt.Errorf("Error updating Managed Service Identity for App Service %q: %+v", name, tf.WrapArmError(err))
 		}
 	}
 
@@ -469,7 +480,7 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.AppServicesClient
+	client := meta.(*ArmClient).Web.AppServicesClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -479,7 +490,8 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	resGroup := id.ResourceGroup
 	name := id.Path["sites"]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -487,7 +499,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	configResp, err := client.GetConfiguration(ctx, resGroup, name)
@@ -497,7 +509,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	authResp, err := client.GetAuthSettings(ctx, resGroup, name)
@@ -508,13 +520,13 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	backupResp, err := client.GetBackupConfiguration(ctx, resGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(backupResp.Response) {
-			return fmt.Errorf("Error retrieving the BackupConfiguration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+			return fmt.Errorf("Error retrieving the BackupConfiguration for App Service %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 		}
 	}
 
 	logsResp, err := client.GetDiagnosticLogsConfiguration(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving the DiagnosticsLogsConfiguration for App Service %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving the DiagnosticsLogsConfiguration for App Service %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
@@ -529,17 +541,17 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 
 	storageAccountsResp, err := client.ListAzureStorageAccounts(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Storage Accounts %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Storage Accounts %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	connectionStringsResp, err := client.ListConnectionStrings(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	scmResp, err := client.GetSourceControl(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, resGroup, name)
@@ -552,7 +564,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	siteCredResp, err := siteCredFuture.Result(*client)
 	if err != nil {
-		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on AzureRM App Service Site Credential %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", name)
@@ -624,13 +636,13 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `identity`: %s", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).web.AppServicesClient
+	client := meta.(*ArmClient).Web.AppServicesClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -643,7 +655,8 @@ func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error
 
 	deleteMetrics := true
 	deleteEmptyServerFarm := false
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name, &deleteMetrics, &deleteEmptyServerFarm)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -324,7 +324,7 @@ func TestAccAzureRMAppServicePlan_basicWindowsContainer(t *testing.T) {
 }
 
 func testCheckAzureRMAppServicePlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).web.AppServicePlansClient
+	conn := testAccProvider.Meta().(*ArmClient).Web.AppServicePlansClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_app_service_plan" {
@@ -364,7 +364,7 @@ func testCheckAzureRMAppServicePlanExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for App Service Plan: %s", appServicePlanName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).web.AppServicePlansClient
+		conn := testAccProvider.Meta().(*ArmClient).Web.AppServicePlansClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, appServicePlanName)
 		if err != nil {
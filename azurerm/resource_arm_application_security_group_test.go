@@ -120,7 +120,7 @@ func testCheckAzureRMApplicationSecurityGroupDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).network.ApplicationSecurityGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).Network.ApplicationSecurityGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -153,7 +153,7 @@ func testCheckAzureRMApplicationSecurityGroupExists(resourceName string) resourc
 			return fmt.Errorf("Bad: no resource group found in state for Application Security Group: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.ApplicationSecurityGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).Network.ApplicationSecurityGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
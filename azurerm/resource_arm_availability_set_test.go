@@ -198,7 +198,7 @@ func testCheckAzureRMAvailabilitySetExists(resourceName string) resource.TestChe
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).compute.AvailabilitySetsClient
+		client := testAccProvider.Meta().(*ArmClient).Compute.AvailabilitySetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, availSetName)
 		if err != nil {
@@ -227,7 +227,7 @@ func testCheckAzureRMAvailabilitySetDisappears(resourceName string) resource.Tes
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %s", availSetName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).compute.AvailabilitySetsClient
+		client := testAccProvider.Meta().(*ArmClient).Compute.AvailabilitySetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Delete(ctx, resourceGroup, availSetName)
 		if err != nil {
@@ -249,7 +249,7 @@ func testCheckAzureRMAvailabilitySetDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).compute.AvailabilitySetsClient
+		client := testAccProvider.Meta().(*ArmClient).Compute.AvailabilitySetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
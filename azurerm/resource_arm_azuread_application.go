@@ -3,11 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -27,6 +29,13 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -81,8 +90,9 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 }
 
 func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ApplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ApplicationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// NOTE: name isn't the Resource ID here, so we don't check it exists
 	name := d.Get("name").(string)
@@ -111,8 +121,9 @@ func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta in
 }
 
 func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ApplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ApplicationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 
@@ -145,15 +156,16 @@ func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta in
 	}
 
 	if _, err := client.Patch(ctx, d.Id(), properties); err != nil {
-		return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), tf.WrapArmError(err))
 	}
 
 	return resourceArmActiveDirectoryApplicationRead(d, meta)
 }
 
 func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ApplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ApplicationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, d.Id())
 	if err != nil {
@@ -163,7 +175,7 @@ func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta inte
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Azure AD Application with ID %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error retrieving Azure AD Application with ID %q: %+v", d.Id(), tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.DisplayName)
@@ -192,8 +204,9 @@ func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta inte
 }
 
 func resourceArmActiveDirectoryApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ApplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ApplicationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// in order to delete an application which is available to other tenants, we first have to disable this setting
 	availableToOtherTenants := d.Get("available_to_other_tenants").(bool)
@@ -204,14 +217,14 @@ func resourceArmActiveDirectoryApplicationDelete(d *schema.ResourceData, meta in
 		}
 
 		if _, err := client.Patch(ctx, d.Id(), properties); err != nil {
-			return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), err)
+			return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), tf.WrapArmError(err))
 		}
 	}
 
 	resp, err := client.Delete(ctx, d.Id())
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error Deleting Azure AD Application with ID %q: %+v", d.Id(), err)
+			return fmt.Errorf("Error Deleting Azure AD Application with ID %q: %+v", d.Id(), tf.WrapArmError(err))
 		}
 	}
 
@@ -3,11 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +30,12 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:     schema.TypeString,
@@ -43,14 +51,15 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 }
 
 func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	applicationId := d.Get("application_id").(string)
 
 	apps, err := client.ListComplete(ctx, "")
 	if err != nil {
-		return fmt.Errorf("Error checking for existence of Service Principal %q: %+v", applicationId, err)
+		return fmt.Errorf("Error checking for existence of Service Principal %q: %+v", applicationId, tf.WrapArmError(err))
 	}
 
 	for apps.NotDone() {
@@ -78,13 +87,13 @@ func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, me
 
 	app, err := client.Create(ctx, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating Service Principal %q: %+v", applicationId, err)
+		return fmt.Errorf("Error creating Service Principal %q: %+v", applicationId, tf.WrapArmError(err))
 	}
 
 	objectId := *app.ObjectID
 	resp, err := client.Get(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ObjectID)
@@ -93,8 +102,9 @@ func resourceArmActiveDirectoryServicePrincipalCreate(d *schema.ResourceData, me
 }
 
 func resourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	objectId := d.Id()
 	app, err := client.Get(ctx, objectId)
@@ -104,7 +114,7 @@ func resourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	d.Set("application_id", app.AppID)
@@ -114,14 +124,15 @@ func resourceArmActiveDirectoryServicePrincipalRead(d *schema.ResourceData, meta
 }
 
 func resourceArmActiveDirectoryServicePrincipalDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	applicationId := d.Id()
 	app, err := client.Delete(ctx, applicationId)
 	if err != nil {
 		if !response.WasNotFound(app.Response) {
-			return fmt.Errorf("Error deleting Service Principal ID %q: %+v", applicationId, err)
+			return fmt.Errorf("Error deleting Service Principal ID %q: %+v", applicationId, tf.WrapArmError(err))
 		}
 	}
 
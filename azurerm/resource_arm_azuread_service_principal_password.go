@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -31,6 +32,12 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service_principal_id": {
 				Type:         schema.TypeString,
@@ -73,8 +80,9 @@ As such the Azure Active Directory resources within the AzureRM Provider are now
 }
 
 func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	objectId := d.Get("service_principal_id").(string)
 	value := d.Get("value").(string)
@@ -110,7 +118,7 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 
 	existingCredentials, err := client.ListPasswordCredentials(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error Listing Password Credentials for Service Principal %q: %+v", objectId, err)
+		return fmt.Errorf("Error Listing Password Credentials for Service Principal %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	updatedCredentials := make([]graphrbac.PasswordCredential, 0)
@@ -135,7 +143,7 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 	}
 	_, err = client.UpdatePasswordCredentials(ctx, objectId, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating Password Credential %q for Service Principal %q: %+v", keyId, objectId, err)
+		return fmt.Errorf("Error creating Password Credential %q for Service Principal %q: %+v", keyId, objectId, tf.WrapArmError(err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", objectId, keyId))
@@ -144,8 +152,9 @@ func resourceArmActiveDirectoryServicePrincipalPasswordCreate(d *schema.Resource
 }
 
 func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 2 {
@@ -164,12 +173,12 @@ func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	credentials, err := client.ListPasswordCredentials(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	var credential *graphrbac.PasswordCredential
@@ -206,8 +215,9 @@ func resourceArmActiveDirectoryServicePrincipalPasswordRead(d *schema.ResourceDa
 }
 
 func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).graph.ServicePrincipalsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Graph.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 2 {
@@ -228,12 +238,12 @@ func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.Resource
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	existing, err := client.ListPasswordCredentials(ctx, objectId)
 	if err != nil {
-		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+		return fmt.Errorf("Error Listing Password Credentials for Service Principal with Object ID %q: %+v", objectId, tf.WrapArmError(err))
 	}
 
 	updatedCredentials := make([]graphrbac.PasswordCredential, 0)
@@ -252,7 +262,7 @@ func resourceArmActiveDirectoryServicePrincipalPasswordDelete(d *schema.Resource
 	}
 	_, err = client.UpdatePasswordCredentials(ctx, objectId, parameters)
 	if err != nil {
-		return fmt.Errorf("Error removing Password %q from Service Principal %q: %+v", keyId, objectId, err)
+		return fmt.Errorf("Error removing Password %q from Service Principal %q: %+v", keyId, objectId, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -0,0 +1,333 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBastionHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBastionHostCreateUpdate,
+		Read:   resourceArmBastionHostRead,
+		Update: resourceArmBastionHostCreateUpdate,
+		Delete: resourceArmBastionHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.BastionHostName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.BastionSubnetID,
+						},
+
+						"public_ip_address_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: resourceid.ValidatePublicIPAddressID,
+						},
+					},
+				},
+			},
+
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmBastionHostCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.BastionHostsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Bastion Host creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_bastion_host", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	ipConfigurations := d.Get("ip_configuration").([]interface{})
+	ipConfiguration := ipConfigurations[0].(map[string]interface{})
+	subnetId := ipConfiguration["subnet_id"].(string)
+	publicIpAddressId := ipConfiguration["public_ip_address_id"].(string)
+
+	subnet, err := resourceid.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		if err := validateBastionHostPublicIPAddress(ctx, meta, publicIpAddressId); err != nil {
+			return err
+		}
+	}
+
+	parameters := network.BastionHost{
+		Location: utils.String(location),
+		BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
+			IPConfigurations: expandArmBastionHostIPConfiguration(ipConfigurations),
+		},
+		Tags: expandTags(t, meta),
+	}
+
+	locks.ByName(subnet.Name, subnetResourceName)
+	defer locks.UnlockByName(subnet.Name, subnetResourceName)
+
+	locks.ByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Bastion Host %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Bastion Host %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBastionHostRead(d, meta)
+}
+
+func resourceArmBastionHostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.BastionHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseBastionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Bastion Host %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.BastionHostPropertiesFormat; props != nil {
+		d.Set("dns_name", props.DNSName)
+
+		if err := d.Set("ip_configuration", flattenArmBastionHostIPConfiguration(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmBastionHostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.BastionHostsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseBastionHostID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if ipConfigurations := d.Get("ip_configuration").([]interface{}); len(ipConfigurations) > 0 && ipConfigurations[0] != nil {
+		ipConfiguration := ipConfigurations[0].(map[string]interface{})
+		subnet, err := resourceid.ParseSubnetID(ipConfiguration["subnet_id"].(string))
+		if err != nil {
+			return err
+		}
+
+		locks.ByName(subnet.Name, subnetResourceName)
+		defer locks.UnlockByName(subnet.Name, subnetResourceName)
+
+		locks.ByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+		defer locks.UnlockByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Bastion Host %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+// validateBastionHostPublicIPAddress confirms the Public IP Address is a Standard SKU with a Static allocation,
+// since otherwise the API returns an unhelpful error some time into the creation of the Bastion Host
+func validateBastionHostPublicIPAddress(ctx context.Context, meta interface{}, publicIpAddressId string) error {
+	client := meta.(*ArmClient).Network.PublicIPsClient
+
+	id, err := resourceid.ParsePublicIPAddressID(publicIpAddressId)
+	if err != nil {
+		return err
+	}
+
+	publicIp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Public IP Address %q (Resource Group %q) for Bastion Host: %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if publicIp.Sku == nil || !strings.EqualFold(string(publicIp.Sku.Name), string(network.PublicIPAddressSkuNameStandard)) {
+		return fmt.Errorf("The Public IP Address %q (Resource Group %q) used for a Bastion Host must use the `Standard` SKU", id.Name, id.ResourceGroup)
+	}
+
+	if props := publicIp.PublicIPAddressPropertiesFormat; props == nil || !strings.EqualFold(string(props.PublicIPAllocationMethod), string(network.Static)) {
+		return fmt.Errorf("The Public IP Address %q (Resource Group %q) used for a Bastion Host must use a `Static` allocation method", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func expandArmBastionHostIPConfiguration(input []interface{}) *[]network.BastionHostIPConfiguration {
+	results := make([]network.BastionHostIPConfiguration, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		results = append(results, network.BastionHostIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
+				Subnet: &network.SubResource{
+					ID: utils.String(v["subnet_id"].(string)),
+				},
+				PublicIPAddress: &network.SubResource{
+					ID: utils.String(v["public_ip_address_id"].(string)),
+				},
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenArmBastionHostIPConfiguration(input *[]network.BastionHostIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		subnetId := ""
+		publicIpAddressId := ""
+		if props := item.BastionHostIPConfigurationPropertiesFormat; props != nil {
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+				publicIpAddressId = *props.PublicIPAddress.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                 name,
+			"subnet_id":            subnetId,
+			"public_ip_address_id": publicIpAddressId,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,286 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBastionHost_basic(t *testing.T) {
+	resourceName := "azurerm_bastion_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBastionHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBastionHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBastionHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_configuration.0.subnet_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_configuration.0.public_ip_address_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBastionHost_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_bastion_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBastionHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBastionHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBastionHostExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMBastionHost_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_bastion_host"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBastionHost_tags(t *testing.T) {
+	resourceName := "azurerm_bastion_host.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBastionHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBastionHost_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBastionHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMBastionHost_tags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBastionHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBastionHost_basicPublicIP(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBastionHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMBastionHost_basicPublicIP(ri, location),
+				ExpectError: regexp.MustCompile("must use the `Standard` SKU"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBastionHostExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Bastion Host not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseBastionHostID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Network.BastionHostsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Bastion Host %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Network.BastionHostsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMBastionHostDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Network.BastionHostsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_bastion_host" {
+			continue
+		}
+
+		id, err := resourceid.ParseBastionHostID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Network.BastionHostsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Bastion Host %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMBastionHost_template(rInt int, location string, publicIpSku string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["192.168.1.0/24"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureBastionSubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "192.168.1.224/27"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "%s"
+}
+`, rInt, location, rInt, rInt, publicIpSku)
+}
+
+func testAccAzureRMBastionHost_basic(rInt int, location string) string {
+	template := testAccAzureRMBastionHost_template(rInt, location, "Standard")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_host" "test" {
+  name                = "acctestbastion%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMBastionHost_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMBastionHost_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_host" "import" {
+  name                = "${azurerm_bastion_host.test.name}"
+  location            = "${azurerm_bastion_host.test.location}"
+  resource_group_name = "${azurerm_bastion_host.test.resource_group_name}"
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMBastionHost_tags(rInt int, location string) string {
+	template := testAccAzureRMBastionHost_template(rInt, location, "Standard")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_host" "test" {
+  name                = "acctestbastion%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  tags = {
+    environment = "production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMBastionHost_basicPublicIP(rInt int, location string) string {
+	template := testAccAzureRMBastionHost_template(rInt, location, "Basic")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_host" "test" {
+  name                = "acctestbastion%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+`, template, rInt)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +25,13 @@ func resourceArmBatchApplication() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -62,8 +71,9 @@ func resourceArmBatchApplication() *schema.Resource {
 }
 
 func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch.ApplicationClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -73,7 +83,7 @@ func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{})
 		resp, err := client.Get(ctx, resourceGroup, accountName, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("Error checking for present of existing Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+				return fmt.Errorf("Error checking for present of existing Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, tf.WrapArmError(err))
 			}
 		}
 		if !utils.ResponseWasNotFound(resp.Response) {
@@ -94,12 +104,12 @@ func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, accountName, name, &parameters); err != nil {
-		return fmt.Errorf("Error creating Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		return fmt.Errorf("Error creating Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, tf.WrapArmError(err))
 	}
 	if resp.ID == nil {
 		return fmt.Errorf("Cannot read Batch Application %q (Account Name %q / Resource Group %q) ID", name, accountName, resourceGroup)
@@ -110,8 +120,9 @@ func resourceArmBatchApplicationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmBatchApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch.ApplicationClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -128,7 +139,7 @@ func resourceArmBatchApplicationRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		return fmt.Errorf("Error reading Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", name)
@@ -144,8 +155,9 @@ func resourceArmBatchApplicationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmBatchApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch.ApplicationClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -163,15 +175,16 @@ func resourceArmBatchApplicationUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, err := client.Update(ctx, resourceGroup, accountName, name, parameters); err != nil {
-		return fmt.Errorf("Error updating Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		return fmt.Errorf("Error updating Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	return resourceArmBatchApplicationRead(d, meta)
 }
 
 func resourceArmBatchApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.ApplicationClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch.ApplicationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -182,7 +195,7 @@ func resourceArmBatchApplicationDelete(d *schema.ResourceData, meta interface{})
 	name := id.Path["applications"]
 
 	if _, err := client.Delete(ctx, resourceGroup, accountName, name); err != nil {
-		return fmt.Errorf("Error deleting Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+		return fmt.Errorf("Error deleting Batch Application %q (Account Name %q / Resource Group %q): %+v", name, accountName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -8,15 +8,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -30,6 +30,13 @@ func resourceArmBatchPool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -335,8 +342,9 @@ func resourceArmBatchPool() *schema.Resource {
 }
 
 func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).batch.PoolClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Batch.PoolClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch pool creation.")
 
@@ -351,7 +359,7 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 		existing, err := client.Get(ctx, resourceGroup, accountName, poolName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Batch Pool %q (Account %q / Resource Group %q): %+v", poolName, accountName, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Batch Pool %q (Account %q / Resource Group %q): %+v", poolName, accountName, resourceGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -380,7 +388,7 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 	storageImageReferenceSet := d.Get("storage_image_reference").([]interface{})
 	imageReference, err := azure.ExpandBatchPoolImageReference(storageImageReferenceSet)
 	if err != nil {
-		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if imageReference != nil {
@@ -414,7 +422,7 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 	containerConfigurationSet := d.Get("container_configuration").([]interface{})
 	containerConfiguration, err := azure.ExpandBatchPoolContainerConfiguration(containerConfigurationSet)
 	if err != nil {
-		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	parameters.PoolProperties.DeploymentConfiguration = &batch.DeploymentConfiguration{
@@ -438,16 +446,16 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 
 	future, err := client.Create(ctx, resourceGroup, accountName, poolName, parameters, "", "")
 	if err != nil {
-		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error creating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -467,8 +475,9 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batch.PoolClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Batch.PoolClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -480,7 +489,7 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if resp.PoolProperties.AllocationState != batch.Steady {
@@ -492,7 +501,7 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 
 		log.Printf("[INFO] stopping the pending resize operation on this pool...")
 		if _, err = client.StopResize(ctx, resourceGroup, accountName, poolName); err != nil {
-			return fmt.Errorf("Error stopping resize operation for Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+			return fmt.Errorf("Error stopping resize operation for Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 		}
 
 		// waiting for the pool to be in steady state
@@ -541,7 +550,7 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 
 	result, err := client.Update(ctx, resourceGroup, accountName, poolName, parameters, "")
 	if err != nil {
-		return fmt.Errorf("Error updating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error updating Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	// if the pool is not Steady after the update, wait for it to be Steady
@@ -555,8 +564,9 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batch.PoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Batch.PoolClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -571,7 +581,7 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Batch pool %q in account %q (Resource Group %q) was not found", poolName, accountName, resourceGroup)
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Batch pool %q: %+v", poolName, err)
+		return fmt.Errorf("Error making Read request on AzureRM Batch pool %q: %+v", poolName, tf.WrapArmError(err))
 	}
 
 	d.Set("name", poolName)
@@ -617,8 +627,9 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).batch.PoolClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Batch.PoolClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -630,12 +641,12 @@ func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error
 
 	future, err := client.Delete(ctx, resourceGroup, accountName, poolName)
 	if err != nil {
-		return fmt.Errorf("Error deleting Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+		return fmt.Errorf("Error deleting Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error waiting for deletion of Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+			return fmt.Errorf("Error waiting for deletion of Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 		}
 	}
 	return nil
@@ -699,7 +710,7 @@ func waitForBatchPoolPendingResizeOperation(ctx context.Context, client *batch.P
 	for !isSteady {
 		resp, err := client.Get(ctx, resourceGroup, accountName, poolName)
 		if err != nil {
-			return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, err)
+			return fmt.Errorf("Error retrieving the Batch pool %q (Resource Group %q): %+v", poolName, resourceGroup, tf.WrapArmError(err))
 		}
 
 		isSteady = resp.PoolProperties.AllocationState == batch.Steady
@@ -35,8 +35,45 @@ func TestAccAzureRMCosmosDbCassandraKeyspace_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMCosmosDbCassandraKeyspace_throughput(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_cassandra_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbCassandraKeyspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbCassandraKeyspace_throughput(ri, testLocation(), 700),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbCassandraKeyspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "700"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMCosmosDbCassandraKeyspace_throughput(ri, testLocation(), 1700),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbCassandraKeyspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "1700"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMCosmosDbCassandraKeyspaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).cosmos.DatabaseClient
+	client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -65,7 +102,7 @@ func testCheckAzureRMCosmosDbCassandraKeyspaceDestroy(s *terraform.State) error
 
 func testCheckAzureRMCosmosDbCassandraKeyspaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).cosmos.DatabaseClient
+		client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
@@ -102,3 +139,16 @@ resource "azurerm_cosmosdb_cassandra_keyspace" "test" {
 }
 `, testAccAzureRMCosmosDBAccount_capabilityCassandra(rInt, location), rInt)
 }
+
+func testAccAzureRMCosmosDbCassandraKeyspace_throughput(rInt int, location string, throughput int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_cassandra_keyspace" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  throughput          = %[3]d
+}
+`, testAccAzureRMCosmosDBAccount_capabilityCassandra(rInt, location), rInt, throughput)
+}
@@ -0,0 +1,202 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbGremlinDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbGremlinDatabaseCreate,
+		Read:   resourceArmCosmosDbGremlinDatabaseRead,
+		Update: resourceArmCosmosDbGremlinDatabaseUpdate,
+		Delete: resourceArmCosmosDbGremlinDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"throughput": cosmosDbThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDbGremlinDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetGremlinDatabase(ctx, resourceGroup, account, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of creating Cosmos Gremlin Database %s (Account %s): %+v", name, account, tf.WrapArmError(err))
+			}
+		} else {
+			id, err := azure.CosmosGetIDFromResponse(existing.Response)
+			if err != nil {
+				return fmt.Errorf("Error generating import ID for Cosmos Gremlin Database '%s' (Account %s)", name, account)
+			}
+
+			return tf.ImportAsExistsError("azurerm_cosmosdb_gremlin_database", id)
+		}
+	}
+
+	db := documentdb.GremlinDatabaseCreateUpdateParameters{
+		GremlinDatabaseCreateUpdateProperties: &documentdb.GremlinDatabaseCreateUpdateProperties{
+			Resource: &documentdb.GremlinDatabaseResource{
+				ID: &name,
+			},
+			Options: expandCosmosDbThroughputOptions(d),
+		},
+	}
+
+	future, err := client.CreateUpdateGremlinDatabase(ctx, resourceGroup, account, name, db)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for Cosmos Gremlin Database %s (Account %s): %+v", name, account, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on create/update future for Cosmos Gremlin Database %s (Account %s): %+v", name, account, tf.WrapArmError(err))
+	}
+
+	resp, err := client.GetGremlinDatabase(ctx, resourceGroup, account, name)
+	if err != nil {
+		return fmt.Errorf("Error making get request for Cosmos Gremlin Database %s (Account %s): %+v", name, account, tf.WrapArmError(err))
+	}
+
+	id, err := azure.CosmosGetIDFromResponse(resp.Response)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ID for Cosmos Gremlin Database '%s' (Account %s) ID: %v", name, account, tf.WrapArmError(err))
+	}
+	d.SetId(id)
+
+	return resourceArmCosmosDbGremlinDatabaseRead(d, meta)
+}
+
+func resourceArmCosmosDbGremlinDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetGremlinDatabase(ctx, id.ResourceGroup, id.Account, id.Database)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos Gremlin Database %s (Account %s) - removing from state", id.Database, id.Account)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Cosmos Gremlin Database %s (Account %s): %+v", id.Database, id.Account, tf.WrapArmError(err))
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+	if props := resp.GremlinDatabaseProperties; props != nil {
+		d.Set("name", props.ID)
+	}
+
+	throughput, err := flattenCosmosDbThroughput(client.GetGremlinDatabaseThroughput(ctx, id.ResourceGroup, id.Account, id.Database))
+	if err != nil {
+		return fmt.Errorf("Error reading Throughput for Cosmos Gremlin Database %s (Account %s): %+v", id.Database, id.Account, tf.WrapArmError(err))
+	}
+	d.Set("throughput", throughput)
+
+	return nil
+}
+
+func resourceArmCosmosDbGremlinDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("throughput") {
+		future, err := client.UpdateGremlinDatabaseThroughput(ctx, id.ResourceGroup, id.Account, id.Database, expandCosmosDbThroughput(d))
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return fmt.Errorf("Error setting Throughput for Cosmos Gremlin Database %s (Account %s): throughput can only be updated if it was provisioned when the Database was created", id.Database, id.Account)
+			}
+
+			return fmt.Errorf("Error setting Throughput for Cosmos Gremlin Database %s (Account %s): %+v", id.Database, id.Account, tf.WrapArmError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on ThroughputUpdate future for Cosmos Gremlin Database %s (Account %s): %+v", id.Database, id.Account, tf.WrapArmError(err))
+		}
+	}
+
+	return resourceArmCosmosDbGremlinDatabaseRead(d, meta)
+}
+
+func resourceArmCosmosDbGremlinDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteGremlinDatabase(ctx, id.ResourceGroup, id.Account, id.Database)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting Cosmos Gremlin Database %s (Account %s): %+v", id.Database, id.Account, tf.WrapArmError(err))
+		}
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting on delete future for Cosmos Gremlin Database %s (Account %s): %+v", id.Database, id.Account, tf.WrapArmError(err))
+	}
+
+	return nil
+}
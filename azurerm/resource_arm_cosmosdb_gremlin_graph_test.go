@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMCosmosDbGremlinGraph_basic(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_gremlin_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbGremlinGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbGremlinGraph_basic(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbGremlinGraphExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbGremlinGraph_complete(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_gremlin_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbGremlinGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbGremlinGraph_complete(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbGremlinGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_key_path", "/definition/id"),
+					resource.TestCheckResourceAttr(resourceName, "unique_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "indexing_policy.0.included_path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "indexing_policy.0.excluded_path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "500"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbGremlinGraph_update(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_cosmosdb_gremlin_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbGremlinGraphDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbGremlinGraph_complete(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbGremlinGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "500"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMCosmosDbGremlinGraph_updated(ri, testLocation()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckAzureRMCosmosDbGremlinGraphExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "indexing_policy.0.excluded_path.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCosmosDbGremlinGraphDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cosmosdb_gremlin_graph" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		account := rs.Primary.Attributes["account_name"]
+		database := rs.Primary.Attributes["database_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetGremlinGraph(ctx, resourceGroup, account, database, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Error checking destroy for Cosmos Gremlin Graph %s (account %s, database %s) still exists:\n%v", name, account, database, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Cosmos Gremlin Graph %s (account %s) still exists:\n%#v", name, account, resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMCosmosDbGremlinGraphExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		account := rs.Primary.Attributes["account_name"]
+		database := rs.Primary.Attributes["database_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.GetGremlinGraph(ctx, resourceGroup, account, database, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on cosmosAccountsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Cosmos Gremlin Graph '%s' (account: '%s', database: %s) does not exist", name, account, database)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMCosmosDbGremlinGraph_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_gremlin_graph" "test" {
+  name                = "acctest-CGRPC-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_gremlin_database.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_gremlin_database.test.account_name}"
+  database_name       = "${azurerm_cosmosdb_gremlin_database.test.name}"
+}
+`, testAccAzureRMCosmosDbGremlinDatabase_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMCosmosDbGremlinGraph_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_gremlin_graph" "test" {
+  name                = "acctest-CGRPC-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_gremlin_database.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_gremlin_database.test.account_name}"
+  database_name       = "${azurerm_cosmosdb_gremlin_database.test.name}"
+  partition_key_path  = "/definition/id"
+  default_ttl         = 500
+  throughput          = 400
+
+  unique_key {
+    paths = ["/definition/idlong", "/definition/idshort"]
+  }
+
+  indexing_policy {
+    indexing_mode = "Consistent"
+
+    included_path {
+      path = "/*"
+    }
+
+    excluded_path {
+      path = "/testing/*"
+    }
+  }
+}
+`, testAccAzureRMCosmosDbGremlinDatabase_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMCosmosDbGremlinGraph_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_cosmosdb_gremlin_graph" "test" {
+  name                = "acctest-CGRPC-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_gremlin_database.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_gremlin_database.test.account_name}"
+  database_name       = "${azurerm_cosmosdb_gremlin_database.test.name}"
+  partition_key_path  = "/definition/id"
+  default_ttl         = 1000
+  throughput          = 600
+
+  unique_key {
+    paths = ["/definition/idlong", "/definition/idshort"]
+  }
+
+  indexing_policy {
+    indexing_mode = "Consistent"
+
+    included_path {
+      path = "/*"
+    }
+
+    excluded_path {
+      path = "/testing/*"
+    }
+
+    excluded_path {
+      path = "/archive/*"
+    }
+  }
+}
+`, testAccAzureRMCosmosDbGremlinDatabase_basic(rInt, location), rInt)
+}
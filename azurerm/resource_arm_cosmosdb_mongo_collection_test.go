@@ -51,6 +51,7 @@ func TestAccAzureRMCosmosDbMongoCollection_complete(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "shard_key", "day"),
 					resource.TestCheckResourceAttr(resourceName, "default_ttl_seconds", "707"),
 					resource.TestCheckResourceAttr(resourceName, "indexes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
 				),
 			},
 			{
@@ -84,6 +85,7 @@ func TestAccAzureRMCosmosDbMongoCollection_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "shard_key", "day"),
 					resource.TestCheckResourceAttr(resourceName, "default_ttl_seconds", "707"),
 					resource.TestCheckResourceAttr(resourceName, "indexes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
 				),
 			},
 			{
@@ -109,7 +111,7 @@ func TestAccAzureRMCosmosDbMongoCollection_update(t *testing.T) {
 }
 
 func testCheckAzureRMCosmosDbMongoCollectionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).cosmos.DatabaseClient
+	client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -139,7 +141,7 @@ func testCheckAzureRMCosmosDbMongoCollectionDestroy(s *terraform.State) error {
 
 func testCheckAzureRMCosmosDbMongoCollectionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).cosmos.DatabaseClient
+		client := testAccProvider.Meta().(*ArmClient).Cosmos.DatabaseClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
@@ -191,6 +193,7 @@ resource "azurerm_cosmosdb_mongo_collection" "test" {
 
   default_ttl_seconds = 707
   shard_key           = "day"
+  throughput          = 400
 
   indexes {
     key    = "seven"
@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbSQLContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbSQLContainerCreateUpdate,
+		Read:   resourceArmCosmosDbSQLContainerRead,
+		Update: resourceArmCosmosDbSQLContainerCreateUpdate,
+		Delete: resourceArmCosmosDbSQLContainerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"partition_key_path": cosmosDbPartitionKeyPathSchema(),
+
+			"unique_key": cosmosDbUniqueKeySchema(),
+
+			"indexing_policy": cosmosDbIndexingPolicySchema(),
+
+			// -1 means documents don't expire by default, but can be overridden per document
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"throughput": cosmosDbThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDbSQLContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetSQLContainer(ctx, resourceGroup, account, database, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of creating Cosmos SQL Container %s (Account %s, Database %s): %+v", name, account, database, tf.WrapArmError(err))
+			}
+		} else {
+			id, err := azure.CosmosGetIDFromResponse(existing.Response)
+			if err != nil {
+				return fmt.Errorf("Error generating import ID for Cosmos SQL Container %s (Account %s, Database %s)", name, account, database)
+			}
+
+			return tf.ImportAsExistsError("azurerm_cosmosdb_sql_container", id)
+		}
+	}
+
+	container := documentdb.SQLContainerCreateUpdateParameters{
+		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
+			Resource: &documentdb.SQLContainerResource{
+				ID:              &name,
+				PartitionKey:    expandCosmosDbPartitionKey(d.Get("partition_key_path").(string)),
+				IndexingPolicy:  expandCosmosDbIndexingPolicy(d.Get("indexing_policy").([]interface{})),
+				UniqueKeyPolicy: expandCosmosDbUniqueKeyPolicy(d.Get("unique_key").(*schema.Set).List()),
+			},
+			Options: map[string]*string{},
+		},
+	}
+
+	if v, ok := d.GetOkExists("default_ttl"); ok {
+		container.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(v.(int)))
+	}
+
+	if d.IsNewResource() {
+		container.SQLContainerCreateUpdateProperties.Options = expandCosmosDbThroughputOptions(d)
+	}
+
+	future, err := client.CreateUpdateSQLContainer(ctx, resourceGroup, account, database, name, container)
+	if err != nil {
+		return fmt.Errorf("Error issuing create/update request for Cosmos SQL Container %s (Account %s, Database %s): %+v", name, account, database, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting on create/update future for Cosmos SQL Container %s (Account %s, Database %s): %+v", name, account, database, tf.WrapArmError(err))
+	}
+
+	if !d.IsNewResource() && d.HasChange("throughput") {
+		throughputFuture, err := client.UpdateSQLContainerThroughput(ctx, resourceGroup, account, database, name, expandCosmosDbThroughput(d))
+		if err != nil {
+			if response.WasNotFound(throughputFuture.Response()) {
+				return fmt.Errorf("Error setting Throughput for Cosmos SQL Container %s (Account %s, Database %s): throughput can only be updated if it was provisioned when the Container was created", name, account, database)
+			}
+
+			return fmt.Errorf("Error setting Throughput for Cosmos SQL Container %s (Account %s, Database %s): %+v", name, account, database, tf.WrapArmError(err))
+		}
+
+		if err = throughputFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on ThroughputUpdate future for Cosmos SQL Container %s (Account %s, Database %s): %+v", name, account, database, tf.WrapArmError(err))
+		}
+	}
+
+	resp, err := client.GetSQLContainer(ctx, resourceGroup, account, database, name)
+	if err != nil {
+		return fmt.Errorf("Error making get request for Cosmos SQL Container %s (Account %s, Database %s): %+v", name, account, database, tf.WrapArmError(err))
+	}
+
+	id, err := azure.CosmosGetIDFromResponse(resp.Response)
+	if err != nil {
+		return fmt.Errorf("Error getting ID for Cosmos SQL Container %s (Account %s, Database %s) ID: %v", name, account, database, tf.WrapArmError(err))
+	}
+	d.SetId(id)
+
+	return resourceArmCosmosDbSQLContainerRead(d, meta)
+}
+
+func resourceArmCosmosDbSQLContainerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDatabaseContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSQLContainer(ctx, id.ResourceGroup, id.Account, id.Database, id.Container)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos SQL Container %s (Account %s, Database %s) - removing from state", id.Container, id.Account, id.Database)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Cosmos SQL Container %s (Account %s, Database %s): %+v", id.Container, id.Account, id.Database, tf.WrapArmError(err))
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+	d.Set("database_name", id.Database)
+	if props := resp.SQLContainerProperties; props != nil {
+		d.Set("name", props.ID)
+		d.Set("partition_key_path", flattenCosmosDbPartitionKey(props.PartitionKey))
+
+		var defaultTTL *int
+		if v := props.DefaultTTL; v != nil {
+			defaultTTL = utils.Int(int(*v))
+		}
+		d.Set("default_ttl", defaultTTL)
+
+		if err := d.Set("unique_key", flattenCosmosDbUniqueKeyPolicy(props.UniqueKeyPolicy)); err != nil {
+			return fmt.Errorf("Error setting `unique_key`: %+v", err)
+		}
+
+		if err := d.Set("indexing_policy", flattenCosmosDbIndexingPolicy(props.IndexingPolicy)); err != nil {
+			return fmt.Errorf("Error setting `indexing_policy`: %+v", err)
+		}
+	}
+
+	throughput, err := flattenCosmosDbThroughput(client.GetSQLContainerThroughput(ctx, id.ResourceGroup, id.Account, id.Database, id.Container))
+	if err != nil {
+		return fmt.Errorf("Error reading Throughput for Cosmos SQL Container %s (Account %s, Database %s): %+v", id.Container, id.Account, id.Database, tf.WrapArmError(err))
+	}
+	d.Set("throughput", throughput)
+
+	return nil
+}
+
+func resourceArmCosmosDbSQLContainerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDatabaseContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteSQLContainer(ctx, id.ResourceGroup, id.Account, id.Database, id.Container)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting Cosmos SQL Container %s (Account %s, Database %s): %+v", id.Container, id.Account, id.Database, tf.WrapArmError(err))
+		}
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting on delete future for Cosmos SQL Container %s (Account %s, Database %s): %+v", id.Container, id.Account, id.Database, tf.WrapArmError(err))
+	}
+
+	return nil
+}
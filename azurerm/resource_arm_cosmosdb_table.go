@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -17,12 +19,20 @@ func resourceArmCosmosDbTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmCosmosDbTableCreate,
 		Read:   resourceArmCosmosDbTableRead,
+		Update: resourceArmCosmosDbTableUpdate,
 		Delete: resourceArmCosmosDbTableDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -39,13 +49,16 @@ func resourceArmCosmosDbTable() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validate.CosmosAccountName,
 			},
+
+			"throughput": cosmosDbThroughputSchema(),
 		},
 	}
 }
 
 func resourceArmCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -55,7 +68,7 @@ func resourceArmCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) er
 		existing, err := client.GetTable(ctx, resourceGroup, account, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of creating Cosmos Table %s (Account %s): %+v", name, account, err)
+				return fmt.Errorf("Error checking for presence of creating Cosmos Table %s (Account %s): %+v", name, account, tf.WrapArmError(err))
 			}
 		} else {
 			id, err := azure.CosmosGetIDFromResponse(existing.Response)
@@ -72,27 +85,27 @@ func resourceArmCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) er
 			Resource: &documentdb.TableResource{
 				ID: &name,
 			},
-			Options: map[string]*string{},
+			Options: expandCosmosDbThroughputOptions(d),
 		},
 	}
 
 	future, err := client.CreateUpdateTable(ctx, resourceGroup, account, name, db)
 	if err != nil {
-		return fmt.Errorf("Error issuing create/update request for Cosmos Table %s (Account %s): %+v", name, account, err)
+		return fmt.Errorf("Error issuing create/update request for Cosmos Table %s (Account %s): %+v", name, account, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting on create/update future for Cosmos Table %s (Account %s): %+v", name, account, err)
+		return fmt.Errorf("Error waiting on create/update future for Cosmos Table %s (Account %s): %+v", name, account, tf.WrapArmError(err))
 	}
 
 	resp, err := client.GetTable(ctx, resourceGroup, account, name)
 	if err != nil {
-		return fmt.Errorf("Error making get request for Cosmos Table %s (Account %s): %+v", name, account, err)
+		return fmt.Errorf("Error making get request for Cosmos Table %s (Account %s): %+v", name, account, tf.WrapArmError(err))
 	}
 
 	id, err := azure.CosmosGetIDFromResponse(resp.Response)
 	if err != nil {
-		return fmt.Errorf("Error retrieving the ID for Cosmos Table '%s' (Account %s) ID: %v", name, account, err)
+		return fmt.Errorf("Error retrieving the ID for Cosmos Table '%s' (Account %s) ID: %v", name, account, tf.WrapArmError(err))
 	}
 	d.SetId(id)
 
@@ -100,8 +113,9 @@ func resourceArmCosmosDbTableCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmCosmosDbTableRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseCosmosTableID(d.Id())
 	if err != nil {
@@ -116,7 +130,7 @@ func resourceArmCosmosDbTableRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Cosmos Table %s (Account %s): %+v", id.Table, id.Account, err)
+		return fmt.Errorf("Error reading Cosmos Table %s (Account %s): %+v", id.Table, id.Account, tf.WrapArmError(err))
 	}
 
 	d.Set("resource_group_name", id.ResourceGroup)
@@ -125,12 +139,47 @@ func resourceArmCosmosDbTableRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("name", props.ID)
 	}
 
+	throughput, err := flattenCosmosDbThroughput(client.GetTableThroughput(ctx, id.ResourceGroup, id.Account, id.Table))
+	if err != nil {
+		return fmt.Errorf("Error reading Throughput for Cosmos Table %s (Account %s): %+v", id.Table, id.Account, tf.WrapArmError(err))
+	}
+	d.Set("throughput", throughput)
+
 	return nil
 }
 
+func resourceArmCosmosDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("throughput") {
+		future, err := client.UpdateTableThroughput(ctx, id.ResourceGroup, id.Account, id.Table, expandCosmosDbThroughput(d))
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return fmt.Errorf("Error setting Throughput for Cosmos Table %s (Account %s): throughput can only be updated if it was provisioned when the Table was created", id.Table, id.Account)
+			}
+
+			return fmt.Errorf("Error setting Throughput for Cosmos Table %s (Account %s): %+v", id.Table, id.Account, tf.WrapArmError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting on ThroughputUpdate future for Cosmos Table %s (Account %s): %+v", id.Table, id.Account, tf.WrapArmError(err))
+		}
+	}
+
+	return resourceArmCosmosDbTableRead(d, meta)
+}
+
 func resourceArmCosmosDbTableDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmos.DatabaseClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Cosmos.DatabaseClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseCosmosTableID(d.Id())
 	if err != nil {
@@ -140,13 +189,13 @@ func resourceArmCosmosDbTableDelete(d *schema.ResourceData, meta interface{}) er
 	future, err := client.DeleteTable(ctx, id.ResourceGroup, id.Account, id.Table)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error deleting Cosmos Table %s (Account %s): %+v", id.Table, id.Account, err)
+			return fmt.Errorf("Error deleting Cosmos Table %s (Account %s): %+v", id.Table, id.Account, tf.WrapArmError(err))
 		}
 	}
 
 	err = future.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		return fmt.Errorf("Error waiting on delete future for Cosmos Table %s (Account %s): %+v", id.Table, id.Account, err)
+		return fmt.Errorf("Error waiting on delete future for Cosmos Table %s (Account %s): %+v", id.Table, id.Account, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -0,0 +1,497 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryDatasetDelimitedText() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryDatasetDelimitedTextCreateOrUpdate,
+		Read:   resourceArmDataFactoryDatasetDelimitedTextRead,
+		Update: resourceArmDataFactoryDatasetDelimitedTextCreateOrUpdate,
+		Delete: resourceArmDataFactoryDatasetDelimitedTextDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"linked_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"http_server_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"azure_blob_storage_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relative_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"azure_blob_storage_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"http_server_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"column_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"row_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"quote_character": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"escape_character": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"first_row_as_header": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"null_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"compression_codec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"bzip2",
+					"gzip",
+					"deflate",
+					"ZipDeflate",
+					"snappy",
+					"lz4",
+				}, false),
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"folder": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"schema_column": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Byte",
+								"Byte[]",
+								"Boolean",
+								"Date",
+								"DateTime",
+								"DateTimeOffset",
+								"Decimal",
+								"Double",
+								"Guid",
+								"Int16",
+								"Int32",
+								"Int64",
+								"Single",
+								"String",
+								"TimeSpan",
+							}, false),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryDatasetDelimitedTextCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_dataset_delimited_text", *existing.ID)
+		}
+	}
+
+	location := expandDataFactoryDatasetLocation(d)
+	if location == nil {
+		return fmt.Errorf("One of `http_server_location` or `azure_blob_storage_location` must be specified")
+	}
+
+	delimitedTextDatasetProperties := datafactory.DelimitedTextDatasetTypeProperties{
+		Location:         location,
+		FirstRowAsHeader: d.Get("first_row_as_header").(bool),
+	}
+
+	if v, ok := d.GetOk("column_delimiter"); ok {
+		delimitedTextDatasetProperties.ColumnDelimiter = v.(string)
+	}
+
+	if v, ok := d.GetOk("row_delimiter"); ok {
+		delimitedTextDatasetProperties.RowDelimiter = v.(string)
+	}
+
+	if v, ok := d.GetOk("encoding"); ok {
+		delimitedTextDatasetProperties.EncodingName = v.(string)
+	}
+
+	if v, ok := d.GetOk("quote_character"); ok {
+		delimitedTextDatasetProperties.QuoteChar = v.(string)
+	}
+
+	if v, ok := d.GetOk("escape_character"); ok {
+		delimitedTextDatasetProperties.EscapeChar = v.(string)
+	}
+
+	if v, ok := d.GetOk("null_value"); ok {
+		delimitedTextDatasetProperties.NullValue = v.(string)
+	}
+
+	if v, ok := d.GetOk("compression_codec"); ok {
+		delimitedTextDatasetProperties.CompressionCodec = v.(string)
+	}
+
+	description := d.Get("description").(string)
+	delimitedTextDataset := datafactory.DelimitedTextDataset{
+		DelimitedTextDatasetTypeProperties: &delimitedTextDatasetProperties,
+		LinkedServiceName:                  expandDataFactoryDatasetLinkedService(d.Get("linked_service_name").(string)),
+		Description:                        &description,
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		name := v.(string)
+		delimitedTextDataset.Folder = &datafactory.DatasetFolder{
+			Name: &name,
+		}
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		delimitedTextDataset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		delimitedTextDataset.Annotations = &annotations
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		delimitedTextDataset.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("schema_column"); ok {
+		delimitedTextDataset.Structure = expandDataFactoryDatasetStructure(v.([]interface{}))
+	}
+
+	datasetType := string(datafactory.TypeDelimitedText)
+	dataset := datafactory.DatasetResource{
+		Properties: &delimitedTextDataset,
+		Type:       &datasetType,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, dataset, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryDatasetDelimitedTextRead(d, meta)
+}
+
+func resourceArmDataFactoryDatasetDelimitedTextRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	delimitedTextDataset, ok := resp.Properties.AsDelimitedTextDataset()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeDelimitedText, *resp.Type)
+	}
+
+	d.Set("additional_properties", delimitedTextDataset.AdditionalProperties)
+
+	if delimitedTextDataset.Description != nil {
+		d.Set("description", delimitedTextDataset.Description)
+	}
+
+	parameters := flattenDataFactoryParameters(delimitedTextDataset.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	annotations := flattenDataFactoryAnnotations(delimitedTextDataset.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	if linkedService := delimitedTextDataset.LinkedServiceName; linkedService != nil {
+		if linkedService.ReferenceName != nil {
+			d.Set("linked_service_name", linkedService.ReferenceName)
+		}
+	}
+
+	if properties := delimitedTextDataset.DelimitedTextDatasetTypeProperties; properties != nil {
+		if err := flattenDataFactoryDatasetLocation(d, properties.Location); err != nil {
+			return err
+		}
+
+		columnDelimiter := ""
+		if v, ok := properties.ColumnDelimiter.(string); ok {
+			columnDelimiter = v
+		}
+		d.Set("column_delimiter", columnDelimiter)
+
+		rowDelimiter := ""
+		if v, ok := properties.RowDelimiter.(string); ok {
+			rowDelimiter = v
+		}
+		d.Set("row_delimiter", rowDelimiter)
+
+		encoding := ""
+		if v, ok := properties.EncodingName.(string); ok {
+			encoding = v
+		}
+		d.Set("encoding", encoding)
+
+		quoteCharacter := ""
+		if v, ok := properties.QuoteChar.(string); ok {
+			quoteCharacter = v
+		}
+		d.Set("quote_character", quoteCharacter)
+
+		escapeCharacter := ""
+		if v, ok := properties.EscapeChar.(string); ok {
+			escapeCharacter = v
+		}
+		d.Set("escape_character", escapeCharacter)
+
+		nullValue := ""
+		if v, ok := properties.NullValue.(string); ok {
+			nullValue = v
+		}
+		d.Set("null_value", nullValue)
+
+		compressionCodec := ""
+		if v, ok := properties.CompressionCodec.(string); ok {
+			compressionCodec = v
+		}
+		d.Set("compression_codec", compressionCodec)
+
+		firstRowAsHeader := false
+		if v, ok := properties.FirstRowAsHeader.(bool); ok {
+			firstRowAsHeader = v
+		}
+		d.Set("first_row_as_header", firstRowAsHeader)
+	}
+
+	if folder := delimitedTextDataset.Folder; folder != nil {
+		if folder.Name != nil {
+			d.Set("folder", folder.Name)
+		}
+	}
+
+	structureColumns := flattenDataFactoryStructureColumns(delimitedTextDataset.Structure)
+	if err := d.Set("schema_column", structureColumns); err != nil {
+		return fmt.Errorf("Error setting `schema_column`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryDatasetDelimitedTextDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Dataset Delimited Text %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
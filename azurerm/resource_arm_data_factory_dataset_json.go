@@ -0,0 +1,426 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// dataFactoryDatasetTypeJSON is the type of a JSON Dataset, which isn't available in the Data Factory SDK
+const dataFactoryDatasetTypeJSON datafactory.TypeBasicDataset = "Json"
+
+func resourceArmDataFactoryDatasetJSON() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryDatasetJSONCreateOrUpdate,
+		Read:   resourceArmDataFactoryDatasetJSONRead,
+		Update: resourceArmDataFactoryDatasetJSONCreateOrUpdate,
+		Delete: resourceArmDataFactoryDatasetJSONDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"linked_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"http_server_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"azure_blob_storage_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relative_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"azure_blob_storage_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"http_server_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"folder": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"schema_column": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Byte",
+								"Byte[]",
+								"Boolean",
+								"Date",
+								"DateTime",
+								"DateTimeOffset",
+								"Decimal",
+								"Double",
+								"Guid",
+								"Int16",
+								"Int32",
+								"Int64",
+								"Single",
+								"String",
+								"TimeSpan",
+							}, false),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryDatasetJSONCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_dataset_json", *existing.ID)
+		}
+	}
+
+	location := expandDataFactoryDatasetLocation(d)
+	if location == nil {
+		return fmt.Errorf("One of `http_server_location` or `azure_blob_storage_location` must be specified")
+	}
+
+	jsonDatasetProperties := map[string]interface{}{
+		"location": location,
+	}
+
+	if v, ok := d.GetOk("encoding"); ok {
+		jsonDatasetProperties["encodingName"] = v.(string)
+	}
+
+	description := d.Get("description").(string)
+	jsonDataset := datafactory.Dataset{
+		LinkedServiceName:    expandDataFactoryDatasetLinkedService(d.Get("linked_service_name").(string)),
+		Description:          &description,
+		AdditionalProperties: make(map[string]interface{}),
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		name := v.(string)
+		jsonDataset.Folder = &datafactory.DatasetFolder{
+			Name: &name,
+		}
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		jsonDataset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		jsonDataset.Annotations = &annotations
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			jsonDataset.AdditionalProperties[key] = value
+		}
+	}
+
+	// the version of the Data Factory SDK in use doesn't include the JSON Dataset, however Additional Properties
+	// take precedence over the fields of the generic Dataset when it's serialized - so the type is set here
+	jsonDataset.AdditionalProperties["type"] = dataFactoryDatasetTypeJSON
+	jsonDataset.AdditionalProperties["typeProperties"] = jsonDatasetProperties
+
+	if v, ok := d.GetOk("schema_column"); ok {
+		jsonDataset.Structure = expandDataFactoryDatasetStructure(v.([]interface{}))
+	}
+
+	datasetType := string(dataFactoryDatasetTypeJSON)
+	dataset := datafactory.DatasetResource{
+		Properties: &jsonDataset,
+		Type:       &datasetType,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, dataset, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryDatasetJSONRead(d, meta)
+}
+
+func resourceArmDataFactoryDatasetJSONRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	jsonDataset, ok := resp.Properties.AsDataset()
+	if !ok || jsonDataset == nil || jsonDataset.Type != dataFactoryDatasetTypeJSON {
+		return fmt.Errorf("Error classifiying Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, dataFactoryDatasetTypeJSON, *resp.Type)
+	}
+
+	additionalProperties := make(map[string]interface{})
+	for key, value := range jsonDataset.AdditionalProperties {
+		if key != "typeProperties" {
+			additionalProperties[key] = value
+		}
+	}
+	d.Set("additional_properties", additionalProperties)
+
+	if jsonDataset.Description != nil {
+		d.Set("description", jsonDataset.Description)
+	}
+
+	parameters := flattenDataFactoryParameters(jsonDataset.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	annotations := flattenDataFactoryAnnotations(jsonDataset.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	if linkedService := jsonDataset.LinkedServiceName; linkedService != nil {
+		if linkedService.ReferenceName != nil {
+			d.Set("linked_service_name", linkedService.ReferenceName)
+		}
+	}
+
+	if properties, ok := jsonDataset.AdditionalProperties["typeProperties"].(map[string]interface{}); ok {
+		location, err := parseDataFactoryDatasetJSONLocation(properties["location"])
+		if err != nil {
+			return err
+		}
+		if err := flattenDataFactoryDatasetLocation(d, location); err != nil {
+			return err
+		}
+
+		encoding := ""
+		if v, ok := properties["encodingName"].(string); ok {
+			encoding = v
+		}
+		d.Set("encoding", encoding)
+	}
+
+	if folder := jsonDataset.Folder; folder != nil {
+		if folder.Name != nil {
+			d.Set("folder", folder.Name)
+		}
+	}
+
+	structureColumns := flattenDataFactoryStructureColumns(jsonDataset.Structure)
+	if err := d.Set("schema_column", structureColumns); err != nil {
+		return fmt.Errorf("Error setting `schema_column`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryDatasetJSONDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Dataset JSON %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func parseDataFactoryDatasetJSONLocation(input interface{}) (*datafactory.DatasetLocation, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	// the Type Properties are returned as a generic map, so round-trip the location to parse it
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the location of the JSON Dataset: %+v", err)
+	}
+
+	location := datafactory.DatasetLocation{}
+	if err := location.UnmarshalJSON(body); err != nil {
+		return nil, fmt.Errorf("Error parsing the location of the JSON Dataset: %+v", err)
+	}
+
+	return &location, nil
+}
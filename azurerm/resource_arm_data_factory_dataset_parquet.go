@@ -0,0 +1,391 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryDatasetParquet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryDatasetParquetCreateOrUpdate,
+		Read:   resourceArmDataFactoryDatasetParquetRead,
+		Update: resourceArmDataFactoryDatasetParquetCreateOrUpdate,
+		Delete: resourceArmDataFactoryDatasetParquetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"linked_service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"http_server_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"azure_blob_storage_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relative_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"azure_blob_storage_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"http_server_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"filename": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"compression_codec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"none",
+					"gzip",
+					"snappy",
+					"lzo",
+				}, false),
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"folder": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"schema_column": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Byte",
+								"Byte[]",
+								"Boolean",
+								"Date",
+								"DateTime",
+								"DateTimeOffset",
+								"Decimal",
+								"Double",
+								"Guid",
+								"Int16",
+								"Int32",
+								"Int64",
+								"Single",
+								"String",
+								"TimeSpan",
+							}, false),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryDatasetParquetCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_dataset_parquet", *existing.ID)
+		}
+	}
+
+	location := expandDataFactoryDatasetLocation(d)
+	if location == nil {
+		return fmt.Errorf("One of `http_server_location` or `azure_blob_storage_location` must be specified")
+	}
+
+	parquetDatasetProperties := datafactory.ParquetDatasetTypeProperties{
+		Location: location,
+	}
+
+	if v, ok := d.GetOk("compression_codec"); ok {
+		parquetDatasetProperties.CompressionCodec = v.(string)
+	}
+
+	description := d.Get("description").(string)
+	parquetDataset := datafactory.ParquetDataset{
+		ParquetDatasetTypeProperties: &parquetDatasetProperties,
+		LinkedServiceName:            expandDataFactoryDatasetLinkedService(d.Get("linked_service_name").(string)),
+		Description:                  &description,
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		name := v.(string)
+		parquetDataset.Folder = &datafactory.DatasetFolder{
+			Name: &name,
+		}
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		parquetDataset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		parquetDataset.Annotations = &annotations
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		parquetDataset.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("schema_column"); ok {
+		parquetDataset.Structure = expandDataFactoryDatasetStructure(v.([]interface{}))
+	}
+
+	datasetType := string(datafactory.TypeParquet)
+	dataset := datafactory.DatasetResource{
+		Properties: &parquetDataset,
+		Type:       &datasetType,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, dataset, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryDatasetParquetRead(d, meta)
+}
+
+func resourceArmDataFactoryDatasetParquetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	parquetDataset, ok := resp.Properties.AsParquetDataset()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeParquet, *resp.Type)
+	}
+
+	d.Set("additional_properties", parquetDataset.AdditionalProperties)
+
+	if parquetDataset.Description != nil {
+		d.Set("description", parquetDataset.Description)
+	}
+
+	parameters := flattenDataFactoryParameters(parquetDataset.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	annotations := flattenDataFactoryAnnotations(parquetDataset.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	if linkedService := parquetDataset.LinkedServiceName; linkedService != nil {
+		if linkedService.ReferenceName != nil {
+			d.Set("linked_service_name", linkedService.ReferenceName)
+		}
+	}
+
+	if properties := parquetDataset.ParquetDatasetTypeProperties; properties != nil {
+		if err := flattenDataFactoryDatasetLocation(d, properties.Location); err != nil {
+			return err
+		}
+
+		compressionCodec := ""
+		if v, ok := properties.CompressionCodec.(string); ok {
+			compressionCodec = v
+		}
+		d.Set("compression_codec", compressionCodec)
+	}
+
+	if folder := parquetDataset.Folder; folder != nil {
+		if folder.Name != nil {
+			d.Set("folder", folder.Name)
+		}
+	}
+
+	structureColumns := flattenDataFactoryStructureColumns(parquetDataset.Structure)
+	if err := d.Set("schema_column", structureColumns); err != nil {
+		return fmt.Errorf("Error setting `schema_column`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryDatasetParquetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.DatasetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["datasets"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Dataset Parquet %q (Data Factory %q / Resource Group %q): %s", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
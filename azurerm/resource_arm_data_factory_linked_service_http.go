@@ -0,0 +1,314 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryLinkedServiceHTTP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryLinkedServiceHTTPCreateOrUpdate,
+		Read:   resourceArmDataFactoryLinkedServiceHTTPRead,
+		Update: resourceArmDataFactoryLinkedServiceHTTPCreateOrUpdate,
+		Delete: resourceArmDataFactoryLinkedServiceHTTPDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.HTTPAuthenticationTypeAnonymous),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.HTTPAuthenticationTypeAnonymous),
+					string(datafactory.HTTPAuthenticationTypeBasic),
+					string(datafactory.HTTPAuthenticationTypeDigest),
+					string(datafactory.HTTPAuthenticationTypeWindows),
+				}, false),
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the password is a Secure String, so isn't returned from the API
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"enable_server_certificate_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"integration_runtime_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryLinkedServiceHTTPCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_linked_service_http", *existing.ID)
+		}
+	}
+
+	authenticationType := datafactory.HTTPAuthenticationType(d.Get("authentication_type").(string))
+	httpServerProperties := &datafactory.HTTPLinkedServiceTypeProperties{
+		URL:                               d.Get("url").(string),
+		AuthenticationType:                authenticationType,
+		EnableServerCertificateValidation: d.Get("enable_server_certificate_validation").(bool),
+	}
+
+	if authenticationType != datafactory.HTTPAuthenticationTypeAnonymous {
+		username := d.Get("username").(string)
+		password := d.Get("password").(string)
+		if username == "" || password == "" {
+			return fmt.Errorf("`username` and `password` must be specified when `authentication_type` is %q", authenticationType)
+		}
+
+		httpServerProperties.UserName = username
+		httpServerProperties.Password = expandDataFactorySecureString(password)
+	}
+
+	description := d.Get("description").(string)
+
+	httpServerLinkedService := &datafactory.HTTPLinkedService{
+		Description:                     &description,
+		HTTPLinkedServiceTypeProperties: httpServerProperties,
+		Type:                            datafactory.TypeHTTPServer,
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		httpServerLinkedService.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("integration_runtime_name"); ok {
+		httpServerLinkedService.ConnectVia = expandDataFactoryLinkedServiceIntegrationRuntime(v.(string))
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		httpServerLinkedService.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		httpServerLinkedService.Annotations = &annotations
+	}
+
+	linkedService := datafactory.LinkedServiceResource{
+		Properties: httpServerLinkedService,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, linkedService, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryLinkedServiceHTTPRead(d, meta)
+}
+
+func resourceArmDataFactoryLinkedServiceHTTPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	httpServer, ok := resp.Properties.AsHTTPLinkedService()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeHTTPServer, *resp.Type)
+	}
+
+	d.Set("additional_properties", httpServer.AdditionalProperties)
+
+	if httpServer.Description != nil {
+		d.Set("description", *httpServer.Description)
+	}
+
+	annotations := flattenDataFactoryAnnotations(httpServer.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	parameters := flattenDataFactoryParameters(httpServer.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	if connectVia := httpServer.ConnectVia; connectVia != nil {
+		if connectVia.ReferenceName != nil {
+			d.Set("integration_runtime_name", connectVia.ReferenceName)
+		}
+	}
+
+	if properties := httpServer.HTTPLinkedServiceTypeProperties; properties != nil {
+		if v, ok := properties.URL.(string); ok {
+			d.Set("url", v)
+		}
+
+		d.Set("authentication_type", string(properties.AuthenticationType))
+
+		username := ""
+		if v, ok := properties.UserName.(string); ok {
+			username = v
+		}
+		d.Set("username", username)
+
+		enableServerCertificateValidation := true
+		if v, ok := properties.EnableServerCertificateValidation.(bool); ok {
+			enableServerCertificateValidation = v
+		}
+		d.Set("enable_server_certificate_validation", enableServerCertificateValidation)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryLinkedServiceHTTPDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Linked Service HTTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
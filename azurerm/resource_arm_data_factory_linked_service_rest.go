@@ -0,0 +1,374 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryLinkedServiceREST() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryLinkedServiceRESTCreateOrUpdate,
+		Read:   resourceArmDataFactoryLinkedServiceRESTRead,
+		Update: resourceArmDataFactoryLinkedServiceRESTCreateOrUpdate,
+		Delete: resourceArmDataFactoryLinkedServiceRESTDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.RestServiceAuthenticationTypeAnonymous),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.RestServiceAuthenticationTypeAadServicePrincipal),
+					string(datafactory.RestServiceAuthenticationTypeAnonymous),
+					string(datafactory.RestServiceAuthenticationTypeBasic),
+					string(datafactory.RestServiceAuthenticationTypeManagedServiceIdentity),
+				}, false),
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the password and service principal key are Secure Strings, so aren't returned from the API
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"service_principal_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"service_principal_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"aad_resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"enable_server_certificate_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"integration_runtime_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryLinkedServiceRESTCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_linked_service_rest", *existing.ID)
+		}
+	}
+
+	authenticationType := datafactory.RestServiceAuthenticationType(d.Get("authentication_type").(string))
+	restProperties := &datafactory.RestServiceLinkedServiceTypeProperties{
+		URL:                               d.Get("url").(string),
+		AuthenticationType:                authenticationType,
+		EnableServerCertificateValidation: d.Get("enable_server_certificate_validation").(bool),
+	}
+
+	switch authenticationType {
+	case datafactory.RestServiceAuthenticationTypeBasic:
+		username := d.Get("username").(string)
+		password := d.Get("password").(string)
+		if username == "" || password == "" {
+			return fmt.Errorf("`username` and `password` must be specified when `authentication_type` is %q", authenticationType)
+		}
+
+		restProperties.UserName = username
+		restProperties.Password = expandDataFactorySecureString(password)
+
+	case datafactory.RestServiceAuthenticationTypeAadServicePrincipal:
+		servicePrincipalId := d.Get("service_principal_id").(string)
+		servicePrincipalKey := d.Get("service_principal_key").(string)
+		tenantId := d.Get("tenant_id").(string)
+		if servicePrincipalId == "" || servicePrincipalKey == "" || tenantId == "" {
+			return fmt.Errorf("`service_principal_id`, `service_principal_key` and `tenant_id` must be specified when `authentication_type` is %q", authenticationType)
+		}
+
+		restProperties.ServicePrincipalID = servicePrincipalId
+		restProperties.ServicePrincipalKey = expandDataFactorySecureString(servicePrincipalKey)
+		restProperties.Tenant = tenantId
+	}
+
+	if v, ok := d.GetOk("aad_resource_id"); ok {
+		restProperties.AadResourceID = v.(string)
+	}
+
+	description := d.Get("description").(string)
+
+	restLinkedService := &datafactory.RestServiceLinkedService{
+		Description:                            &description,
+		RestServiceLinkedServiceTypeProperties: restProperties,
+		Type:                                   datafactory.TypeRestService,
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		restLinkedService.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("integration_runtime_name"); ok {
+		restLinkedService.ConnectVia = expandDataFactoryLinkedServiceIntegrationRuntime(v.(string))
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		restLinkedService.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		restLinkedService.Annotations = &annotations
+	}
+
+	linkedService := datafactory.LinkedServiceResource{
+		Properties: restLinkedService,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, linkedService, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryLinkedServiceRESTRead(d, meta)
+}
+
+func resourceArmDataFactoryLinkedServiceRESTRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	rest, ok := resp.Properties.AsRestServiceLinkedService()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeRestService, *resp.Type)
+	}
+
+	d.Set("additional_properties", rest.AdditionalProperties)
+
+	if rest.Description != nil {
+		d.Set("description", *rest.Description)
+	}
+
+	annotations := flattenDataFactoryAnnotations(rest.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	parameters := flattenDataFactoryParameters(rest.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	if connectVia := rest.ConnectVia; connectVia != nil {
+		if connectVia.ReferenceName != nil {
+			d.Set("integration_runtime_name", connectVia.ReferenceName)
+		}
+	}
+
+	if properties := rest.RestServiceLinkedServiceTypeProperties; properties != nil {
+		if v, ok := properties.URL.(string); ok {
+			d.Set("url", v)
+		}
+
+		d.Set("authentication_type", string(properties.AuthenticationType))
+
+		username := ""
+		if v, ok := properties.UserName.(string); ok {
+			username = v
+		}
+		d.Set("username", username)
+
+		servicePrincipalId := ""
+		if v, ok := properties.ServicePrincipalID.(string); ok {
+			servicePrincipalId = v
+		}
+		d.Set("service_principal_id", servicePrincipalId)
+
+		tenantId := ""
+		if v, ok := properties.Tenant.(string); ok {
+			tenantId = v
+		}
+		d.Set("tenant_id", tenantId)
+
+		aadResourceId := ""
+		if v, ok := properties.AadResourceID.(string); ok {
+			aadResourceId = v
+		}
+		d.Set("aad_resource_id", aadResourceId)
+
+		enableServerCertificateValidation := true
+		if v, ok := properties.EnableServerCertificateValidation.(bool); ok {
+			enableServerCertificateValidation = v
+		}
+		d.Set("enable_server_certificate_validation", enableServerCertificateValidation)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryLinkedServiceRESTDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Linked Service REST %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,377 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryLinkedServiceSFTP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryLinkedServiceSFTPCreateOrUpdate,
+		Read:   resourceArmDataFactoryLinkedServiceSFTPRead,
+		Update: resourceArmDataFactoryLinkedServiceSFTPCreateOrUpdate,
+		Delete: resourceArmDataFactoryLinkedServiceSFTPDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Data Factory, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      22,
+				ValidateFunc: validate.PortNumber,
+			},
+
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.SftpAuthenticationTypeBasic),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.SftpAuthenticationTypeBasic),
+					string(datafactory.SftpAuthenticationTypeSSHPublicKey),
+				}, false),
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the password and private key are Secure Strings, so aren't returned from the API
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"private_key_content"},
+			},
+
+			"private_key_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"password"},
+			},
+
+			"private_key_passphrase": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"skip_host_key_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"host_key_fingerprint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"integration_runtime_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryLinkedServiceSFTPCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_linked_service_sftp", *existing.ID)
+		}
+	}
+
+	authenticationType := datafactory.SftpAuthenticationType(d.Get("authentication_type").(string))
+	skipHostKeyValidation := d.Get("skip_host_key_validation").(bool)
+	hostKeyFingerprint := d.Get("host_key_fingerprint").(string)
+	if !skipHostKeyValidation && hostKeyFingerprint == "" {
+		return fmt.Errorf("`host_key_fingerprint` must be specified when `skip_host_key_validation` is `false`")
+	}
+
+	sftpProperties := &datafactory.SftpServerLinkedServiceTypeProperties{
+		Host:                  d.Get("host").(string),
+		Port:                  d.Get("port").(int),
+		AuthenticationType:    authenticationType,
+		UserName:              d.Get("username").(string),
+		SkipHostKeyValidation: skipHostKeyValidation,
+	}
+
+	if hostKeyFingerprint != "" {
+		sftpProperties.HostKeyFingerprint = hostKeyFingerprint
+	}
+
+	switch authenticationType {
+	case datafactory.SftpAuthenticationTypeBasic:
+		password := d.Get("password").(string)
+		if password == "" {
+			return fmt.Errorf("`password` must be specified when `authentication_type` is %q", authenticationType)
+		}
+		sftpProperties.Password = expandDataFactorySecureString(password)
+
+	case datafactory.SftpAuthenticationTypeSSHPublicKey:
+		privateKeyContent := d.Get("private_key_content").(string)
+		if privateKeyContent == "" {
+			return fmt.Errorf("`private_key_content` must be specified when `authentication_type` is %q", authenticationType)
+		}
+		sftpProperties.PrivateKeyContent = expandDataFactorySecureString(privateKeyContent)
+
+		if v, ok := d.GetOk("private_key_passphrase"); ok {
+			sftpProperties.PassPhrase = expandDataFactorySecureString(v.(string))
+		}
+	}
+
+	description := d.Get("description").(string)
+
+	sftpLinkedService := &datafactory.SftpServerLinkedService{
+		Description:                           &description,
+		SftpServerLinkedServiceTypeProperties: sftpProperties,
+		Type:                                  datafactory.TypeSftp,
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		sftpLinkedService.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("integration_runtime_name"); ok {
+		sftpLinkedService.ConnectVia = expandDataFactoryLinkedServiceIntegrationRuntime(v.(string))
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		sftpLinkedService.AdditionalProperties = v.(map[string]interface{})
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		sftpLinkedService.Annotations = &annotations
+	}
+
+	linkedService := datafactory.LinkedServiceResource{
+		Properties: sftpLinkedService,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, dataFactoryName, name, linkedService, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmDataFactoryLinkedServiceSFTPRead(d, meta)
+}
+
+func resourceArmDataFactoryLinkedServiceSFTPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	sftp, ok := resp.Properties.AsSftpServerLinkedService()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, resourceGroup, datafactory.TypeSftp, *resp.Type)
+	}
+
+	d.Set("additional_properties", sftp.AdditionalProperties)
+
+	if sftp.Description != nil {
+		d.Set("description", *sftp.Description)
+	}
+
+	annotations := flattenDataFactoryAnnotations(sftp.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	parameters := flattenDataFactoryParameters(sftp.Parameters)
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	}
+
+	if connectVia := sftp.ConnectVia; connectVia != nil {
+		if connectVia.ReferenceName != nil {
+			d.Set("integration_runtime_name", connectVia.ReferenceName)
+		}
+	}
+
+	if properties := sftp.SftpServerLinkedServiceTypeProperties; properties != nil {
+		if v, ok := properties.Host.(string); ok {
+			d.Set("host", v)
+		}
+
+		// the port is returned as a JSON number, which is unmarshalled as a float64
+		if v, ok := properties.Port.(float64); ok {
+			d.Set("port", int(v))
+		}
+
+		d.Set("authentication_type", string(properties.AuthenticationType))
+
+		if v, ok := properties.UserName.(string); ok {
+			d.Set("username", v)
+		}
+
+		skipHostKeyValidation := false
+		if v, ok := properties.SkipHostKeyValidation.(bool); ok {
+			skipHostKeyValidation = v
+		}
+		d.Set("skip_host_key_validation", skipHostKeyValidation)
+
+		hostKeyFingerprint := ""
+		if properties.HostKeyFingerprint != nil {
+			val, ok := properties.HostKeyFingerprint.(string)
+			if ok {
+				hostKeyFingerprint = val
+			} else {
+				log.Printf("[DEBUG] Skipping `host_key_fingerprint` since it's not a string")
+			}
+		}
+		d.Set("host_key_fingerprint", hostKeyFingerprint)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryLinkedServiceSFTPDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.LinkedServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["linkedservices"]
+
+	response, err := client.Delete(ctx, resourceGroup, dataFactoryName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("Error deleting Data Factory Linked Service SFTP %q (Data Factory %q / Resource Group %q): %+v", name, dataFactoryName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +25,13 @@ func resourceArmDataFactoryPipeline() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -67,13 +76,21 @@ func resourceArmDataFactoryPipeline() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"activities_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: azureRmDataFactoryJsonDiff,
+			},
 		},
 	}
 }
 
 func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DataFactory.PipelinesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Data Factory Pipeline creation.")
 
@@ -85,7 +102,7 @@ func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta int
 		existing, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %s", name, resourceGroupName, dataFactoryName, err)
+				return fmt.Errorf("Error checking for presence of existing Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %s", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
 			}
 		}
 
@@ -109,17 +126,25 @@ func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta int
 		pipeline.Annotations = &annotations
 	}
 
+	if v, ok := d.GetOk("activities_json"); ok {
+		activities, err := expandDataFactoryPipelineActivities(v.(string))
+		if err != nil {
+			return err
+		}
+		pipeline.Activities = activities
+	}
+
 	config := datafactory.PipelineResource{
 		Pipeline: pipeline,
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroupName, dataFactoryName, name, config, ""); err != nil {
-		return fmt.Errorf("Error creating Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+		return fmt.Errorf("Error creating Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
 	if err != nil {
-		return fmt.Errorf("Error retrieving Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+		return fmt.Errorf("Error retrieving Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -132,8 +157,9 @@ func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DataFactory.PipelinesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -149,7 +175,7 @@ func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}
 			log.Printf("[DEBUG] Data Factory Pipeline %q was not found in Resource Group %q - removing from state!", name, id.ResourceGroup)
 			return nil
 		}
-		return fmt.Errorf("Error reading the state of Data Factory Pipeline %q: %+v", name, err)
+		return fmt.Errorf("Error reading the state of Data Factory Pipeline %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -174,14 +200,20 @@ func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `variables`: %+v", err)
 		}
 
+		activities, err := flattenDataFactoryPipelineActivities(props.Activities)
+		if err != nil {
+			return err
+		}
+		d.Set("activities_json", activities)
 	}
 
 	return nil
 }
 
 func resourceArmDataFactoryPipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DataFactory.PipelinesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -192,7 +224,7 @@ func resourceArmDataFactoryPipelineDelete(d *schema.ResourceData, meta interface
 	resourceGroupName := id.ResourceGroup
 
 	if _, err = client.Delete(ctx, resourceGroupName, dataFactoryName, name); err != nil {
-		return fmt.Errorf("Error deleting Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+		return fmt.Errorf("Error deleting Data Factory Pipeline %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
 	}
 
 	return nil
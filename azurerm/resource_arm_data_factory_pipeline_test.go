@@ -77,8 +77,37 @@ func TestAccAzureRMDataFactoryPipeline_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDataFactoryPipeline_activities(t *testing.T) {
+	resourceName := "azurerm_data_factory_pipeline.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMDataFactoryPipeline_activities(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryPipelineExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "activities_json"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API returns additional default values which are ignored by the diff suppression
+				ImportStateVerifyIgnore: []string{"activities_json"},
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDataFactoryPipelineDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).dataFactory.PipelinesClient
+	client := testAccProvider.Meta().(*ArmClient).DataFactory.PipelinesClient
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_data_factory_pipeline" {
 			continue
@@ -113,7 +142,7 @@ func testCheckAzureRMDataFactoryPipelineExists(resourceName string) resource.Tes
 		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).dataFactory.PipelinesClient
+		client := testAccProvider.Meta().(*ArmClient).DataFactory.PipelinesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
 		if err != nil {
@@ -213,3 +242,43 @@ resource "azurerm_data_factory_pipeline" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMDataFactoryPipeline_activities(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  variables = {
+    bob = "item1"
+  }
+
+  activities_json = <<JSON
+[
+  {
+    "name": "Append variable1",
+    "type": "AppendVariable",
+    "dependsOn": [],
+    "userProperties": [],
+    "typeProperties": {
+      "variableName": "bob",
+      "value": "something"
+    }
+  }
+]
+JSON
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,454 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryTriggerSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryTriggerScheduleCreateUpdate,
+		Read:   resourceArmDataFactoryTriggerScheduleRead,
+		Update: resourceArmDataFactoryTriggerScheduleCreateUpdate,
+		Delete: resourceArmDataFactoryTriggerScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryPipelineName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid data_factory_name, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureRMDataFactoryPipelineName,
+			},
+
+			"pipeline_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.Minute),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.Minute),
+					string(datafactory.Hour),
+					string(datafactory.Day),
+					string(datafactory.Week),
+					string(datafactory.Month),
+				}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Always in UTC and must be RFC3339 format
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			// Always in UTC and must be RFC3339 format
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minutes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 59),
+							},
+						},
+
+						"hours": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 23),
+							},
+						},
+
+						"days_of_week": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(datafactory.DaysOfWeekMonday),
+									string(datafactory.DaysOfWeekTuesday),
+									string(datafactory.DaysOfWeekWednesday),
+									string(datafactory.DaysOfWeekThursday),
+									string(datafactory.DaysOfWeekFriday),
+									string(datafactory.DaysOfWeekSaturday),
+									string(datafactory.DaysOfWeekSunday),
+								}, false),
+							},
+						},
+
+						"days_of_month": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(1, 31),
+							},
+						},
+					},
+				},
+			},
+
+			"activated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryTriggerScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Data Factory Schedule Trigger creation.")
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q): %s", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_trigger_schedule", *existing.ID)
+		}
+	}
+
+	recurrence := &datafactory.ScheduleTriggerRecurrence{
+		Frequency: datafactory.RecurrenceFrequency(d.Get("frequency").(string)),
+		Interval:  utils.Int32(int32(d.Get("interval").(int))),
+		Schedule:  expandDataFactoryTriggerScheduleRecurrenceSchedule(d.Get("schedule").([]interface{})),
+	}
+
+	// when no start time is specified, the Trigger starts from the time it's created
+	startTime := time.Now().UTC().Format(time.RFC3339)
+	if v, ok := d.GetOk("start_time"); ok {
+		startTime = v.(string)
+	}
+	t, err := parseDataFactoryTriggerTime(startTime)
+	if err != nil {
+		return fmt.Errorf("Error parsing `start_time`: %+v", err)
+	}
+	recurrence.StartTime = t
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, err := parseDataFactoryTriggerTime(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `end_time`: %+v", err)
+		}
+		recurrence.EndTime = t
+	}
+
+	pipelineName := d.Get("pipeline_name").(string)
+	pipelineParameters := d.Get("pipeline_parameters").(map[string]interface{})
+
+	trigger := &datafactory.ScheduleTrigger{
+		ScheduleTriggerTypeProperties: &datafactory.ScheduleTriggerTypeProperties{
+			Recurrence: recurrence,
+		},
+		Pipelines: &[]datafactory.TriggerPipelineReference{
+			*expandDataFactoryTriggerPipelineReference(pipelineName, pipelineParameters),
+		},
+		Description: utils.String(d.Get("description").(string)),
+	}
+
+	annotations := make([]interface{}, 0)
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations = v.([]interface{})
+	}
+	trigger.Annotations = &annotations
+
+	config := datafactory.TriggerResource{
+		Properties: trigger,
+	}
+
+	if !d.IsNewResource() {
+		if err := stopDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroupName, dataFactoryName, name, config, ""); err != nil {
+		return fmt.Errorf("Error creating Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if d.Get("activated").(bool) {
+		if err := startDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+			return err
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q) ID", name, resourceGroupName, dataFactoryName)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryTriggerScheduleRead(d, meta)
+}
+
+func resourceArmDataFactoryTriggerScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+
+	resp, err := client.Get(ctx, id.ResourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			log.Printf("[DEBUG] Data Factory Schedule Trigger %q was not found in Resource Group %q - removing from state!", name, id.ResourceGroup)
+			return nil
+		}
+		return fmt.Errorf("Error reading the state of Data Factory Schedule Trigger %q: %+v", name, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	if resp.Properties == nil {
+		return fmt.Errorf("Error retrieving Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q): `properties` was nil", name, id.ResourceGroup, dataFactoryName)
+	}
+
+	trigger, ok := resp.Properties.AsScheduleTrigger()
+	if !ok || trigger == nil {
+		return fmt.Errorf("Error classifying Data Factory Trigger %q (Resource Group %q / Data Factory %q): Expected a Schedule Trigger", name, id.ResourceGroup, dataFactoryName)
+	}
+
+	d.Set("description", trigger.Description)
+	d.Set("activated", trigger.RuntimeState == datafactory.TriggerRuntimeStateStarted)
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(trigger.Annotations)); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	pipelineName := ""
+	pipelineParameters := make(map[string]interface{})
+	if pipelines := trigger.Pipelines; pipelines != nil && len(*pipelines) > 0 {
+		pipelineName, pipelineParameters = flattenDataFactoryTriggerPipelineReference(&(*pipelines)[0])
+	}
+	d.Set("pipeline_name", pipelineName)
+	if err := d.Set("pipeline_parameters", pipelineParameters); err != nil {
+		return fmt.Errorf("Error setting `pipeline_parameters`: %+v", err)
+	}
+
+	if props := trigger.ScheduleTriggerTypeProperties; props != nil {
+		if recurrence := props.Recurrence; recurrence != nil {
+			d.Set("frequency", string(recurrence.Frequency))
+			if recurrence.Interval != nil {
+				d.Set("interval", int(*recurrence.Interval))
+			}
+
+			if v := recurrence.StartTime; v != nil {
+				d.Set("start_time", v.Format(time.RFC3339))
+			}
+			if v := recurrence.EndTime; v != nil {
+				d.Set("end_time", v.Format(time.RFC3339))
+			}
+
+			if err := d.Set("schedule", flattenDataFactoryTriggerScheduleRecurrenceSchedule(recurrence.Schedule)); err != nil {
+				return fmt.Errorf("Error setting `schedule`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryTriggerScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+	resourceGroupName := id.ResourceGroup
+
+	if err := stopDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(ctx, resourceGroupName, dataFactoryName, name); err != nil {
+		return fmt.Errorf("Error deleting Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	return nil
+}
+
+func expandDataFactoryTriggerScheduleRecurrenceSchedule(input []interface{}) *datafactory.RecurrenceSchedule {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	schedule := datafactory.RecurrenceSchedule{}
+
+	if minutes := expandDataFactoryInt32List(v["minutes"].([]interface{})); len(minutes) > 0 {
+		schedule.Minutes = &minutes
+	}
+
+	if hours := expandDataFactoryInt32List(v["hours"].([]interface{})); len(hours) > 0 {
+		schedule.Hours = &hours
+	}
+
+	if monthDays := expandDataFactoryInt32List(v["days_of_month"].([]interface{})); len(monthDays) > 0 {
+		schedule.MonthDays = &monthDays
+	}
+
+	weekDays := make([]datafactory.DaysOfWeek, 0)
+	for _, day := range v["days_of_week"].([]interface{}) {
+		weekDays = append(weekDays, datafactory.DaysOfWeek(day.(string)))
+	}
+	if len(weekDays) > 0 {
+		schedule.WeekDays = &weekDays
+	}
+
+	return &schedule
+}
+
+func flattenDataFactoryTriggerScheduleRecurrenceSchedule(input *datafactory.RecurrenceSchedule) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	weekDays := make([]interface{}, 0)
+	if input.WeekDays != nil {
+		for _, v := range *input.WeekDays {
+			weekDays = append(weekDays, string(v))
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"minutes":       flattenDataFactoryInt32List(input.Minutes),
+			"hours":         flattenDataFactoryInt32List(input.Hours),
+			"days_of_week":  weekDays,
+			"days_of_month": flattenDataFactoryInt32List(input.MonthDays),
+		},
+	}
+}
+
+func expandDataFactoryInt32List(input []interface{}) []int32 {
+	output := make([]int32, 0)
+	for _, v := range input {
+		output = append(output, int32(v.(int)))
+	}
+	return output
+}
+
+func flattenDataFactoryInt32List(input *[]int32) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, int(v))
+	}
+	return output
+}
@@ -0,0 +1,208 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataFactoryTriggerSchedule_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory_trigger_schedule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMDataFactoryTriggerSchedule_basic(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryTriggerScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Minute"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryTriggerSchedule_complete(t *testing.T) {
+	resourceName := "azurerm_data_factory_trigger_schedule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMDataFactoryTriggerSchedule_basic(ri, location)
+	config2 := testAccAzureRMDataFactoryTriggerSchedule_complete(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryTriggerScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName),
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Week"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "true"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.days_of_week.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryTriggerScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).DataFactory.TriggersClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_trigger_schedule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		}
+
+		return nil
+	}
+	return nil
+}
+
+func testCheckAzureRMDataFactoryTriggerScheduleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).DataFactory.TriggersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Data Factory Schedule Trigger %q (Resource Group %q / Data Factory %q) does not exist", name, resourceGroup, dataFactoryName)
+			}
+			return fmt.Errorf("Bad: Get on DataFactoryTriggersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMDataFactoryTriggerSchedule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  parameters = {
+    test = "testparameter"
+  }
+}
+
+resource "azurerm_data_factory_trigger_schedule" "test" {
+  name                = "acctestdf%d"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  pipeline_name       = "${azurerm_data_factory_pipeline.test.name}"
+  activated           = false
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryTriggerSchedule_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  parameters = {
+    test = "testparameter"
+  }
+}
+
+resource "azurerm_data_factory_trigger_schedule" "test" {
+  name                = "acctestdf%d"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  pipeline_name       = "${azurerm_data_factory_pipeline.test.name}"
+  description         = "test description"
+  annotations         = ["test1", "test2"]
+
+  pipeline_parameters = "${azurerm_data_factory_pipeline.test.parameters}"
+
+  frequency  = "Week"
+  interval   = 2
+  start_time = "2019-09-01T00:00:00Z"
+  end_time   = "2029-09-01T00:00:00Z"
+
+  schedule {
+    minutes      = [0, 30]
+    hours        = [6]
+    days_of_week = ["Monday", "Thursday"]
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,401 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryTriggerTumblingWindow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryTriggerTumblingWindowCreateUpdate,
+		Read:   resourceArmDataFactoryTriggerTumblingWindowRead,
+		Update: resourceArmDataFactoryTriggerTumblingWindowCreateUpdate,
+		Delete: resourceArmDataFactoryTriggerTumblingWindowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryPipelineName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid data_factory_name, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureRMDataFactoryPipelineName,
+			},
+
+			"pipeline_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// the window size can't be changed once the Trigger has been created
+			"frequency": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.TumblingWindowFrequencyMinute),
+					string(datafactory.TumblingWindowFrequencyHour),
+				}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Always in UTC and must be RFC3339 format
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			// Always in UTC and must be RFC3339 format
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			// a timespan in the format `hh:mm:ss`
+			"delay": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^\d{2}:[0-5]\d:[0-5]\d$`),
+					"`delay` must be a timespan in the format `hh:mm:ss`",
+				),
+			},
+
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(30),
+						},
+					},
+				},
+			},
+
+			"activated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryTriggerTumblingWindowCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Data Factory Tumbling Window Trigger creation.")
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q): %s", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_trigger_tumbling_window", *existing.ID)
+		}
+	}
+
+	startTime, err := parseDataFactoryTriggerTime(d.Get("start_time").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `start_time`: %+v", err)
+	}
+
+	props := &datafactory.TumblingWindowTriggerTypeProperties{
+		Frequency:      datafactory.TumblingWindowFrequency(d.Get("frequency").(string)),
+		Interval:       utils.Int32(int32(d.Get("interval").(int))),
+		StartTime:      startTime,
+		MaxConcurrency: utils.Int32(int32(d.Get("max_concurrency").(int))),
+		RetryPolicy:    expandDataFactoryTriggerTumblingWindowRetryPolicy(d.Get("retry").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, err := parseDataFactoryTriggerTime(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `end_time`: %+v", err)
+		}
+		props.EndTime = t
+	}
+
+	if v, ok := d.GetOk("delay"); ok {
+		props.Delay = v.(string)
+	}
+
+	pipelineName := d.Get("pipeline_name").(string)
+	pipelineParameters := d.Get("pipeline_parameters").(map[string]interface{})
+
+	trigger := &datafactory.TumblingWindowTrigger{
+		TumblingWindowTriggerTypeProperties: props,
+		Pipeline:                            expandDataFactoryTriggerPipelineReference(pipelineName, pipelineParameters),
+		Description:                         utils.String(d.Get("description").(string)),
+	}
+
+	annotations := make([]interface{}, 0)
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations = v.([]interface{})
+	}
+	trigger.Annotations = &annotations
+
+	config := datafactory.TriggerResource{
+		Properties: trigger,
+	}
+
+	if !d.IsNewResource() {
+		if err := stopDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroupName, dataFactoryName, name, config, ""); err != nil {
+		return fmt.Errorf("Error creating Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if d.Get("activated").(bool) {
+		if err := startDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+			return err
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q) ID", name, resourceGroupName, dataFactoryName)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataFactoryTriggerTumblingWindowRead(d, meta)
+}
+
+func resourceArmDataFactoryTriggerTumblingWindowRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+
+	resp, err := client.Get(ctx, id.ResourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			log.Printf("[DEBUG] Data Factory Tumbling Window Trigger %q was not found in Resource Group %q - removing from state!", name, id.ResourceGroup)
+			return nil
+		}
+		return fmt.Errorf("Error reading the state of Data Factory Tumbling Window Trigger %q: %+v", name, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	if resp.Properties == nil {
+		return fmt.Errorf("Error retrieving Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q): `properties` was nil", name, id.ResourceGroup, dataFactoryName)
+	}
+
+	trigger, ok := resp.Properties.AsTumblingWindowTrigger()
+	if !ok || trigger == nil {
+		return fmt.Errorf("Error classifying Data Factory Trigger %q (Resource Group %q / Data Factory %q): Expected a Tumbling Window Trigger", name, id.ResourceGroup, dataFactoryName)
+	}
+
+	d.Set("description", trigger.Description)
+	d.Set("activated", trigger.RuntimeState == datafactory.TriggerRuntimeStateStarted)
+
+	if err := d.Set("annotations", flattenDataFactoryAnnotations(trigger.Annotations)); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	pipelineName, pipelineParameters := flattenDataFactoryTriggerPipelineReference(trigger.Pipeline)
+	d.Set("pipeline_name", pipelineName)
+	if err := d.Set("pipeline_parameters", pipelineParameters); err != nil {
+		return fmt.Errorf("Error setting `pipeline_parameters`: %+v", err)
+	}
+
+	if props := trigger.TumblingWindowTriggerTypeProperties; props != nil {
+		d.Set("frequency", string(props.Frequency))
+		if props.Interval != nil {
+			d.Set("interval", int(*props.Interval))
+		}
+		if props.MaxConcurrency != nil {
+			d.Set("max_concurrency", int(*props.MaxConcurrency))
+		}
+
+		if v := props.StartTime; v != nil {
+			d.Set("start_time", v.Format(time.RFC3339))
+		}
+		if v := props.EndTime; v != nil {
+			d.Set("end_time", v.Format(time.RFC3339))
+		}
+
+		if v, ok := props.Delay.(string); ok {
+			d.Set("delay", v)
+		}
+
+		if err := d.Set("retry", flattenDataFactoryTriggerTumblingWindowRetryPolicy(props.RetryPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retry`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryTriggerTumblingWindowDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+	resourceGroupName := id.ResourceGroup
+
+	if err := stopDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(ctx, resourceGroupName, dataFactoryName, name); err != nil {
+		return fmt.Errorf("Error deleting Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, tf.WrapArmError(err))
+	}
+
+	return nil
+}
+
+func expandDataFactoryTriggerTumblingWindowRetryPolicy(input []interface{}) *datafactory.RetryPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	return &datafactory.RetryPolicy{
+		Count:             v["count"].(int),
+		IntervalInSeconds: utils.Int32(int32(v["interval_in_seconds"].(int))),
+	}
+}
+
+func flattenDataFactoryTriggerTumblingWindowRetryPolicy(input *datafactory.RetryPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the API returns the count as a JSON number, which is unmarshalled as a float64
+	count := 0
+	switch v := input.Count.(type) {
+	case float64:
+		count = int(v)
+	case int:
+		count = v
+	}
+
+	intervalInSeconds := 30
+	if input.IntervalInSeconds != nil {
+		intervalInSeconds = int(*input.IntervalInSeconds)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"count":               count,
+			"interval_in_seconds": intervalInSeconds,
+		},
+	}
+}
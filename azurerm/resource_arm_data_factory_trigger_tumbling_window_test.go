@@ -0,0 +1,203 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDataFactoryTriggerTumblingWindow_basic(t *testing.T) {
+	resourceName := "azurerm_data_factory_trigger_tumbling_window.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMDataFactoryTriggerTumblingWindow_basic(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryTriggerTumblingWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerTumblingWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "50"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataFactoryTriggerTumblingWindow_complete(t *testing.T) {
+	resourceName := "azurerm_data_factory_trigger_tumbling_window.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	config := testAccAzureRMDataFactoryTriggerTumblingWindow_complete(ri, location)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataFactoryTriggerTumblingWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataFactoryTriggerTumblingWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delay", "00:15:00"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "retry.0.count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "retry.0.interval_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "activated", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataFactoryTriggerTumblingWindowDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).DataFactory.TriggersClient
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_factory_trigger_tumbling_window" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		}
+
+		return nil
+	}
+	return nil
+}
+
+func testCheckAzureRMDataFactoryTriggerTumblingWindowExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		dataFactoryName := rs.Primary.Attributes["data_factory_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).DataFactory.TriggersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, dataFactoryName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Data Factory Tumbling Window Trigger %q (Resource Group %q / Data Factory %q) does not exist", name, resourceGroup, dataFactoryName)
+			}
+			return fmt.Errorf("Bad: Get on DataFactoryTriggersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMDataFactoryTriggerTumblingWindow_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  parameters = {
+    test = "testparameter"
+  }
+}
+
+resource "azurerm_data_factory_trigger_tumbling_window" "test" {
+  name                = "acctestdf%d"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  pipeline_name       = "${azurerm_data_factory_pipeline.test.name}"
+  frequency           = "Hour"
+  interval            = 1
+  start_time          = "2019-09-01T00:00:00Z"
+  activated           = false
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMDataFactoryTriggerTumblingWindow_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+
+  parameters = {
+    test = "testparameter"
+  }
+}
+
+resource "azurerm_data_factory_trigger_tumbling_window" "test" {
+  name                = "acctestdf%d"
+  data_factory_name   = "${azurerm_data_factory.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  pipeline_name       = "${azurerm_data_factory_pipeline.test.name}"
+  description         = "test description"
+  annotations         = ["test1", "test2"]
+
+  pipeline_parameters = "${azurerm_data_factory_pipeline.test.parameters}"
+
+  frequency       = "Minute"
+  interval        = 15
+  start_time      = "2019-09-01T00:00:00Z"
+  end_time        = "2029-09-01T00:00:00Z"
+  delay           = "00:15:00"
+  max_concurrency = 10
+
+  retry {
+    count               = 3
+    interval_in_seconds = 60
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
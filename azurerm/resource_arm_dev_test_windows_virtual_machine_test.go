@@ -152,7 +152,7 @@ func testCheckAzureRMDevTestWindowsVirtualMachineExists(resourceName string) res
 		labName := rs.Primary.Attributes["lab_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).devTestLabs.VirtualMachinesClient
+		conn := testAccProvider.Meta().(*ArmClient).DevTestLabs.VirtualMachinesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, labName, virtualMachineName, "")
@@ -169,7 +169,7 @@ func testCheckAzureRMDevTestWindowsVirtualMachineExists(resourceName string) res
 }
 
 func testCheckAzureRMDevTestWindowsVirtualMachineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).devTestLabs.VirtualMachinesClient
+	conn := testAccProvider.Meta().(*ArmClient).DevTestLabs.VirtualMachinesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
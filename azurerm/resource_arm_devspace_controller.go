@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/devspaces/mgmt/2018-06-01-preview/devspaces"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +25,13 @@ func resourceArmDevSpaceController() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -95,8 +104,9 @@ func resourceArmDevSpaceController() *schema.Resource {
 }
 
 func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DevSpace.ControllersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevSpace Controller creation")
 
@@ -107,7 +117,7 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing DevSpace Controller %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing DevSpace Controller %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -127,7 +137,7 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 
 	controller := devspaces.Controller{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		Sku:      sku,
 		ControllerProperties: &devspaces.ControllerProperties{
 			HostSuffix:                           &hostSuffix,
@@ -138,16 +148,16 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 
 	future, err := client.Create(ctx, resGroup, name, controller)
 	if err != nil {
-		return fmt.Errorf("Error creating DevSpace Controller %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating DevSpace Controller %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of DevSpace Controller %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for creation of DevSpace Controller %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	result, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving DevSpace %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving DevSpace %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if result.ID == nil {
@@ -159,8 +169,9 @@ func resourceArmDevSpaceControllerCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmDevSpaceControllerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DevSpace.ControllersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -177,7 +188,7 @@ func resourceArmDevSpaceControllerRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error making Read request on DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, tf.WrapArmError(err))
 	}
 
 	d.Set("name", result.Name)
@@ -196,14 +207,15 @@ func resourceArmDevSpaceControllerRead(d *schema.ResourceData, meta interface{})
 		d.Set("target_container_host_resource_id", props.TargetContainerHostResourceID)
 	}
 
-	flattenAndSetTags(d, result.Tags)
+	flattenAndSetTags(d, result.Tags, meta)
 
 	return nil
 }
 
 func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DevSpace.ControllersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for DevSpace Controller updating")
 
@@ -212,12 +224,12 @@ func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{
 	tags := d.Get("tags").(map[string]interface{})
 
 	params := devspaces.ControllerUpdateParameters{
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	result, err := client.Update(ctx, resGroupName, name, params)
 	if err != nil {
-		return fmt.Errorf("Error updating DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error updating DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, tf.WrapArmError(err))
 	}
 
 	if result.ID == nil {
@@ -229,8 +241,9 @@ func resourceArmDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmDevSpaceControllerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).devSpace.ControllersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).DevSpace.ControllersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -241,11 +254,11 @@ func resourceArmDevSpaceControllerDelete(d *schema.ResourceData, meta interface{
 
 	future, err := client.Delete(ctx, resGroupName, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error deleting DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the deletion of DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, err)
+		return fmt.Errorf("Error waiting for the deletion of DevSpace Controller %q (Resource Group %q): %+v", name, resGroupName, tf.WrapArmError(err))
 	}
 
 	return nil
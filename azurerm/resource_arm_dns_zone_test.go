@@ -138,7 +138,7 @@ func testCheckAzureRMDnsZoneExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for DNS zone: %s", zoneName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).dns.ZonesClient
+		client := testAccProvider.Meta().(*ArmClient).Dns.ZonesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, zoneName)
 		if err != nil {
@@ -154,7 +154,7 @@ func testCheckAzureRMDnsZoneExists(resourceName string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMDnsZoneDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).dns.ZonesClient
+	conn := testAccProvider.Meta().(*ArmClient).Dns.ZonesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/eventgrid/mgmt/2018-09-15-preview/eventgrid"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +27,13 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -211,8 +220,9 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 }
 
 func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).EventGrid.EventSubscriptionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -221,7 +231,7 @@ func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, m
 		existing, err := client.Get(ctx, scope, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing EventGrid Event Subscription %q (Scope %q): %s", name, scope, err)
+				return fmt.Errorf("Error checking for presence of existing EventGrid Event Subscription %q (Scope %q): %s", name, scope, tf.WrapArmError(err))
 			}
 		}
 
@@ -256,16 +266,16 @@ func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, m
 
 	future, err := client.CreateOrUpdate(ctx, scope, name, eventSubscription)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating EventGrid Event Subscription %q (Scope %q): %s", name, scope, err)
+		return fmt.Errorf("Error creating/updating EventGrid Event Subscription %q (Scope %q): %s", name, scope, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for EventGrid Event Subscription %q (Scope %q) to become available: %s", name, scope, err)
+		return fmt.Errorf("Error waiting for EventGrid Event Subscription %q (Scope %q) to become available: %s", name, scope, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, scope, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving EventGrid Event Subscription %q (Scope %q): %s", name, scope, err)
+		return fmt.Errorf("Error retrieving EventGrid Event Subscription %q (Scope %q): %s", name, scope, tf.WrapArmError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read EventGrid Event Subscription %s (Scope %s) ID", name, scope)
@@ -277,8 +287,9 @@ func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, m
 }
 
 func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).EventGrid.EventSubscriptionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureEventGridEventSubscriptionID(d.Id())
 	if err != nil {
@@ -295,7 +306,7 @@ func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta inte
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on EventGrid Event Subscription '%s': %+v", name, err)
+		return fmt.Errorf("Error making Read request on EventGrid Event Subscription '%s': %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -326,7 +337,7 @@ func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta inte
 		if _, ok := props.Destination.AsWebHookEventSubscriptionDestination(); ok {
 			fullURL, err := client.GetFullURL(ctx, scope, name)
 			if err != nil {
-				return fmt.Errorf("Error making Read request on EventGrid Event Subscription full URL '%s': %+v", name, err)
+				return fmt.Errorf("Error making Read request on EventGrid Event Subscription full URL '%s': %+v", name, tf.WrapArmError(err))
 			}
 			if err := d.Set("webhook_endpoint", flattenEventGridEventSubscriptionWebhookEndpoint(&fullURL)); err != nil {
 				return fmt.Errorf("Error setting `webhook_endpoint` for EventGrid Event Subscription %q (Scope %q): %s", name, scope, err)
@@ -365,8 +376,9 @@ func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta inte
 }
 
 func resourceArmEventGridEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).EventGrid.EventSubscriptionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureEventGridEventSubscriptionID(d.Id())
 	if err != nil {
@@ -380,14 +392,14 @@ func resourceArmEventGridEventSubscriptionDelete(d *schema.ResourceData, meta in
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Event Grid Event Subscription %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Event Grid Event Subscription %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Event Grid Event Subscription %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Event Grid Event Subscription %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	return nil
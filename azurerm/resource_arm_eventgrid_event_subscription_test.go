@@ -151,7 +151,7 @@ func TestAccAzureRMEventGridEventSubscription_filter(t *testing.T) {
 }
 
 func testCheckAzureRMEventGridEventSubscriptionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).eventGrid.EventSubscriptionsClient
+	client := testAccProvider.Meta().(*ArmClient).EventGrid.EventSubscriptionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -193,7 +193,7 @@ func testCheckAzureRMEventGridEventSubscriptionExists(resourceName string) resou
 			return fmt.Errorf("Bad: no scope found in state for EventGrid Event Subscription: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).eventGrid.EventSubscriptionsClient
+		client := testAccProvider.Meta().(*ArmClient).EventGrid.EventSubscriptionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, name)
 		if err != nil {
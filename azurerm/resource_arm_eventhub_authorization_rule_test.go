@@ -132,7 +132,7 @@ func TestAccAzureRMEventHubAuthorizationRule_rightsUpdate(t *testing.T) {
 }
 
 func testCheckAzureRMEventHubAuthorizationRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).eventhub.EventHubsClient
+	conn := testAccProvider.Meta().(*ArmClient).Eventhub.EventHubsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -172,7 +172,7 @@ func testCheckAzureRMEventHubAuthorizationRuleExists(resourceName string) resour
 			return fmt.Errorf("Bad: no resource group found in state for Event Hub: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).eventhub.EventHubsClient
+		conn := testAccProvider.Meta().(*ArmClient).Eventhub.EventHubsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.GetAuthorizationRule(ctx, resourceGroup, namespaceName, eventHubName, name)
 		if err != nil {
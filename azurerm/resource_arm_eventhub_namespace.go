@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
@@ -15,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -32,6 +34,13 @@ func resourceArmEventHubNamespace() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -80,6 +89,8 @@ func resourceArmEventHubNamespace() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 20),
 			},
 
+			"network_rulesets": azure.SchemaEventHubNamespaceNetworkRuleSets(),
+
 			"default_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -110,8 +121,9 @@ func resourceArmEventHubNamespace() *schema.Resource {
 }
 
 func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventhub.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Eventhub.NamespacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Namespace creation.")
 
 	name := d.Get("name").(string)
@@ -121,7 +133,7 @@ func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing EventHub Namespace %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing EventHub Namespace %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -148,20 +160,32 @@ func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta inter
 			IsAutoInflateEnabled: utils.Bool(autoInflateEnabled),
 			KafkaEnabled:         utils.Bool(kafkaEnabled),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("maximum_throughput_units"); ok {
 		parameters.EHNamespaceProperties.MaximumThroughputUnits = utils.Int32(int32(v.(int)))
 	}
 
+	networkRuleSets, hasNetworkRuleSets := d.GetOk("network_rulesets")
+	if hasNetworkRuleSets && strings.EqualFold(sku, string(eventhub.Basic)) {
+		return fmt.Errorf("`network_rulesets` cannot be specified for EventHub SKU %q", string(eventhub.Basic))
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return err
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error creating eventhub namespace: %+v", err)
+		return fmt.Errorf("Error creating eventhub namespace: %+v", tf.WrapArmError(err))
+	}
+
+	if hasNetworkRuleSets && d.HasChange("network_rulesets") {
+		ruleSet := azure.ExpandEventHubNamespaceNetworkRuleSets(networkRuleSets.([]interface{}))
+		if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, resGroup, name, ruleSet); err != nil {
+			return fmt.Errorf("Error setting Network Rule Sets for EventHub Namespace %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -179,8 +203,9 @@ func resourceArmEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventhub.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Eventhub.NamespacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -195,7 +220,7 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on EventHub Namespace %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on EventHub Namespace %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -207,6 +232,22 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 	d.Set("sku", string(resp.Sku.Name))
 	d.Set("capacity", resp.Sku.Capacity)
 
+	// Network Rule Sets aren't supported on the Basic SKU
+	networkRuleSets := make([]interface{}, 0)
+	if resp.Sku.Tier != eventhub.SkuTierBasic {
+		ruleSet, err := client.GetNetworkRuleSet(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(ruleSet.Response) {
+				return fmt.Errorf("Error retrieving Network Rule Sets for EventHub Namespace %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
+			}
+		} else {
+			networkRuleSets = azure.FlattenEventHubNamespaceNetworkRuleSets(ruleSet.NetworkRuleSetProperties)
+		}
+	}
+	if err := d.Set("network_rulesets", networkRuleSets); err != nil {
+		return fmt.Errorf("Error setting `network_rulesets`: %+v", err)
+	}
+
 	keys, err := client.ListKeys(ctx, resGroup, name, eventHubNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] Unable to List default keys for EventHub Namespace %q: %+v", name, err)
@@ -223,14 +264,15 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("maximum_throughput_units", int(*props.MaximumThroughputUnits))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventhub.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Eventhub.NamespacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -244,7 +286,7 @@ func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("Error issuing delete request of EventHub Namespace %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error issuing delete request of EventHub Namespace %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	return waitForEventHubNamespaceToBeDeleted(ctx, client, resGroup, name)
@@ -260,7 +302,7 @@ func waitForEventHubNamespaceToBeDeleted(ctx context.Context, client *eventhub.N
 		Timeout: 40 * time.Minute,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for EventHub NameSpace (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for EventHub NameSpace (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -276,7 +318,7 @@ func eventHubNamespaceStateStatusCodeRefreshFunc(ctx context.Context, client *ev
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
-			return nil, "", fmt.Errorf("Error polling for the status of the EventHub Namespace %q (RG: %q): %+v", name, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error polling for the status of the EventHub Namespace %q (RG: %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
@@ -114,6 +114,51 @@ func TestAccAzureRMEventHubNamespace_standard(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMEventHubNamespace_networkRuleSets(t *testing.T) {
+	resourceName := "azurerm_eventhub_namespace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventHubNamespace_networkRuleSets(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.default_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.ip_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.ip_rule.0.ip_mask", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.0.ignore_missing_vnet_service_endpoint", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMEventHubNamespace_networkRuleSetsUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.default_action", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.ip_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.0.ignore_missing_vnet_service_endpoint", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMEventHubNamespace_readDefaultKeys(t *testing.T) {
 	resourceName := "azurerm_eventhub_namespace.test"
 	ri := tf.AccRandTimeInt()
@@ -347,7 +392,7 @@ func TestAccAzureRMEventHubNamespace_autoInfalteDisabledWithAutoInflateUnits(t *
 }
 
 func testCheckAzureRMEventHubNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).eventhub.NamespacesClient
+	conn := testAccProvider.Meta().(*ArmClient).Eventhub.NamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -384,7 +429,7 @@ func testCheckAzureRMEventHubNamespaceExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Event Hub Namespace: %s", namespaceName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).eventhub.NamespacesClient
+		conn := testAccProvider.Meta().(*ArmClient).Eventhub.NamespacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, namespaceName)
@@ -573,3 +618,78 @@ resource "azurerm_eventhub_namespace" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMEventHubNamespace_networkRuleSetsTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  service_endpoints    = ["Microsoft.EventHub"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMEventHubNamespace_networkRuleSets(rInt int, location string) string {
+	template := testAccAzureRMEventHubNamespace_networkRuleSetsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  capacity            = 1
+
+  network_rulesets {
+    default_action = "Deny"
+
+    ip_rule {
+      ip_mask = "10.0.0.0/16"
+    }
+
+    virtual_network_rule {
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMEventHubNamespace_networkRuleSetsUpdated(rInt int, location string) string {
+	template := testAccAzureRMEventHubNamespace_networkRuleSetsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  capacity            = 1
+
+  network_rulesets {
+    default_action = "Allow"
+
+    virtual_network_rule {
+      subnet_id                            = "${azurerm_subnet.test.id}"
+      ignore_missing_vnet_service_endpoint = true
+    }
+  }
+}
+`, template, rInt)
+}
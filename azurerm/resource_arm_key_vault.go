@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -18,6 +20,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	keyVaultSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -42,6 +46,13 @@ func resourceArmKeyVault() *schema.Resource {
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -143,6 +154,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -187,8 +208,9 @@ func resourceArmKeyVault() *schema.Resource {
 }
 
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// Remove in 2.0
 	var sku keyvault.Sku
@@ -222,7 +244,7 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Key Vault %q (Resource Group %q): %s", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Key Vault %q (Resource Group %q): %s", name, resourceGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -232,6 +254,22 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// Soft Delete and Purge Protection can be enabled but once enabled can't be disabled
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
+	if purgeProtectionEnabled && !softDeleteEnabled {
+		return fmt.Errorf("Error configuring Key Vault %q (Resource Group %q): `soft_delete_enabled` must be set to `true` when `purge_protection_enabled` is enabled", name, resourceGroup)
+	}
+	if !d.IsNewResource() {
+		if old, _ := d.GetChange("soft_delete_enabled"); old.(bool) && !softDeleteEnabled {
+			return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): once Soft Delete has been enabled it cannot be disabled", name, resourceGroup)
+		}
+		if old, _ := d.GetChange("purge_protection_enabled"); old.(bool) && !purgeProtectionEnabled {
+			return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): once Purge Protection has been enabled it cannot be disabled", name, resourceGroup)
+		}
+	}
+
 	tenantUUID := uuid.FromStringOrNil(d.Get("tenant_id").(string))
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
@@ -258,7 +296,39 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			EnabledForTemplateDeployment: &enabledForTemplateDeployment,
 			NetworkAcls:                  networkAcls,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
+	}
+
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
+	// a soft-deleted Key Vault with the same name blocks creating a new one, so either recover it or
+	// return an error explaining how to resolve this
+	recoverSoftDeletedKeyVault := false
+	if d.IsNewResource() {
+		deleted, err := client.GetDeleted(ctx, name, location)
+		if err != nil {
+			if !utils.ResponseWasNotFound(deleted.Response) {
+				return fmt.Errorf("Error checking for the presence of a soft-deleted Key Vault %q (Location %q): %+v", name, location, tf.WrapArmError(err))
+			}
+		}
+
+		if deleted.ID != nil && *deleted.ID != "" {
+			if !meta.(*ArmClient).Features.KeyVault.RecoverSoftDeletedKeyVaults {
+				return fmt.Errorf(`An existing soft-deleted Key Vault exists with the Name %q in the Location %q - however automatically recovering
+this Key Vault has been disabled via the "features" block in the Provider.
+
+Terraform can automatically recover the soft-deleted Key Vault when this behaviour is enabled - otherwise this
+Key Vault needs to be purged (where Purge Protection isn't enabled) before a new Key Vault with this name can be created.`, name, location)
+			}
+
+			log.Printf("[DEBUG] Found a soft-deleted Key Vault %q (Location %q) - recovering..", name, location)
+			recoverSoftDeletedKeyVault = true
+		}
 	}
 
 	// Locking this resource so we don't make modifications to it at the same time if there is a
@@ -283,13 +353,34 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	locks.MultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 	defer locks.UnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 
+	if recoverSoftDeletedKeyVault {
+		recoverParameters := keyvault.VaultCreateOrUpdateParameters{
+			Location: &location,
+			Properties: &keyvault.VaultProperties{
+				TenantID:   &tenantUUID,
+				Sku:        &sku,
+				CreateMode: keyvault.CreateModeRecover,
+			},
+		}
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, recoverParameters)
+		if err != nil {
+			return fmt.Errorf("Error recovering soft-deleted Key Vault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for recovery of soft-deleted Key Vault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	// when a Key Vault has been recovered, this then applies the configuration over the top
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
-		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 	if read.ID == nil {
 		return fmt.Errorf("Cannot read KeyVault %s (resource Group %q) ID", name, resourceGroup)
@@ -312,7 +403,7 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 				}
 
 				if _, err := stateConf.WaitForState(); err != nil {
-					return fmt.Errorf("Error waiting for Key Vault %q (Resource Group %q) to become available: %s", name, resourceGroup, err)
+					return fmt.Errorf("Error waiting for Key Vault %q (Resource Group %q) to become available: %s", name, resourceGroup, tf.WrapArmError(err))
 				}
 			}
 		}
@@ -322,8 +413,9 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -339,7 +431,7 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on KeyVault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on KeyVault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -354,6 +446,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
 		d.Set("vault_uri", props.VaultURI)
+		d.Set("soft_delete_enabled", props.EnableSoftDelete != nil && *props.EnableSoftDelete)
+		d.Set("purge_protection_enabled", props.EnablePurgeProtection != nil && *props.EnablePurgeProtection)
 
 		if sku := props.Sku; sku != nil {
 			// Remove in 2.0
@@ -378,13 +472,14 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 	return nil
 }
 
 func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -402,7 +497,7 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	// ensure we lock on the latest network names, to ensure we handle Azure's networking layer being limited to one change at a time
@@ -435,13 +530,94 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 		}
 	}
 
+	if !meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	props := read.Properties
+	if props == nil || props.EnableSoftDelete == nil || !*props.EnableSoftDelete {
+		return nil
+	}
+
+	if props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] Purge Protection is enabled for Key Vault %q (Resource Group %q) - skipping purging the soft-deleted Key Vault", name, resourceGroup)
+		return nil
+	}
+
+	if read.Location == nil {
+		return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): `location` was nil", name, resourceGroup)
+	}
+	location := azure.NormalizeLocation(*read.Location)
+
+	log.Printf("[DEBUG] Purging soft-deleted Key Vault %q (Location %q)..", name, location)
+	future, err := client.PurgeDeleted(ctx, name, location)
+	if err != nil {
+		return fmt.Errorf("Error purging soft-deleted Key Vault %q (Location %q): %+v", name, location, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted Key Vault %q (Location %q) to be purged: %+v", name, location, tf.WrapArmError(err))
+	}
+
 	return nil
 }
 
+// keyVaultRecoverSoftDeletedNestedItem recovers a soft-deleted Certificate, Key or Secret with the same name (if one
+// exists) - which otherwise blocks creating a new one - provided this behaviour is enabled in the `features` block.
+// This is only called once creating the item has returned a Conflict, to avoid checking for (and requiring permission
+// to list) soft-deleted items unnecessarily. Returns whether the item was recovered, in which case it can be updated.
+func keyVaultRecoverSoftDeletedNestedItem(ctx context.Context, meta interface{}, itemType keyVaultSvc.NestedItemType, keyVaultBaseUrl string, name string, timeout time.Duration) (bool, error) {
+	client := meta.(*ArmClient).KeyVault
+
+	exists, err := client.DeletedNestedItemExists(ctx, itemType, keyVaultBaseUrl, name)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+
+	if !meta.(*ArmClient).Features.KeyVault.RecoverSoftDeletedKeyVaults {
+		return false, fmt.Errorf(`An existing soft-deleted %s exists with the Name %q in the Key Vault %q - however automatically
+recovering this %s has been disabled via the "features" block in the Provider.
+
+Terraform can automatically recover the soft-deleted %s when this behaviour is enabled - otherwise this
+%s needs to be purged before a new %s with this name can be created.`, itemType, name, keyVaultBaseUrl, itemType, itemType, itemType, itemType)
+	}
+
+	if err := client.RecoverDeletedNestedItem(ctx, itemType, keyVaultBaseUrl, name, timeout); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// keyVaultRecoverSoftDeletedNestedItemOnConflict recovers a soft-deleted Certificate, Key or Secret when creating
+// (or importing/setting) the item returned a Conflict - returning whether the item was recovered, in which case
+// the request can be retried
+func keyVaultRecoverSoftDeletedNestedItemOnConflict(ctx context.Context, d *schema.ResourceData, meta interface{}, itemType keyVaultSvc.NestedItemType, resp autorest.Response, keyVaultBaseUrl string, name string) (bool, error) {
+	if !utils.ResponseWasStatusCode(resp, http.StatusConflict) {
+		return false, nil
+	}
+
+	return keyVaultRecoverSoftDeletedNestedItem(ctx, meta, itemType, keyVaultBaseUrl, name, d.Timeout(schema.TimeoutCreate))
+}
+
+// keyVaultPurgeSoftDeletedNestedItem purges a Certificate, Key or Secret which has been soft-deleted, provided this
+// behaviour is enabled in the `features` block - where Soft Delete isn't enabled the `recoveryId` returned when
+// deleting the item will be nil, as there's nothing to purge
+func keyVaultPurgeSoftDeletedNestedItem(ctx context.Context, meta interface{}, itemType keyVaultSvc.NestedItemType, keyVaultBaseUrl string, name string, recoveryId *string, timeout time.Duration) error {
+	if !meta.(*ArmClient).Features.KeyVault.PurgeSoftDeleteOnDestroy || recoveryId == nil {
+		return nil
+	}
+
+	return meta.(*ArmClient).KeyVault.PurgeDeletedNestedItem(ctx, itemType, keyVaultBaseUrl, name, timeout)
+}
+
 // Remove in 2.0
 func flattenKeyVaultSku(sku *keyvault.Sku) []interface{} {
 	result := map[string]interface{}{
@@ -510,7 +686,7 @@ func keyVaultRefreshFunc(vaultUri string) resource.StateRefreshFunc {
 		conn, err := client.Get(vaultUri)
 		if err != nil {
 			log.Printf("[DEBUG] Didn't find KeyVault at %q", vaultUri)
-			return nil, "pending", fmt.Errorf("Error connecting to %q: %s", vaultUri, err)
+			return nil, "pending", fmt.Errorf("Error connecting to %q: %s", vaultUri, tf.WrapArmError(err))
 		}
 
 		defer conn.Body.Close()
@@ -194,8 +194,41 @@ func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultSecret_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_softDelete(rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+			{
+				// removing the Secret leaves it in a soft-deleted state
+				Config: testAccAzureRMKeyVaultSecret_softDelete(rs, location, true),
+			},
+			{
+				// and then re-adding it recovers the soft-deleted Secret
+				Config: testAccAzureRMKeyVaultSecret_softDelete(rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyvault.ManagementClient
+	client := testAccProvider.Meta().(*ArmClient).KeyVault.ManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -207,7 +240,7 @@ func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
 		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
 		keyVaultId := rs.Primary.Attributes["key_vault_id"]
 
-		ok, err := azure.KeyVaultExists(ctx, testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient, keyVaultId)
+		ok, err := azure.KeyVaultExists(ctx, testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient, keyVaultId)
 		if err != nil {
 			return fmt.Errorf("Error checking if key vault %q for Secret %q in Vault at url %q exists: %v", keyVaultId, name, vaultBaseUrl, err)
 		}
@@ -233,7 +266,7 @@ func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
 
 func testCheckAzureRMKeyVaultSecretExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).keyvault.ManagementClient
+		client := testAccProvider.Meta().(*ArmClient).KeyVault.ManagementClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
@@ -245,7 +278,7 @@ func testCheckAzureRMKeyVaultSecretExists(resourceName string) resource.TestChec
 		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
 		keyVaultId := rs.Primary.Attributes["key_vault_id"]
 
-		ok, err := azure.KeyVaultExists(ctx, testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient, keyVaultId)
+		ok, err := azure.KeyVaultExists(ctx, testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient, keyVaultId)
 		if err != nil {
 			return fmt.Errorf("Error checking if key vault %q for Secret %q in Vault at url %q exists: %v", keyVaultId, name, vaultBaseUrl, err)
 		}
@@ -269,7 +302,7 @@ func testCheckAzureRMKeyVaultSecretExists(resourceName string) resource.TestChec
 
 func testCheckAzureRMKeyVaultSecretDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).keyvault.ManagementClient
+		client := testAccProvider.Meta().(*ArmClient).KeyVault.ManagementClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		// Ensure we have enough information in state to look up in API
@@ -281,7 +314,7 @@ func testCheckAzureRMKeyVaultSecretDisappears(resourceName string) resource.Test
 		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
 		keyVaultId := rs.Primary.Attributes["key_vault_id"]
 
-		ok, err := azure.KeyVaultExists(ctx, testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient, keyVaultId)
+		ok, err := azure.KeyVaultExists(ctx, testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient, keyVaultId)
 		if err != nil {
 			return fmt.Errorf("Error checking if key vault %q for Secret %q in Vault at url %q exists: %v", keyVaultId, name, vaultBaseUrl, err)
 		}
@@ -500,3 +533,57 @@ resource "azurerm_key_vault_secret" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultSecret_softDelete(rString string, location string, secretAbsent bool) string {
+	secret := `
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-` + rString + `"
+  value        = "rick-and-morty"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+}
+`
+	if secretAbsent {
+		secret = ""
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      recover_soft_deleted_key_vaults = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name            = "premium"
+  soft_delete_enabled = true
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    secret_permissions = [
+      "delete",
+      "get",
+      "purge",
+      "recover",
+      "set",
+    ]
+  }
+}
+
+%s
+`, rString, location, rString, secret)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -360,8 +361,128 @@ func TestAccAzureRMKeyVault_justCert(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_softDelete(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAzureRMKeyVault_basic(ri, location),
+				ExpectError: regexp.MustCompile("once Soft Delete has been enabled it cannot be disabled"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_softDeleteRecovery(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+			{
+				// removing the Key Vault leaves it in a soft-deleted state
+				Config: testAccAzureRMKeyVault_softDeleteAbsent(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSoftDeleted(fmt.Sprintf("vault%d", ri), location, true),
+				),
+			},
+			{
+				// and then re-adding it recovers the soft-deleted Key Vault
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_softDeletePurgeOnDestroy(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVault_softDeleteAbsent(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSoftDeleted(fmt.Sprintf("vault%d", ri), location, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_purgeProtectionEnabled(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_purgeProtection(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient
+	client := testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -400,7 +521,7 @@ func testCheckAzureRMKeyVaultExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for vault: %s", vaultName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient
+		client := testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vaultName)
@@ -416,6 +537,32 @@ func testCheckAzureRMKeyVaultExists(resourceName string) resource.TestCheckFunc
 	}
 }
 
+func testCheckAzureRMKeyVaultSoftDeleted(name string, location string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetDeleted(ctx, name, azure.NormalizeLocation(location))
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on keyVaultClient.GetDeleted: %+v", err)
+			}
+
+			if shouldExist {
+				return fmt.Errorf("Bad: Soft-Deleted Key Vault %q (Location %q) does not exist", name, location)
+			}
+
+			return nil
+		}
+
+		if !shouldExist {
+			return fmt.Errorf("Bad: Soft-Deleted Key Vault %q (Location %q) still exists", name, location)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMKeyVaultDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -430,7 +577,7 @@ func testCheckAzureRMKeyVaultDisappears(resourceName string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for vault: %s", vaultName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).keyvault.VaultsClient
+		client := testAccProvider.Meta().(*ArmClient).KeyVault.VaultsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Delete(ctx, resourceGroup, vaultName)
@@ -917,3 +1064,72 @@ access_policy {
 }
 `, accountNum)
 }
+
+func testAccAzureRMKeyVault_softDeleteProvider(purgeOnDestroy bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy    = %t
+      recover_soft_deleted_key_vaults = true
+    }
+  }
+}
+`, purgeOnDestroy)
+}
+
+func testAccAzureRMKeyVault_softDelete(rInt int, location string, purgeOnDestroy bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name            = "premium"
+  soft_delete_enabled = true
+}
+`, testAccAzureRMKeyVault_softDeleteProvider(purgeOnDestroy), rInt, location, rInt)
+}
+
+func testAccAzureRMKeyVault_softDeleteAbsent(rInt int, location string, purgeOnDestroy bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, testAccAzureRMKeyVault_softDeleteProvider(purgeOnDestroy), rInt, location)
+}
+
+func testAccAzureRMKeyVault_purgeProtection(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "vault%d"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name                 = "premium"
+  soft_delete_enabled      = true
+  purge_protection_enabled = true
+}
+`, rInt, location, rInt)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -15,9 +16,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var kubernetesClusterResourceName = "azurerm_kubernetes_cluster"
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmKubernetesClusterCreateUpdate,
@@ -63,6 +69,13 @@ func resourceArmKubernetesCluster() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -607,9 +620,10 @@ func resourceArmKubernetesCluster() *schema.Resource {
 }
 
 func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
-	tenantId := meta.(*ArmClient).tenantId
+	client := meta.(*ArmClient).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	tenantId := meta.(*ArmClient).TenantID
 
 	log.Printf("[INFO] preparing arguments for Managed Kubernetes Cluster create/update.")
 
@@ -620,7 +634,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -629,6 +643,9 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
+	locks.ByName(name, kubernetesClusterResourceName)
+	defer locks.UnlockByName(name, kubernetesClusterResourceName)
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	dnsPrefix := d.Get("dns_prefix").(string)
 	kubernetesVersion := d.Get("kubernetes_version").(string)
@@ -638,6 +655,20 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
+
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
+		}
+
+		// Node Pools managed outside of this resource (e.g. by `azurerm_kubernetes_cluster_node_pool`) need to be
+		// sent back as-is, otherwise updating the cluster would attempt to remove them
+		if props := existing.ManagedClusterProperties; props != nil {
+			managed := kubernetesClusterManagedAgentPoolNames(d)
+			agentProfiles = append(agentProfiles, filterKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, managed, false)...)
+		}
+	}
 	windowsProfile := expandKubernetesClusterWindowsProfile(d)
 	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
 	networkProfile := expandKubernetesClusterNetworkProfile(d)
@@ -673,21 +704,21 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 			NodeResourceGroup:           utils.String(nodeResourceGroup),
 			EnablePodSecurityPolicy:     utils.Bool(enablePodSecurityPolicy),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -700,15 +731,16 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := resourceid.ParseKubernetesClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -718,12 +750,12 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	profile, err := client.GetAccessProfile(ctx, resGroup, name, "clusterUser")
 	if err != nil {
-		return fmt.Errorf("Error retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -749,7 +781,13 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, resp.Fqdn)
+		// when importing there's nothing in the state to filter on, so every Node Pool is returned
+		agentPools := props.AgentPoolProfiles
+		if managed := kubernetesClusterManagedAgentPoolNames(d); len(managed) > 0 {
+			filtered := filterKubernetesClusterAgentPoolProfiles(agentPools, managed, true)
+			agentPools = &filtered
+		}
+		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(agentPools, resp.Fqdn)
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
@@ -783,7 +821,7 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		if props.AadProfile != nil {
 			adminProfile, err := client.GetAccessProfile(ctx, resGroup, name, "clusterAdmin")
 			if err != nil {
-				return fmt.Errorf("Error retrieving Admin Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+				return fmt.Errorf("Error retrieving Admin Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 			}
 
 			adminKubeConfigRaw, adminKubeConfig := flattenKubernetesClusterAccessProfile(adminProfile)
@@ -803,29 +841,30 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := resourceid.ParseKubernetesClusterID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["managedClusters"]
+	name := id.Name
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error deleting Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the deletion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("Error waiting for the deletion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -1047,6 +1086,47 @@ func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) ([]contain
 	return profiles, nil
 }
 
+// kubernetesClusterManagedAgentPoolNames returns the (lower-cased) names of the Node Pools managed by the
+// `agent_pool_profile` block - which are those either in the state or in the configuration
+func kubernetesClusterManagedAgentPoolNames(d *schema.ResourceData) map[string]bool {
+	names := make(map[string]bool)
+
+	old, new := d.GetChange("agent_pool_profile")
+	for _, raw := range append(old.([]interface{}), new.([]interface{})...) {
+		config, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name := config["name"].(string); name != "" {
+			names[strings.ToLower(name)] = true
+		}
+	}
+
+	return names
+}
+
+// filterKubernetesClusterAgentPoolProfiles returns the Node Pools which either are (when `managed` is true)
+// or aren't (when `managed` is false) present in the specified set of names
+func filterKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, names map[string]bool, managed bool) []containerservice.ManagedClusterAgentPoolProfile {
+	results := make([]containerservice.ManagedClusterAgentPoolProfile, 0)
+	if profiles == nil {
+		return results
+	}
+
+	for _, profile := range *profiles {
+		if profile.Name == nil {
+			continue
+		}
+
+		if names[strings.ToLower(*profile.Name)] == managed {
+			results = append(results, profile)
+		}
+	}
+
+	return results
+}
+
 func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string) []interface{} {
 	if profiles == nil {
 		return []interface{}{}
@@ -0,0 +1,386 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKubernetesClusterNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKubernetesClusterNodePoolCreateUpdate,
+		Read:   resourceArmKubernetesClusterNodePoolRead,
+		Update: resourceArmKubernetesClusterNodePoolCreateUpdate,
+		Delete: resourceArmKubernetesClusterNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesAgentPoolName,
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateKubernetesClusterID,
+			},
+
+			"vm_size": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"enable_auto_scaling": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"min_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"node_taints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"max_pods": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"os_disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containerservice.Linux),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.Linux),
+					string(containerservice.Windows),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"vnet_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmKubernetesClusterNodePoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Kubernetes Cluster Node Pool create/update.")
+
+	clusterId, err := resourceid.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := clusterId.ResourceGroup
+	clusterName := clusterId.Name
+	name := d.Get("name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, clusterName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_kubernetes_cluster_node_pool", *existing.ID)
+		}
+	}
+
+	profile := containerservice.ManagedClusterAgentPoolProfileProperties{
+		// additional Node Pools can only be added to clusters backed by Virtual Machine Scale Sets
+		Type:   containerservice.VirtualMachineScaleSets,
+		VMSize: containerservice.VMSizeTypes(d.Get("vm_size").(string)),
+		OsType: containerservice.OSType(d.Get("os_type").(string)),
+	}
+
+	enableAutoScaling := d.Get("enable_auto_scaling").(bool)
+	profile.EnableAutoScaling = utils.Bool(enableAutoScaling)
+
+	if maxCount := d.Get("max_count").(int); maxCount > 0 {
+		profile.MaxCount = utils.Int32(int32(maxCount))
+	}
+
+	if minCount := d.Get("min_count").(int); minCount > 0 {
+		profile.MinCount = utils.Int32(int32(minCount))
+	}
+
+	if enableAutoScaling {
+		if profile.MinCount == nil || profile.MaxCount == nil {
+			return fmt.Errorf("`min_count` and `max_count` must be set when `enable_auto_scaling` is enabled")
+		}
+
+		if *profile.MinCount > *profile.MaxCount {
+			return fmt.Errorf("`min_count` must be less than or equal to `max_count`")
+		}
+	} else if profile.MinCount != nil || profile.MaxCount != nil {
+		return fmt.Errorf("`min_count` and `max_count` can only be set when `enable_auto_scaling` is enabled")
+	}
+
+	// the Count is required by the API - however once the auto-scaler is enabled it manages the number of nodes,
+	// so when updating the current number of nodes is sent rather than the original `node_count`
+	nodeCount, hasNodeCount := d.GetOkExists("node_count")
+	count := int32(1)
+	switch {
+	case enableAutoScaling && !d.IsNewResource():
+		if hasNodeCount {
+			count = int32(nodeCount.(int))
+		}
+
+		existing, err := client.Get(ctx, resGroup, clusterName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, tf.WrapArmError(err))
+		}
+		if props := existing.ManagedClusterAgentPoolProfileProperties; props != nil && props.Count != nil {
+			count = *props.Count
+		}
+
+		// the range may have been changed in this update
+		if count < *profile.MinCount {
+			count = *profile.MinCount
+		}
+		if count > *profile.MaxCount {
+			count = *profile.MaxCount
+		}
+
+	case hasNodeCount:
+		count = int32(nodeCount.(int))
+
+	case profile.MinCount != nil:
+		count = *profile.MinCount
+	}
+	profile.Count = utils.Int32(count)
+
+	if availabilityZones := utils.ExpandStringSlice(d.Get("availability_zones").([]interface{})); len(*availabilityZones) > 0 {
+		profile.AvailabilityZones = availabilityZones
+	}
+
+	if nodeTaints := utils.ExpandStringSlice(d.Get("node_taints").([]interface{})); len(*nodeTaints) > 0 {
+		profile.NodeTaints = nodeTaints
+	}
+
+	if maxPods := d.Get("max_pods").(int); maxPods > 0 {
+		profile.MaxPods = utils.Int32(int32(maxPods))
+	}
+
+	if osDiskSizeGB := d.Get("os_disk_size_gb").(int); osDiskSizeGB > 0 {
+		profile.OsDiskSizeGB = utils.Int32(int32(osDiskSizeGB))
+	}
+
+	if vnetSubnetID := d.Get("vnet_subnet_id").(string); vnetSubnetID != "" {
+		profile.VnetSubnetID = utils.String(vnetSubnetID)
+	}
+
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(name),
+		ManagedClusterAgentPoolProfileProperties: &profile,
+	}
+
+	// the Node Pools within a cluster can only be modified one at a time
+	locks.ByName(clusterName, kubernetesClusterResourceName)
+	defer locks.UnlockByName(clusterName, kubernetesClusterResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, clusterName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, tf.WrapArmError(err))
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Node Pool %q (Kubernetes Cluster %q / Resource Group %q)", name, clusterName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Node Pool %q was not found in Kubernetes Cluster %q / Resource Group %q - removing from state!", id.Name, id.ClusterName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ClusterName, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	clusterId := resourceid.KubernetesClusterID{
+		SubscriptionId: id.SubscriptionId,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.ClusterName,
+	}
+
+	d.Set("name", id.Name)
+	d.Set("kubernetes_cluster_id", clusterId.String())
+
+	if props := resp.ManagedClusterAgentPoolProfileProperties; props != nil {
+		d.Set("vm_size", string(props.VMSize))
+		d.Set("os_type", string(props.OsType))
+		d.Set("enable_auto_scaling", props.EnableAutoScaling)
+		d.Set("vnet_subnet_id", props.VnetSubnetID)
+
+		if props.Count != nil {
+			d.Set("node_count", int(*props.Count))
+		}
+
+		minCount := 0
+		if props.MinCount != nil {
+			minCount = int(*props.MinCount)
+		}
+		d.Set("min_count", minCount)
+
+		maxCount := 0
+		if props.MaxCount != nil {
+			maxCount = int(*props.MaxCount)
+		}
+		d.Set("max_count", maxCount)
+
+		if props.MaxPods != nil {
+			d.Set("max_pods", int(*props.MaxPods))
+		}
+
+		if props.OsDiskSizeGB != nil {
+			d.Set("os_disk_size_gb", int(*props.OsDiskSizeGB))
+		}
+
+		if err := d.Set("availability_zones", utils.FlattenStringSlice(props.AvailabilityZones)); err != nil {
+			return fmt.Errorf("Error setting `availability_zones`: %+v", err)
+		}
+
+		if err := d.Set("node_taints", utils.FlattenStringSlice(props.NodeTaints)); err != nil {
+			return fmt.Errorf("Error setting `node_taints`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Containers.AgentPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.ClusterName, kubernetesClusterResourceName)
+	defer locks.UnlockByName(id.ClusterName, kubernetesClusterResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ClusterName, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ClusterName, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,422 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+func TestAccAzureRMKubernetesClusterNodePool_basic(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, testLocation(), 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+					resource.TestCheckResourceAttrSet(resourceName, "max_pods"),
+					// the pool managed by the node pool resource shouldn't show up within the cluster
+					resource.TestCheckResourceAttr("azurerm_kubernetes_cluster.test", "agent_pool_profile.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMKubernetesClusterNodePool_requiresImport(ri, clientId, clientSecret, location),
+				ExpectError: testRequiresImportError("azurerm_kubernetes_cluster_node_pool"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_manualScaleUpdate(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "3"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_autoScale(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_autoScale(ri, clientId, clientSecret, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the current number of nodes is below the new `min_count`, so should be increased
+				Config: testAccAzureRMKubernetesClusterNodePool_autoScaleUpdated(ri, clientId, clientSecret, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "4"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "false"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_availabilityZonesAndTaints(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesClusterNodePool_availabilityZonesAndTaints(ri, clientId, clientSecret, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "node_taints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "node_taints.0", "key=value:NoSchedule"),
+					resource.TestCheckResourceAttr(resourceName, "max_pods", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_clusterUpdate(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	clusterResourceName := "azurerm_kubernetes_cluster.test"
+	ri := tf.AccRandTimeInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_manualScale(ri, clientId, clientSecret, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+				),
+			},
+			{
+				// updating the cluster mustn't remove the externally managed Node Pool
+				Config: testAccAzureRMKubernetesClusterNodePool_clusterUpdate(ri, clientId, clientSecret, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(clusterResourceName, "agent_pool_profile.#", "1"),
+					resource.TestCheckResourceAttr(clusterResourceName, "agent_pool_profile.0.count", "2"),
+					resource.TestCheckResourceAttr(clusterResourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKubernetesClusterNodePoolExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseKubernetesClusterNodePoolID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Containers.AgentPoolsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Node Pool %q (Kubernetes Cluster %q / Resource Group: %q) does not exist", id.Name, id.ClusterName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on agentPoolsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKubernetesClusterNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Containers.AgentPoolsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_kubernetes_cluster_node_pool" {
+			continue
+		}
+
+		id, err := resourceid.ParseKubernetesClusterNodePoolID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Node Pool %q (Kubernetes Cluster %q / Resource Group %q) still exists", id.Name, id.ClusterName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMKubernetesClusterNodePool_template(rInt int, clientId, clientSecret, location string, clusterCount int, clusterTags string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    type    = "VirtualMachineScaleSets"
+    count   = %d
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  network_profile {
+    network_plugin    = "kubenet"
+    load_balancer_sku = "standard"
+  }
+%s
+}
+`, rInt, location, rInt, rInt, clusterCount, clientId, clientSecret, clusterTags)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_manualScale(rInt int, clientId, clientSecret, location string, nodeCount int) string {
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location, 1, "")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = %d
+}
+`, template, nodeCount)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_requiresImport(rInt int, clientId, clientSecret, location string) string {
+	template := testAccAzureRMKubernetesClusterNodePool_manualScale(rInt, clientId, clientSecret, location, 1)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "import" {
+  name                  = "${azurerm_kubernetes_cluster_node_pool.test.name}"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster_node_pool.test.kubernetes_cluster_id}"
+  vm_size               = "${azurerm_kubernetes_cluster_node_pool.test.vm_size}"
+  node_count            = "${azurerm_kubernetes_cluster_node_pool.test.node_count}"
+}
+`, template)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_autoScale(rInt int, clientId, clientSecret, location string) string {
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location, 1, "")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  enable_auto_scaling   = true
+  min_count             = 1
+  max_count             = 3
+}
+`, template)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_autoScaleUpdated(rInt int, clientId, clientSecret, location string) string {
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location, 1, "")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  enable_auto_scaling   = true
+  min_count             = 2
+  max_count             = 4
+}
+`, template)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_availabilityZonesAndTaints(rInt int, clientId, clientSecret, location string) string {
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location, 1, "")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 2
+  availability_zones    = ["1", "2"]
+  node_taints           = ["key=value:NoSchedule"]
+  max_pods              = 60
+}
+`, template)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_clusterUpdate(rInt int, clientId, clientSecret, location string) string {
+	tags := `
+  tags = {
+    environment = "Staging"
+  }
+`
+	template := testAccAzureRMKubernetesClusterNodePool_template(rInt, clientId, clientSecret, location, 2, tags)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+}
+`, template)
+}
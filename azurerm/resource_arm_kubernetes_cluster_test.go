@@ -6,9 +6,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
@@ -894,7 +896,7 @@ func testCheckAzureRMKubernetesClusterExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Managed Kubernetes Cluster: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).containers.KubernetesClustersClient
+		client := testAccProvider.Meta().(*ArmClient).Containers.KubernetesClustersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		aks, err := client.Get(ctx, resourceGroup, name)
@@ -911,7 +913,7 @@ func testCheckAzureRMKubernetesClusterExists(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMKubernetesClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containers.KubernetesClustersClient
+	conn := testAccProvider.Meta().(*ArmClient).Containers.KubernetesClustersClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_kubernetes_cluster" {
@@ -2156,3 +2158,28 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, rInt, location, rInt, rInt, clientId, clientSecret)
 }
+
+func TestFilterKubernetesClusterAgentPoolProfiles(t *testing.T) {
+	profiles := &[]containerservice.ManagedClusterAgentPoolProfile{
+		{Name: utils.String("default")},
+		{Name: utils.String("Internal")},
+		{Name: nil},
+	}
+	names := map[string]bool{
+		"default": true,
+	}
+
+	managed := filterKubernetesClusterAgentPoolProfiles(profiles, names, true)
+	if len(managed) != 1 || *managed[0].Name != "default" {
+		t.Fatalf("Expected only the `default` Node Pool to be managed but got %+v", managed)
+	}
+
+	external := filterKubernetesClusterAgentPoolProfiles(profiles, names, false)
+	if len(external) != 1 || *external[0].Name != "Internal" {
+		t.Fatalf("Expected only the `Internal` Node Pool to be external but got %+v", external)
+	}
+
+	if actual := filterKubernetesClusterAgentPoolProfiles(nil, names, true); len(actual) != 0 {
+		t.Fatalf("Expected no Node Pools but got %+v", actual)
+	}
+}
@@ -226,7 +226,7 @@ func testCheckAzureRMLoadBalancerOutboundRuleNotExists(outboundRuleName string,
 
 func testCheckAzureRMLoadBalancerOutboundRuleDisappears(ruleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).network.LoadBalancersClient
+		client := testAccProvider.Meta().(*ArmClient).Network.LoadBalancersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		_, i, exists := findLoadBalancerOutboundRuleByName(lb, ruleName)
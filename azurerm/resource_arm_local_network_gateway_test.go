@@ -263,7 +263,7 @@ func testCheckAzureRMLocalNetworkGatewayExists(resourceName string) resource.Tes
 		resGrp := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
-		client := testAccProvider.Meta().(*ArmClient).network.LocalNetworkGatewaysClient
+		client := testAccProvider.Meta().(*ArmClient).Network.LocalNetworkGatewaysClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resGrp, localNetName)
@@ -296,7 +296,7 @@ func testCheckAzureRMLocalNetworkGatewayDisappears(resourceName string) resource
 		resourceGroup := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
-		client := testAccProvider.Meta().(*ArmClient).network.LocalNetworkGatewaysClient
+		client := testAccProvider.Meta().(*ArmClient).Network.LocalNetworkGatewaysClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, localNetName)
@@ -328,7 +328,7 @@ func testCheckAzureRMLocalNetworkGatewayDestroy(s *terraform.State) error {
 		localNetName := id.Path["localNetworkGateways"]
 		resourceGroup := id.ResourceGroup
 
-		client := testAccProvider.Meta().(*ArmClient).network.LocalNetworkGatewaysClient
+		client := testAccProvider.Meta().(*ArmClient).Network.LocalNetworkGatewaysClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, localNetName)
 
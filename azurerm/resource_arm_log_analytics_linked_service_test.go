@@ -95,7 +95,7 @@ func TestAccAzureRMLogAnalyticsLinkedService_complete(t *testing.T) {
 }
 
 func testCheckAzureRMLogAnalyticsLinkedServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).logAnalytics.LinkedServicesClient
+	conn := testAccProvider.Meta().(*ArmClient).LogAnalytics.LinkedServicesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -140,7 +140,7 @@ func testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName string) resour
 			return fmt.Errorf("Bad: no resource group found in state for Log Analytics Linked Service: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).logAnalytics.LinkedServicesClient
+		conn := testAccProvider.Meta().(*ArmClient).LogAnalytics.LinkedServicesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, workspaceName, lsName)
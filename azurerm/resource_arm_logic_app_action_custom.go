@@ -1,15 +1,17 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
-
-	"encoding/json"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmLogicAppActionCustom() *schema.Resource {
@@ -22,6 +24,13 @@ func resourceArmLogicAppActionCustom() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -64,6 +73,9 @@ func resourceArmLogicAppActionCustomCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -73,7 +85,7 @@ func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{
 	logicAppName := id.Path["workflows"]
 	name := id.Path["actions"]
 
-	t, app, err := retrieveLogicAppAction(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppAction(ctx, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -91,7 +103,7 @@ func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{
 
 	body, err := json.Marshal(action)
 	if err != nil {
-		return fmt.Errorf("Error serializing `body` for Action %q: %+v", name, err)
+		return fmt.Errorf("Error serializing `body` for Action %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	if err := d.Set("body", string(body)); err != nil {
@@ -113,7 +125,7 @@ func resourceArmLogicAppActionCustomDelete(d *schema.ResourceData, meta interfac
 
 	err = resourceLogicAppActionRemove(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
-		return fmt.Errorf("Error removing Action %q from Logic App %q (Resource Group %q): %+v", name, logicAppName, resourceGroup, err)
+		return fmt.Errorf("Error removing Action %q from Logic App %q (Resource Group %q): %+v", name, logicAppName, resourceGroup, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +27,13 @@ func resourceArmManagementGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:     schema.TypeString,
@@ -56,10 +65,11 @@ func resourceArmManagementGroup() *schema.Resource {
 }
 
 func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementGroups.GroupsClient
-	subscriptionsClient := meta.(*ArmClient).managementGroups.SubscriptionClient
-	ctx := meta.(*ArmClient).StopContext
-	armTenantID := meta.(*ArmClient).tenantId
+	client := meta.(*ArmClient).ManagementGroups.GroupsClient
+	subscriptionsClient := meta.(*ArmClient).ManagementGroups.SubscriptionClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	armTenantID := meta.(*ArmClient).TenantID
 
 	groupId := d.Get("group_id").(string)
 	if groupId == "" {
@@ -76,7 +86,7 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 		existing, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Management Group %q: %s", groupId, err)
+				return fmt.Errorf("Error checking for presence of existing Management Group %q: %s", groupId, tf.WrapArmError(err))
 			}
 		}
 
@@ -105,16 +115,16 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 
 	future, err := client.CreateOrUpdate(ctx, groupId, properties, managementGroupCacheControl)
 	if err != nil {
-		return fmt.Errorf("Error creating Management Group %q: %+v", groupId, err)
+		return fmt.Errorf("Error creating Management Group %q: %+v", groupId, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Management Group %q: %+v", groupId, err)
+		return fmt.Errorf("Error waiting for creation of Management Group %q: %+v", groupId, tf.WrapArmError(err))
 	}
 
 	resp, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Management Group %q: %+v", groupId, err)
+		return fmt.Errorf("Error retrieving Management Group %q: %+v", groupId, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -156,8 +166,9 @@ func resourceArmManagementGroupCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementGroups.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseManagementGroupId(d.Id())
 	if err != nil {
@@ -173,7 +184,7 @@ func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error reading Management Group %q: %+v", d.Id(), tf.WrapArmError(err))
 	}
 
 	d.Set("group_id", id.groupId)
@@ -203,9 +214,10 @@ func resourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementGroups.GroupsClient
-	subscriptionsClient := meta.(*ArmClient).managementGroups.SubscriptionClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).ManagementGroups.GroupsClient
+	subscriptionsClient := meta.(*ArmClient).ManagementGroups.SubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseManagementGroupId(d.Id())
 	if err != nil {
@@ -220,7 +232,7 @@ func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Management Group %q: %+v", id.groupId, err)
+		return fmt.Errorf("Error retrieving Management Group %q: %+v", id.groupId, tf.WrapArmError(err))
 	}
 
 	// before deleting a management group, return any subscriptions to the root management group
@@ -246,12 +258,12 @@ func resourceArmManagementGroupDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := client.Delete(ctx, id.groupId, managementGroupCacheControl)
 	if err != nil {
-		return fmt.Errorf("Error deleting Management Group %q: %+v", id.groupId, err)
+		return fmt.Errorf("Error deleting Management Group %q: %+v", id.groupId, tf.WrapArmError(err))
 	}
 
 	err = resp.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
-		return fmt.Errorf("Error waiting for the deletion of Management Group %q: %+v", id.groupId, err)
+		return fmt.Errorf("Error waiting for the deletion of Management Group %q: %+v", id.groupId, tf.WrapArmError(err))
 	}
 
 	return nil
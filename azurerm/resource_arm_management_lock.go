@@ -5,11 +5,13 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,6 +24,12 @@ func resourceArmManagementLock() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -57,8 +65,9 @@ func resourceArmManagementLock() *schema.Resource {
 }
 
 func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resource.LocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Resource.LocksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Management Lock creation.")
 
 	name := d.Get("name").(string)
@@ -68,7 +77,7 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.GetByScope(ctx, scope, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Management Lock %q (Scope %q): %s", name, scope, err)
+				return fmt.Errorf("Error checking for presence of existing Management Lock %q (Scope %q): %s", name, scope, tf.WrapArmError(err))
 			}
 		}
 
@@ -105,8 +114,9 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resource.LocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Resource.LocksClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -119,7 +129,7 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
+		return fmt.Errorf("Error making Read request on AzureRM Management Lock %q (Scope %q): %+v", id.Name, id.Scope, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -134,8 +144,9 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resource.LocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Resource.LocksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -148,7 +159,7 @@ func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error issuing AzureRM delete request for Management Lock %q (Scope %q): %+v", id.Name, id.Scope, err)
+		return fmt.Errorf("Error issuing AzureRM delete request for Management Lock %q (Scope %q): %+v", id.Name, id.Scope, tf.WrapArmError(err))
 	}
 
 	return nil
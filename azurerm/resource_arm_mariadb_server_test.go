@@ -277,7 +277,7 @@ func testCheckAzureRMMariaDbServerExists(resourceName string) resource.TestCheck
 			return fmt.Errorf("Bad: no resource group found in state for MariaDB Server: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mariadb.ServersClient
+		client := testAccProvider.Meta().(*ArmClient).MariaDB.ServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -294,7 +294,7 @@ func testCheckAzureRMMariaDbServerExists(resourceName string) resource.TestCheck
 }
 
 func testCheckAzureRMMariaDbServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mariadb.ServersClient
+	client := testAccProvider.Meta().(*ArmClient).MariaDB.ServersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +28,13 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -87,15 +96,16 @@ func resourceArmMonitorLogProfile() *schema.Resource {
 }
 
 func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Monitor.LogProfilesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Monitor Log Profile %q: %s", name, err)
+				return fmt.Errorf("Error checking for presence of existing Monitor Log Profile %q: %s", name, tf.WrapArmError(err))
 			}
 		}
 
@@ -130,17 +140,17 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
-		return fmt.Errorf("Error Creating/Updating Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error Creating/Updating Log Profile %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	// Wait for Log Profile to become available
-	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(name, meta)); err != nil {
-		return fmt.Errorf("Error waiting for Log Profile %q to become available: %+v", name, err)
+	if err := resource.Retry(600*time.Second, retryLogProfilesClientGet(ctx, name, meta)); err != nil {
+		return fmt.Errorf("Error waiting for Log Profile %q to become available: %+v", name, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error retrieving Log Profile %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*read.ID)
@@ -149,8 +159,9 @@ func resourceArmLogProfileCreateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Monitor.LogProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -164,7 +175,7 @@ func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure Log Profile %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -186,8 +197,9 @@ func resourceArmLogProfileRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmLogProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).monitor.LogProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Monitor.LogProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parseLogProfileNameFromID(d.Id())
 	if err != nil {
@@ -196,7 +208,7 @@ func resourceArmLogProfileDelete(d *schema.ResourceData, meta interface{}) error
 
 	_, err = client.Delete(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Log Profile %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Log Profile %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -266,10 +278,9 @@ func flattenAzureRmLogProfileRetentionPolicy(input *insights.RetentionPolicy) []
 	return []interface{}{result}
 }
 
-func retryLogProfilesClientGet(name string, meta interface{}) func() *resource.RetryError {
+func retryLogProfilesClientGet(ctx context.Context, name string, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		client := meta.(*ArmClient).monitor.LogProfilesClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).Monitor.LogProfilesClient
 
 		if _, err := client.Get(ctx, name); err != nil {
 			return resource.RetryableError(err)
@@ -77,7 +77,7 @@ func testCheckAzureRMMySQLFirewallRuleExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for MySQL Firewall Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mysql.FirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).MySQL.FirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -93,7 +93,7 @@ func testCheckAzureRMMySQLFirewallRuleExists(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMMySQLFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mysql.DatabasesClient
+	client := testAccProvider.Meta().(*ArmClient).MySQL.DatabasesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +29,13 @@ func resourceArmMySqlServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -40,7 +50,8 @@ func resourceArmMySqlServer() *schema.Resource {
 
 			"sku": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -112,13 +123,14 @@ func resourceArmMySqlServer() *schema.Resource {
 
 			"administrator_login": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"administrator_login_password": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
@@ -172,6 +184,34 @@ func resourceArmMySqlServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(mysql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mysql.CreateModeDefault),
+					string(mysql.CreateModeGeoRestore),
+					string(mysql.CreateModePointInTimeRestore),
+					string(mysql.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseServerRestorePointInTime,
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -189,14 +229,20 @@ func resourceArmMySqlServer() *schema.Resource {
 				return fmt.Errorf("basic pricing tier only supports upto 1,048,576 MB (1TB) of storage")
 			}
 
-			return nil
+			createMode := diff.Get("create_mode").(string)
+			if strings.EqualFold(createMode, string(mysql.CreateModeReplica)) && strings.ToLower(tier.(string)) == "basic" {
+				return fmt.Errorf("basic pricing tier doesn't support replicas")
+			}
+
+			return validate.DatabaseServerCreateMode(diff)
 		},
 	}
 }
 
 func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysql.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).MySQL.ServersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Server creation.")
 
@@ -208,14 +254,15 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := "Default"
+	createMode := d.Get("create_mode").(string)
+	creationSourceServerId := d.Get("creation_source_server_id").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -227,32 +274,72 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	sku := expandMySQLServerSku(d)
 	storageProfile := expandMySQLStorageProfile(d)
 
-	properties := mysql.ServerForCreate{
-		Location: &location,
-		Properties: &mysql.ServerPropertiesForDefaultCreate{
+	var serverProperties mysql.BasicServerPropertiesForCreate
+	switch mysql.CreateMode(createMode) {
+	case mysql.CreateModeGeoRestore:
+		serverProperties = &mysql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(creationSourceServerId),
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModeGeoRestore,
+		}
+	case mysql.CreateModePointInTimeRestore:
+		restorePointInTime := d.Get("restore_point_in_time").(string)
+		restorePointInTimeDate, err := date.ParseTime(time.RFC3339, restorePointInTime)
+		if err != nil {
+			return fmt.Errorf("`restore_point_in_time` wasn't a valid RFC3339 date %q: %+v", restorePointInTime, err)
+		}
+
+		serverProperties = &mysql.ServerPropertiesForRestore{
+			SourceServerID: utils.String(creationSourceServerId),
+			RestorePointInTime: &date.Time{
+				Time: restorePointInTimeDate,
+			},
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModePointInTimeRestore,
+		}
+	case mysql.CreateModeReplica:
+		// the Administrator Login and SKU of a Replica are inherited from the Source Server
+		serverProperties = &mysql.ServerPropertiesForReplica{
+			SourceServerID: utils.String(creationSourceServerId),
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			CreateMode:     mysql.CreateModeReplica,
+		}
+	default:
+		serverProperties = &mysql.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    mysql.ServerVersion(version),
 			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
-			CreateMode:                 mysql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+			CreateMode:                 mysql.CreateModeDefault,
+		}
+	}
+
+	properties := mysql.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags, meta),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -265,8 +352,9 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysql.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).MySQL.ServersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM MySQL Server update.")
 
@@ -282,27 +370,31 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	properties := mysql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        mysql.ServerVersion(version),
+			SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
+	}
+
+	// the password of a Replica is inherited from the Source Server, so may not be specified
+	if adminLoginPassword != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(adminLoginPassword)
 	}
 
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error updating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for MySQL Server %q (Resource Group %q) to finish updating: %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for MySQL Server %q (Resource Group %q) to finish updating: %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -315,8 +407,9 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysql.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).MySQL.ServersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -332,7 +425,7 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -346,6 +439,21 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
+	// the Source Server is only returned for Replicas, so for other create modes we use the value from the config
+	if masterServerId := resp.MasterServerID; masterServerId != nil && *masterServerId != "" {
+		d.Set("creation_source_server_id", masterServerId)
+	}
+
+	// the Create Mode isn't returned either - Replicas can be detected, but otherwise (e.g. for a Server which
+	// was restored) the value from the config is used, falling back to `Default` when importing
+	createMode := d.Get("create_mode").(string)
+	if masterServerId := resp.MasterServerID; masterServerId != nil && *masterServerId != "" {
+		createMode = string(mysql.CreateModeReplica)
+	} else if createMode == "" {
+		createMode = string(mysql.CreateModeDefault)
+	}
+	d.Set("create_mode", createMode)
+
 	if err := d.Set("sku", flattenMySQLServerSku(resp.Sku)); err != nil {
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
@@ -354,7 +462,7 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `storage_profile`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
@@ -363,8 +471,9 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).mysql.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).MySQL.ServersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -375,11 +484,11 @@ func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) erro
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for deletion of MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for deletion of MySQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -387,6 +496,9 @@ func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) erro
 
 func expandMySQLServerSku(d *schema.ResourceData) *mysql.Sku {
 	skus := d.Get("sku").([]interface{})
+	if len(skus) == 0 || skus[0] == nil {
+		return nil
+	}
 	sku := skus[0].(map[string]interface{})
 
 	name := sku["name"].(string)
@@ -418,6 +530,10 @@ func expandMySQLStorageProfile(d *schema.ResourceData) *mysql.StorageProfile {
 }
 
 func flattenMySQLServerSku(resp *mysql.Sku) []interface{} {
+	if resp == nil {
+		return []interface{}{}
+	}
+
 	values := map[string]interface{}{}
 
 	if name := resp.Name; name != nil {
@@ -0,0 +1,278 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var natGatewayResourceName = "azurerm_nat_gateway"
+
+func resourceArmNatGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNatGatewayCreateUpdate,
+		Read:   resourceArmNatGatewayRead,
+		Update: resourceArmNatGatewayCreateUpdate,
+		Delete: resourceArmNatGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"idle_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(4, 120),
+			},
+
+			"public_ip_address_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceid.ValidatePublicIPAddressID,
+				},
+				Set: schema.HashString,
+			},
+
+			"public_ip_prefix_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceid.ValidatePublicIPPrefixID,
+				},
+				Set: schema.HashString,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.Standard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Standard),
+				}, false),
+			},
+
+			"zones": azure.SchemaSingleZone(),
+
+			"resource_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmNatGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewayClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM NAT Gateway creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing NAT Gateway %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_nat_gateway", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	idleTimeoutInMinutes := d.Get("idle_timeout_in_minutes").(int)
+	publicIpAddressIds := d.Get("public_ip_address_ids").(*schema.Set).List()
+	publicIpPrefixIds := d.Get("public_ip_prefix_ids").(*schema.Set).List()
+	skuName := d.Get("sku_name").(string)
+	zones := d.Get("zones").([]interface{})
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := network.NatGateway{
+		Location: utils.String(location),
+		NatGatewayPropertiesFormat: &network.NatGatewayPropertiesFormat{
+			IdleTimeoutInMinutes: utils.Int32(int32(idleTimeoutInMinutes)),
+			PublicIPAddresses:    expandArmNatGatewaySubResources(publicIpAddressIds),
+			PublicIPPrefixes:     expandArmNatGatewaySubResources(publicIpPrefixIds),
+		},
+		Sku: &network.NatGatewaySku{
+			Name: network.NatGatewaySkuName(skuName),
+		},
+		Zones: azure.ExpandZones(zones),
+		Tags:  expandTags(t, meta),
+	}
+
+	locks.ByName(name, natGatewayResourceName)
+	defer locks.UnlockByName(name, natGatewayResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating NAT Gateway %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of NAT Gateway %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of NAT Gateway %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmNatGatewayRead(d, meta)
+}
+
+func resourceArmNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseNatGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] NAT Gateway %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving NAT Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", string(sku.Name))
+	}
+
+	if props := resp.NatGatewayPropertiesFormat; props != nil {
+		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+		d.Set("resource_guid", props.ResourceGUID)
+
+		if err := d.Set("public_ip_address_ids", flattenArmNatGatewaySubResources(props.PublicIPAddresses)); err != nil {
+			return fmt.Errorf("Error setting `public_ip_address_ids`: %+v", err)
+		}
+
+		if err := d.Set("public_ip_prefix_ids", flattenArmNatGatewaySubResources(props.PublicIPPrefixes)); err != nil {
+			return fmt.Errorf("Error setting `public_ip_prefix_ids`: %+v", err)
+		}
+	}
+
+	if err := d.Set("zones", utils.FlattenStringSlice(resp.Zones)); err != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", err)
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewayClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseNatGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, natGatewayResourceName)
+	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting NAT Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of NAT Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func expandArmNatGatewaySubResources(input []interface{}) *[]network.SubResource {
+	results := make([]network.SubResource, 0)
+
+	for _, item := range input {
+		results = append(results, network.SubResource{
+			ID: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmNatGatewaySubResources(input *[]network.SubResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMNatGateway_basic(t *testing.T) {
+	resourceName := "azurerm_nat_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_timeout_in_minutes", "4"),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "Standard"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_guid"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNatGateway_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_nat_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMNatGateway_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_nat_gateway"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNatGateway_complete(t *testing.T) {
+	resourceName := "azurerm_nat_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGateway_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_timeout_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip_address_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip_prefix_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNatGateway_update(t *testing.T) {
+	resourceName := "azurerm_nat_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNatGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNatGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMNatGateway_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNatGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_timeout_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip_address_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip_prefix_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNatGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("NAT Gateway not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseNatGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Network.NatGatewayClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: NAT Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Network.NatGatewayClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNatGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Network.NatGatewayClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_nat_gateway" {
+			continue
+		}
+
+		id, err := resourceid.ParseNatGatewayID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Network.NatGatewayClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("NAT Gateway %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMNatGateway_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctestnatgateway-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMNatGateway_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMNatGateway_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway" "import" {
+  name                = "${azurerm_nat_gateway.test.name}"
+  location            = "${azurerm_nat_gateway.test.location}"
+  resource_group_name = "${azurerm_nat_gateway.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccAzureRMNatGateway_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+  zones               = ["1"]
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpublicipprefix-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  prefix_length       = 30
+  zones               = ["1"]
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                    = "acctestnatgateway-%d"
+  location                = "${azurerm_resource_group.test.location}"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  public_ip_address_ids   = ["${azurerm_public_ip.test.id}"]
+  public_ip_prefix_ids    = ["${azurerm_public_ip_prefix.test.id}"]
+  sku_name                = "Standard"
+  idle_timeout_in_minutes = 10
+  zones                   = ["1"]
+
+  tags = {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -423,7 +423,7 @@ func testCheckAzureRMNetworkInterfaceExists(resourceName string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.InterfacesClient
+		client := testAccProvider.Meta().(*ArmClient).Network.InterfacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -453,7 +453,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(resourceName string) resource.Te
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.InterfacesClient
+		client := testAccProvider.Meta().(*ArmClient).Network.InterfacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, name)
@@ -470,7 +470,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(resourceName string) resource.Te
 }
 
 func testCheckAzureRMNetworkInterfaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.InterfacesClient
+	client := testAccProvider.Meta().(*ArmClient).Network.InterfacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
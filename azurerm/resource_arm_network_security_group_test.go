@@ -278,7 +278,7 @@ func testCheckAzureRMNetworkSecurityGroupExists(resourceName string) resource.Te
 			return fmt.Errorf("Bad: no resource group found in state for network security group: %q", sgName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.SecurityGroupClient
+		client := testAccProvider.Meta().(*ArmClient).Network.SecurityGroupClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, sgName, "")
 		if err != nil {
@@ -307,7 +307,7 @@ func testCheckAzureRMNetworkSecurityGroupDisappears(resourceName string) resourc
 			return fmt.Errorf("Bad: no resource group found in state for network security group: %q", sgName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.SecurityGroupClient
+		client := testAccProvider.Meta().(*ArmClient).Network.SecurityGroupClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, sgName)
 		if err != nil {
@@ -321,7 +321,7 @@ func testCheckAzureRMNetworkSecurityGroupDisappears(resourceName string) resourc
 }
 
 func testCheckAzureRMNetworkSecurityGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.SecurityGroupClient
+	client := testAccProvider.Meta().(*ArmClient).Network.SecurityGroupClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
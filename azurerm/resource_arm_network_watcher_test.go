@@ -213,7 +213,7 @@ func testCheckAzureRMNetworkWatcherExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for Network Watcher: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.WatcherClient
+		client := testAccProvider.Meta().(*ArmClient).Network.WatcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -242,7 +242,7 @@ func testCheckAzureRMNetworkWatcherDisappears(resourceName string) resource.Test
 			return fmt.Errorf("Bad: no resource group found in state for Network Watcher: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.WatcherClient
+		client := testAccProvider.Meta().(*ArmClient).Network.WatcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -269,7 +269,7 @@ func testCheckAzureRMNetworkWatcherDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).network.WatcherClient
+		client := testAccProvider.Meta().(*ArmClient).Network.WatcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
@@ -233,7 +233,7 @@ func testCheckAzureRMNotificationHubAuthorizationRuleExists(resourceName string)
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).notificationHubs.HubsClient
+		client := testAccProvider.Meta().(*ArmClient).NotificationHubs.HubsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
@@ -255,7 +255,7 @@ func testCheckAzureRMNotificationHubAuthorizationRuleExists(resourceName string)
 }
 
 func testCheckAzureRMNotificationHubAuthorizationRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).notificationHubs.HubsClient
+	client := testAccProvider.Meta().(*ArmClient).NotificationHubs.HubsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
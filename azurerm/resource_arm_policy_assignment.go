@@ -1,22 +1,20 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-
-	"time"
-
-	"context"
 	"strconv"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -30,6 +28,13 @@ func resourceArmPolicyAssignment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -107,8 +112,9 @@ func resourceArmPolicyAssignment() *schema.Resource {
 }
 
 func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.AssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Policy.AssignmentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -120,7 +126,7 @@ func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta inte
 		existing, err := client.Get(ctx, scope, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Policy Assignment %q: %s", name, err)
+				return fmt.Errorf("Error checking for presence of existing Policy Assignment %q: %s", name, tf.WrapArmError(err))
 			}
 		}
 
@@ -180,7 +186,7 @@ func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta inte
 	}
 
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Policy Assignment %q to become available: %s", name, err)
+		return fmt.Errorf("Error waiting for Policy Assignment %q to become available: %s", name, tf.WrapArmError(err))
 	}
 
 	resp, err := client.Get(ctx, scope, name)
@@ -194,8 +200,9 @@ func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.AssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Policy.AssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := d.Id()
 
@@ -207,7 +214,7 @@ func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Policy Assignment %q: %+v", id, err)
+		return fmt.Errorf("Error reading Policy Assignment %q: %+v", id, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -230,7 +237,7 @@ func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) e
 			paramsVal := params.(map[string]interface{})
 			json, err := structure.FlattenJsonToString(paramsVal)
 			if err != nil {
-				return fmt.Errorf("Error serializing JSON from Parameters: %+v", err)
+				return fmt.Errorf("Error serializing JSON from Parameters: %+v", tf.WrapArmError(err))
 			}
 
 			d.Set("parameters", json)
@@ -243,8 +250,9 @@ func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.AssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Policy.AssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id := d.Id()
 
@@ -254,7 +262,7 @@ func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Policy Assignment %q: %+v", id, err)
+		return fmt.Errorf("Error deleting Policy Assignment %q: %+v", id, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -264,7 +272,7 @@ func policyAssignmentRefreshFunc(ctx context.Context, client *policy.Assignments
 	return func() (interface{}, string, error) {
 		res, err := client.Get(ctx, scope, name)
 		if err != nil {
-			return nil, strconv.Itoa(res.StatusCode), fmt.Errorf("Error issuing read request in policyAssignmentRefreshFunc for Policy Assignment %q (Scope: %q): %s", name, scope, err)
+			return nil, strconv.Itoa(res.StatusCode), fmt.Errorf("Error issuing read request in policyAssignmentRefreshFunc for Policy Assignment %q (Scope: %q): %s", name, scope, tf.WrapArmError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
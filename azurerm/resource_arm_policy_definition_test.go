@@ -122,7 +122,7 @@ func testCheckAzureRMPolicyDefinitionExistsInMgmtGroup(policyName string) resour
 		policyName := rs.Primary.Attributes["name"]
 		managementGroupID := rs.Primary.Attributes["management_group_id"]
 
-		client := testAccProvider.Meta().(*ArmClient).policy.DefinitionsClient
+		client := testAccProvider.Meta().(*ArmClient).Policy.DefinitionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.GetAtManagementGroup(ctx, policyName, managementGroupID)
@@ -147,7 +147,7 @@ func testCheckAzureRMPolicyDefinitionExists(resourceName string) resource.TestCh
 
 		policyName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).policy.DefinitionsClient
+		client := testAccProvider.Meta().(*ArmClient).Policy.DefinitionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, policyName)
@@ -164,7 +164,7 @@ func testCheckAzureRMPolicyDefinitionExists(resourceName string) resource.TestCh
 }
 
 func testCheckAzureRMPolicyDefinitionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).policy.DefinitionsClient
+	client := testAccProvider.Meta().(*ArmClient).Policy.DefinitionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
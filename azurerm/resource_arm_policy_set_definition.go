@@ -11,16 +11,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-
-	"github.com/Azure/go-autorest/autorest"
-
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/policy"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -34,6 +33,13 @@ func resourceArmPolicySetDefinition() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -110,8 +116,9 @@ func policyDefinitionsDiffSuppressFunc(k, old, new string, d *schema.ResourceDat
 }
 
 func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.SetDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Policy.SetDefinitionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	policyType := d.Get("policy_type").(string)
@@ -123,7 +130,7 @@ func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta int
 		existing, err := getPolicySetDefinition(ctx, client, name, managementGroupID)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Policy Set Definition %q: %s", name, err)
+				return fmt.Errorf("Error checking for presence of existing Policy Set Definition %q: %s", name, tf.WrapArmError(err))
 			}
 		}
 
@@ -176,7 +183,7 @@ func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating/updating Policy Set Definition %q: %s", name, err)
+		return fmt.Errorf("Error creating/updating Policy Set Definition %q: %s", name, tf.WrapArmError(err))
 	}
 
 	// Policy Definitions are eventually consistent; wait for them to stabilize
@@ -191,13 +198,13 @@ func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta int
 	}
 
 	if _, err = stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Policy Set Definition %q to become available: %s", name, err)
+		return fmt.Errorf("Error waiting for Policy Set Definition %q to become available: %s", name, tf.WrapArmError(err))
 	}
 
 	var resp policy.SetDefinition
 	resp, err = getPolicySetDefinition(ctx, client, name, managementGroupID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Policy Set Definition %q: %s", name, err)
+		return fmt.Errorf("Error retrieving Policy Set Definition %q: %s", name, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
@@ -206,8 +213,9 @@ func resourceArmPolicySetDefinitionCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.SetDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Policy.SetDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicySetDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -225,7 +233,7 @@ func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Policy Set Definition %+v", err)
+		return fmt.Errorf("Error reading Policy Set Definition %+v", tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -271,8 +279,9 @@ func resourceArmPolicySetDefinitionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceArmPolicySetDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).policy.SetDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Policy.SetDefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name, err := parsePolicySetDefinitionNameFromId(d.Id())
 	if err != nil {
@@ -293,7 +302,7 @@ func resourceArmPolicySetDefinitionDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Policy Set Definition %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Policy Set Definition %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -324,7 +333,7 @@ func policySetDefinitionRefreshFunc(ctx context.Context, client *policy.SetDefin
 	return func() (interface{}, string, error) {
 		res, err := getPolicySetDefinition(ctx, client, name, managementGroupId)
 		if err != nil {
-			return nil, strconv.Itoa(res.StatusCode), fmt.Errorf("Error issuing read request in policySetDefinitionRefreshFunc for Policy Set Definition %q: %s", name, err)
+			return nil, strconv.Itoa(res.StatusCode), fmt.Errorf("Error issuing read request in policySetDefinitionRefreshFunc for Policy Set Definition %q: %s", name, tf.WrapArmError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
@@ -4,17 +4,18 @@ import (
 	"fmt"
 	"log"
 	"strings"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +29,13 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -41,7 +49,8 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 
 			"sku": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -113,13 +122,14 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 
 			"administrator_login": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"administrator_login_password": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
 			},
 
@@ -178,6 +188,34 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(postgresql.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(postgresql.CreateModeDefault),
+					string(postgresql.CreateModeGeoRestore),
+					string(postgresql.CreateModePointInTimeRestore),
+					string(postgresql.CreateModeReplica),
+				}, false),
+			},
+
+			"creation_source_server_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseServerRestorePointInTime,
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -195,14 +233,20 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 				return fmt.Errorf("basic pricing tier only supports upto 1,048,576 MB (1TB) of storage")
 			}
 
-			return nil
+			createMode := diff.Get("create_mode").(string)
+			if strings.EqualFold(createMode, string(postgresql.CreateModeReplica)) && strings.ToLower(tier.(string)) == "basic" {
+				return fmt.Errorf("basic pricing tier doesn't support replicas")
+			}
+
+			return validate.DatabaseServerCreateMode(diff)
 		},
 	}
 }
 
 func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).postgres.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Postgres.ServersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM PostgreSQL Server creation.")
 
@@ -214,14 +258,15 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := "Default"
+	createMode := d.Get("create_mode").(string)
+	creationSourceServerId := d.Get("creation_source_server_id").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
 	if requireResourcesToBeImported {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -233,32 +278,72 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 	sku := expandAzureRmPostgreSQLServerSku(d)
 	storageProfile := expandAzureRmPostgreSQLStorageProfile(d)
 
-	properties := postgresql.ServerForCreate{
-		Location: &location,
-		Properties: &postgresql.ServerPropertiesForDefaultCreate{
+	var serverProperties postgresql.BasicServerPropertiesForCreate
+	switch postgresql.CreateMode(createMode) {
+	case postgresql.CreateModeGeoRestore:
+		serverProperties = &postgresql.ServerPropertiesForGeoRestore{
+			SourceServerID: utils.String(creationSourceServerId),
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			CreateMode:     postgresql.CreateModeGeoRestore,
+		}
+	case postgresql.CreateModePointInTimeRestore:
+		restorePointInTime := d.Get("restore_point_in_time").(string)
+		restorePointInTimeDate, err := date.ParseTime(time.RFC3339, restorePointInTime)
+		if err != nil {
+			return fmt.Errorf("`restore_point_in_time` wasn't a valid RFC3339 date %q: %+v", restorePointInTime, err)
+		}
+
+		serverProperties = &postgresql.ServerPropertiesForRestore{
+			SourceServerID: utils.String(creationSourceServerId),
+			RestorePointInTime: &date.Time{
+				Time: restorePointInTimeDate,
+			},
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			CreateMode:     postgresql.CreateModePointInTimeRestore,
+		}
+	case postgresql.CreateModeReplica:
+		// the Administrator Login and SKU of a Replica are inherited from the Source Server
+		serverProperties = &postgresql.ServerPropertiesForReplica{
+			SourceServerID: utils.String(creationSourceServerId),
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			CreateMode:     postgresql.CreateModeReplica,
+		}
+	default:
+		serverProperties = &postgresql.ServerPropertiesForDefaultCreate{
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			Version:                    postgresql.ServerVersion(version),
 			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
 			StorageProfile:             storageProfile,
-			CreateMode:                 postgresql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+			CreateMode:                 postgresql.CreateModeDefault,
+		}
+	}
+
+	properties := postgresql.ServerForCreate{
+		Location:   &location,
+		Properties: serverProperties,
+		Sku:        sku,
+		Tags:       expandTags(tags, meta),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error creating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error creating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for creation of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -271,8 +356,9 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).postgres.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Postgres.ServersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM PostgreSQL Server update.")
 
@@ -288,27 +374,31 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 
 	properties := postgresql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &postgresql.ServerUpdateParametersProperties{
-			StorageProfile:             storageProfile,
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    postgresql.ServerVersion(version),
-			SslEnforcement:             postgresql.SslEnforcementEnum(sslEnforcement),
+			StorageProfile: storageProfile,
+			Version:        postgresql.ServerVersion(version),
+			SslEnforcement: postgresql.SslEnforcementEnum(sslEnforcement),
 		},
 		Sku:  sku,
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
+	}
+
+	// the password of a Replica is inherited from the Source Server, so may not be specified
+	if adminLoginPassword != "" {
+		properties.ServerUpdateParametersProperties.AdministratorLoginPassword = utils.String(adminLoginPassword)
 	}
 
 	future, err := client.Update(ctx, resourceGroup, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error updating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error updating PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for update of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for update of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if read.ID == nil {
@@ -321,8 +411,9 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).postgres.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Postgres.ServersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -339,7 +430,7 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Azure PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error making Read request on Azure PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -353,6 +444,21 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
+	// the Source Server is only returned for Replicas, so for other create modes we use the value from the config
+	if masterServerId := resp.MasterServerID; masterServerId != nil && *masterServerId != "" {
+		d.Set("creation_source_server_id", masterServerId)
+	}
+
+	// the Create Mode isn't returned either - Replicas can be detected, but otherwise (e.g. for a Server which
+	// was restored) the value from the config is used, falling back to `Default` when importing
+	createMode := d.Get("create_mode").(string)
+	if masterServerId := resp.MasterServerID; masterServerId != nil && *masterServerId != "" {
+		createMode = string(postgresql.CreateModeReplica)
+	} else if createMode == "" {
+		createMode = string(postgresql.CreateModeDefault)
+	}
+	d.Set("create_mode", createMode)
+
 	if err := d.Set("sku", flattenPostgreSQLServerSku(resp.Sku)); err != nil {
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
@@ -361,7 +467,7 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting `storage_profile`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
@@ -370,8 +476,9 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).postgres.ServersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Postgres.ServersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -386,7 +493,7 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error deleting PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
@@ -394,7 +501,7 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		return fmt.Errorf("Error waiting for deletion of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for deletion of PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -402,6 +509,9 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 
 func expandAzureRmPostgreSQLServerSku(d *schema.ResourceData) *postgresql.Sku {
 	skus := d.Get("sku").([]interface{})
+	if len(skus) == 0 || skus[0] == nil {
+		return nil
+	}
 	sku := skus[0].(map[string]interface{})
 
 	name := sku["name"].(string)
@@ -433,6 +543,10 @@ func expandAzureRmPostgreSQLStorageProfile(d *schema.ResourceData) *postgresql.S
 }
 
 func flattenPostgreSQLServerSku(resp *postgresql.Sku) []interface{} {
+	if resp == nil {
+		return []interface{}{}
+	}
+
 	values := map[string]interface{}{}
 
 	if name := resp.Name; name != nil {
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -356,6 +357,73 @@ func TestAccAzureRMPostgreSQLServer_updateSKU(t *testing.T) {
 
 //
 
+func TestAccAzureRMPostgreSQLServer_createReplica(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	replicaResourceName := "azurerm_postgresql_server.replica"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPostgreSQLServer_generalPurpose(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMPostgreSQLServer_createReplica(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					testCheckAzureRMPostgreSQLServerExists(replicaResourceName),
+					resource.TestCheckResourceAttr(replicaResourceName, "administrator_login", "acctestun"),
+					resource.TestCheckResourceAttr(replicaResourceName, "sku.0.name", "GP_Gen5_32"),
+					resource.TestCheckResourceAttrPair(replicaResourceName, "creation_source_server_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      replicaResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPostgreSQLServer_createPointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_postgresql_server.test"
+	restoreResourceName := "azurerm_postgresql_server.restore"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	// the restore point must be after the Source Server's first backup has completed, which is checked below
+	restoreTime := time.Now().Add(15 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPostgreSQLServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPostgreSQLServer_generalPurpose(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					testCheckAzureRMPostgreSQLServerWaitForRestorePoint(resourceName, restoreTime),
+				),
+			},
+			{
+				Config: testAccAzureRMPostgreSQLServer_createPointInTimeRestore(ri, location, restoreTime.UTC().Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPostgreSQLServerExists(resourceName),
+					testCheckAzureRMPostgreSQLServerExists(restoreResourceName),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -370,7 +438,7 @@ func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCh
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Server: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgres.ServersClient
+		client := testAccProvider.Meta().(*ArmClient).Postgres.ServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -386,8 +454,47 @@ func testCheckAzureRMPostgreSQLServerExists(resourceName string) resource.TestCh
 	}
 }
 
+// testCheckAzureRMPostgreSQLServerWaitForRestorePoint waits until the specified restore point has passed, checking that it's
+// covered by the Server's backups - since a Server can't be restored to a point before its first backup completed
+func testCheckAzureRMPostgreSQLServerWaitForRestorePoint(resourceName string, restoreTime time.Time) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).Postgres.ServersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		deadline := restoreTime.Add(time.Hour)
+		for time.Now().Before(deadline) {
+			resp, err := client.Get(ctx, resourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("Bad: Get on PostgreSQL Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if props := resp.ServerProperties; props != nil && props.EarliestRestoreDate != nil {
+				if earliest := props.EarliestRestoreDate.Time; earliest.After(restoreTime) {
+					return fmt.Errorf("Bad: the earliest restore point for PostgreSQL Server %q (Resource Group %q) is %s, which is after %s", name, resourceGroup, earliest, restoreTime)
+				}
+
+				if time.Now().After(restoreTime) {
+					return nil
+				}
+			}
+
+			time.Sleep(time.Minute)
+		}
+
+		return fmt.Errorf("Bad: timed out waiting for a backup of PostgreSQL Server %q (Resource Group %q) covering %s", name, resourceGroup, restoreTime)
+	}
+}
+
 func testCheckAzureRMPostgreSQLServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgres.ServersClient
+	client := testAccProvider.Meta().(*ArmClient).Postgres.ServersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -690,3 +797,59 @@ resource "azurerm_postgresql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMPostgreSQLServer_createReplica(rInt int, location string) string {
+	template := testAccAzureRMPostgreSQLServer_generalPurpose(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_server" "replica" {
+  name                      = "accpsqlsvr-replica-%d"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  create_mode               = "Replica"
+  creation_source_server_id = "${azurerm_postgresql_server.test.id}"
+
+  storage_profile {
+    storage_mb            = 640000
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  version         = "9.6"
+  ssl_enforcement = "Enabled"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPostgreSQLServer_createPointInTimeRestore(rInt int, location string, restoreTime string) string {
+	template := testAccAzureRMPostgreSQLServer_generalPurpose(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_server" "restore" {
+  name                      = "accpsqlsvr-restore-%d"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  create_mode               = "PointInTimeRestore"
+  creation_source_server_id = "${azurerm_postgresql_server.test.id}"
+  restore_point_in_time     = "%s"
+
+  sku {
+    name     = "GP_Gen5_32"
+    capacity = 32
+    tier     = "GeneralPurpose"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb            = 640000
+    backup_retention_days = 7
+    geo_redundant_backup  = "Disabled"
+  }
+
+  version         = "9.6"
+  ssl_enforcement = "Enabled"
+}
+`, template, rInt, restoreTime)
+}
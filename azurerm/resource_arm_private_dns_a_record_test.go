@@ -145,7 +145,7 @@ func testCheckAzureRMPrivateDnsARecordExists(resourceName string) resource.TestC
 			return fmt.Errorf("Bad: no resource group found in state for Private DNS A record: %s", aName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
+		conn := testAccProvider.Meta().(*ArmClient).PrivateDns.RecordSetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, zoneName, privatedns.A, aName)
 		if err != nil {
@@ -161,7 +161,7 @@ func testCheckAzureRMPrivateDnsARecordExists(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMPrivateDnsARecordDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
+	conn := testAccProvider.Meta().(*ArmClient).PrivateDns.RecordSetsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -169,7 +169,7 @@ func testCheckAzureRMPrivateDnsCNameRecordExists(resourceName string) resource.T
 			return fmt.Errorf("Bad: no resource group found in state for Private DNS CNAME record: %s", aName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
+		conn := testAccProvider.Meta().(*ArmClient).PrivateDns.RecordSetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, zoneName, privatedns.CNAME, aName)
 		if err != nil {
@@ -185,7 +185,7 @@ func testCheckAzureRMPrivateDnsCNameRecordExists(resourceName string) resource.T
 }
 
 func testCheckAzureRMPrivateDnsCNameRecordDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).privateDns.RecordSetsClient
+	conn := testAccProvider.Meta().(*ArmClient).PrivateDns.RecordSetsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -1,8 +1,8 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
-
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +25,13 @@ func resourceArmPrivateDnsZone() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -59,8 +67,9 @@ func resourceArmPrivateDnsZone() *schema.Resource {
 }
 
 func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).privateDns.PrivateZonesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).PrivateDns.PrivateZonesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -69,7 +78,7 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("error checking for presence of existing Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+				return fmt.Errorf("error checking for presence of existing Private DNS Zone %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -83,7 +92,7 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 
 	parameters := privatedns.PrivateZone{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 
 	etag := ""
@@ -91,17 +100,17 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, parameters, etag, ifNoneMatch)
 	if err != nil {
-		return fmt.Errorf("error creating/updating Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+		return fmt.Errorf("error creating/updating Private DNS Zone %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 	}
 
 	time.Sleep(time.Second * 10) // resource is slow to create / update, retry covers the create, sleeping to make update more reliable
-	if err := resource.Retry(120*time.Second, retryPrivateDnsZonesClientGet(resGroup, name, meta)); err != nil {
-		return fmt.Errorf("error waiting for Private DNS Zone %q to become available: %+v", name, err)
+	if err := resource.Retry(120*time.Second, retryPrivateDnsZonesClientGet(ctx, resGroup, name, meta)); err != nil {
+		return fmt.Errorf("error waiting for Private DNS Zone %q to become available: %+v", name, tf.WrapArmError(err))
 	}
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
-		return fmt.Errorf("error retrieving Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+		return fmt.Errorf("error retrieving Private DNS Zone %q (Resource Group %q): %s", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if resp.ID == nil {
@@ -113,10 +122,9 @@ func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interfac
 	return resourceArmPrivateDnsZoneRead(d, meta)
 }
 
-func retryPrivateDnsZonesClientGet(resGroup string, name string, meta interface{}) func() *resource.RetryError {
+func retryPrivateDnsZonesClientGet(ctx context.Context, resGroup string, name string, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		client := meta.(*ArmClient).privateDns.PrivateZonesClient
-		ctx := meta.(*ArmClient).StopContext
+		client := meta.(*ArmClient).PrivateDns.PrivateZonesClient
 
 		if _, err := client.Get(ctx, resGroup, name); err != nil {
 			return resource.RetryableError(err)
@@ -127,8 +135,9 @@ func retryPrivateDnsZonesClientGet(resGroup string, name string, meta interface{
 }
 
 func resourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).privateDns.PrivateZonesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).PrivateDns.PrivateZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -144,7 +153,7 @@ func resourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading Private DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+		return fmt.Errorf("error reading Private DNS Zone %q (Resource Group %q): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", name)
@@ -154,14 +163,15 @@ func resourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("max_number_of_virtual_network_links", resp.MaxNumberOfVirtualNetworkLinks)
 	d.Set("max_number_of_virtual_network_links_with_registration", resp.MaxNumberOfVirtualNetworkLinksWithRegistration)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmPrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).privateDns.PrivateZonesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).PrivateDns.PrivateZonesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -177,14 +187,14 @@ func resourceArmPrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) e
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("error deleting Private DNS Zone %s (resource group %s): %+v", name, resGroup, err)
+		return fmt.Errorf("error deleting Private DNS Zone %s (resource group %s): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		if response.WasNotFound(future.Response()) {
 			return nil
 		}
-		return fmt.Errorf("error deleting Private DNS Zone %s (resource group %s): %+v", name, resGroup, err)
+		return fmt.Errorf("error deleting Private DNS Zone %s (resource group %s): %+v", name, resGroup, tf.WrapArmError(err))
 	}
 
 	return nil
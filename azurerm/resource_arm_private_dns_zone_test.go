@@ -113,7 +113,7 @@ func testCheckAzureRMPrivateDnsZoneExists(resourceName string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for Private DNS zone: %s", zoneName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).privateDns.PrivateZonesClient
+		client := testAccProvider.Meta().(*ArmClient).PrivateDns.PrivateZonesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, zoneName)
 		if err != nil {
@@ -129,7 +129,7 @@ func testCheckAzureRMPrivateDnsZoneExists(resourceName string) resource.TestChec
 }
 
 func testCheckAzureRMPrivateDnsZoneDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).privateDns.PrivateZonesClient
+	conn := testAccProvider.Meta().(*ArmClient).PrivateDns.PrivateZonesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
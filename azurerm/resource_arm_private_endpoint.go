@@ -0,0 +1,407 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointCreateUpdate,
+		Read:   resourceArmPrivateEndpointRead,
+		Update: resourceArmPrivateEndpointCreateUpdate,
+		Delete: resourceArmPrivateEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateSubnetID,
+			},
+
+			"private_service_connection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"is_manual_connection": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"private_connection_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"subresource_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"request_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateEndpointsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Private Endpoint creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_endpoint", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	subnet, err := resourceid.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	connection, isManual, err := expandArmPrivateEndpointServiceConnection(d.Get("private_service_connection").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.PrivateEndpoint{
+		Location: utils.String(location),
+		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+			Subnet: &network.Subnet{
+				ID: utils.String(subnetId),
+			},
+		},
+		Tags: expandTags(t, meta),
+	}
+	if isManual {
+		parameters.PrivateEndpointProperties.ManualPrivateLinkServiceConnections = &[]network.PrivateLinkServiceConnection{connection}
+	} else {
+		parameters.PrivateEndpointProperties.PrivateLinkServiceConnections = &[]network.PrivateLinkServiceConnection{connection}
+	}
+
+	locks.ByName(subnet.Name, subnetResourceName)
+	defer locks.UnlockByName(subnet.Name, subnetResourceName)
+
+	locks.ByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Endpoint %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPrivateEndpointRead(d, meta)
+}
+
+func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Private Endpoint %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	networkInterfaceId := ""
+	privateIpAddress := ""
+	if props := resp.PrivateEndpointProperties; props != nil {
+		subnetId := ""
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		connections := flattenArmPrivateEndpointServiceConnections(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections)
+		if err := d.Set("private_service_connection", connections); err != nil {
+			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
+		}
+
+		if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
+			nic := (*nics)[0]
+			if nic.ID != nil {
+				networkInterfaceId = *nic.ID
+				privateIpAddress, err = retrievePrivateEndpointIPAddress(ctx, meta, networkInterfaceId)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	d.Set("network_interface_id", networkInterfaceId)
+	d.Set("private_ip_address", privateIpAddress)
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateEndpointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	subnet, err := resourceid.ParseSubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(subnet.Name, subnetResourceName)
+	defer locks.UnlockByName(subnet.Name, subnetResourceName)
+
+	locks.ByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(subnet.VirtualNetworkName, virtualNetworkResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+// retrievePrivateEndpointIPAddress returns the Private IP Address allocated to the Network Interface
+// created for a Private Endpoint, which can be used to register the Endpoint in a Private DNS Zone
+func retrievePrivateEndpointIPAddress(ctx context.Context, meta interface{}, networkInterfaceId string) (string, error) {
+	client := meta.(*ArmClient).Network.InterfacesClient
+
+	id, err := resourceid.ParseNetworkInterfaceID(networkInterfaceId)
+	if err != nil {
+		return "", err
+	}
+
+	nic, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q) for Private Endpoint: %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, config := range *props.IPConfigurations {
+			if config.InterfaceIPConfigurationPropertiesFormat == nil || config.PrivateIPAddress == nil {
+				continue
+			}
+
+			return *config.PrivateIPAddress, nil
+		}
+	}
+
+	return "", nil
+}
+
+func expandArmPrivateEndpointServiceConnection(input []interface{}) (network.PrivateLinkServiceConnection, bool, error) {
+	v := input[0].(map[string]interface{})
+
+	isManual := v["is_manual_connection"].(bool)
+	requestMessage := v["request_message"].(string)
+	if !isManual && requestMessage != "" {
+		return network.PrivateLinkServiceConnection{}, false, fmt.Errorf("`request_message` can only be specified when `is_manual_connection` is set to `true`")
+	}
+
+	groupIds := make([]string, 0)
+	for _, name := range v["subresource_names"].([]interface{}) {
+		groupIds = append(groupIds, name.(string))
+	}
+
+	connection := network.PrivateLinkServiceConnection{
+		Name: utils.String(v["name"].(string)),
+		PrivateLinkServiceConnectionProperties: &network.PrivateLinkServiceConnectionProperties{
+			PrivateLinkServiceID: utils.String(v["private_connection_resource_id"].(string)),
+			GroupIds:             &groupIds,
+		},
+	}
+	if requestMessage != "" {
+		connection.PrivateLinkServiceConnectionProperties.RequestMessage = utils.String(requestMessage)
+	}
+
+	return connection, isManual, nil
+}
+
+func flattenArmPrivateEndpointServiceConnections(automatic *[]network.PrivateLinkServiceConnection, manual *[]network.PrivateLinkServiceConnection) []interface{} {
+	results := make([]interface{}, 0)
+
+	flatten := func(input *[]network.PrivateLinkServiceConnection, isManual bool) {
+		if input == nil {
+			return
+		}
+
+		for _, item := range *input {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			privateConnectionResourceId := ""
+			requestMessage := ""
+			status := ""
+			subresourceNames := make([]interface{}, 0)
+			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+				if props.PrivateLinkServiceID != nil {
+					privateConnectionResourceId = *props.PrivateLinkServiceID
+				}
+				if props.RequestMessage != nil {
+					requestMessage = *props.RequestMessage
+				}
+				if props.GroupIds != nil {
+					for _, groupId := range *props.GroupIds {
+						subresourceNames = append(subresourceNames, groupId)
+					}
+				}
+				if state := props.PrivateLinkServiceConnectionState; state != nil && state.Status != nil {
+					status = *state.Status
+				}
+			}
+
+			results = append(results, map[string]interface{}{
+				"name":                           name,
+				"is_manual_connection":           isManual,
+				"private_connection_resource_id": privateConnectionResourceId,
+				"subresource_names":              subresourceNames,
+				"request_message":                requestMessage,
+				"status":                         status,
+			})
+		}
+	}
+
+	flatten(automatic, false)
+	flatten(manual, true)
+
+	return results
+}
@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateEndpointConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointConnectionCreateUpdate,
+		Read:   resourceArmPrivateEndpointConnectionRead,
+		Update: resourceArmPrivateEndpointConnectionCreateUpdate,
+		Delete: resourceArmPrivateEndpointConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"private_link_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidatePrivateLinkServiceID,
+			},
+
+			"private_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidatePrivateEndpointID,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Approved",
+					"Rejected",
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmPrivateEndpointConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	privateLinkServiceId, err := resourceid.ParsePrivateLinkServiceID(d.Get("private_link_service_id").(string))
+	if err != nil {
+		return err
+	}
+	privateEndpointId := d.Get("private_endpoint_id").(string)
+
+	locks.ByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+	defer locks.UnlockByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+
+	service, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", privateLinkServiceId.Name, privateLinkServiceId.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	connection := findPrivateEndpointConnection(service, func(item network.PrivateEndpointConnection) bool {
+		props := item.PrivateEndpointConnectionProperties
+		if props == nil || props.PrivateEndpoint == nil || props.PrivateEndpoint.ID == nil {
+			return false
+		}
+		return strings.EqualFold(*props.PrivateEndpoint.ID, privateEndpointId)
+	})
+	if connection == nil || connection.Name == nil {
+		return fmt.Errorf("Error locating a connection from Private Endpoint %q to Private Link Service %q (Resource Group %q)", privateEndpointId, privateLinkServiceId.Name, privateLinkServiceId.ResourceGroup)
+	}
+	name := *connection.Name
+
+	// the connection is created by the Private Endpoint (and may have been approved automatically), so it
+	// always exists at this point - as such there's no way to tell if it's already managed by another resource
+
+	parameters := network.PrivateEndpointConnection{
+		Name: utils.String(name),
+		PrivateEndpointConnectionProperties: &network.PrivateEndpointConnectionProperties{
+			PrivateEndpoint: &network.PrivateEndpoint{
+				ID: utils.String(privateEndpointId),
+			},
+			PrivateLinkServiceConnectionState: &network.PrivateLinkServiceConnectionState{
+				Status:      utils.String(d.Get("status").(string)),
+				Description: utils.String(d.Get("description").(string)),
+			},
+		},
+	}
+
+	if _, err := client.UpdatePrivateEndpointConnection(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, name, parameters); err != nil {
+		return fmt.Errorf("Error updating Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", name, privateLinkServiceId.Name, privateLinkServiceId.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	id := resourceid.PrivateEndpointConnectionID{
+		SubscriptionId:         privateLinkServiceId.SubscriptionId,
+		ResourceGroup:          privateLinkServiceId.ResourceGroup,
+		PrivateLinkServiceName: privateLinkServiceId.Name,
+		Name:                   name,
+	}
+	d.SetId(id.String())
+
+	return resourceArmPrivateEndpointConnectionRead(d, meta)
+}
+
+func resourceArmPrivateEndpointConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateEndpointConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.PrivateLinkServiceName
+	name := id.Name
+
+	service, err := client.Get(ctx, resourceGroup, serviceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(service.Response) {
+			log.Printf("[INFO] Private Link Service %q was not found in Resource Group %q - removing Private Endpoint Connection %q from state!", serviceName, resourceGroup, name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", serviceName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	connection := findPrivateEndpointConnection(service, func(item network.PrivateEndpointConnection) bool {
+		return item.Name != nil && strings.EqualFold(*item.Name, name)
+	})
+	if connection == nil {
+		log.Printf("[INFO] Private Endpoint Connection %q was not found on Private Link Service %q (Resource Group %q) - removing from state!", name, serviceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", connection.Name)
+	d.Set("private_link_service_id", service.ID)
+
+	if props := connection.PrivateEndpointConnectionProperties; props != nil {
+		if endpoint := props.PrivateEndpoint; endpoint != nil {
+			d.Set("private_endpoint_id", endpoint.ID)
+		}
+
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			d.Set("status", state.Status)
+			d.Set("description", state.Description)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmPrivateEndpointConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateEndpointConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.PrivateLinkServiceName
+	name := id.Name
+
+	locks.ByName(serviceName, privateLinkServiceResourceName)
+	defer locks.UnlockByName(serviceName, privateLinkServiceResourceName)
+
+	future, err := client.DeletePrivateEndpointConnection(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func findPrivateEndpointConnection(service network.PrivateLinkService, predicate func(item network.PrivateEndpointConnection) bool) *network.PrivateEndpointConnection {
+	props := service.PrivateLinkServiceProperties
+	if props == nil || props.PrivateEndpointConnections == nil {
+		return nil
+	}
+
+	for _, item := range *props.PrivateEndpointConnections {
+		if predicate(item) {
+			connection := item
+			return &connection
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateEndpoint_basic(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.status", "Approved"),
+					resource.TestCheckResourceAttrSet(resourceName, "network_interface_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateEndpoint_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_endpoint"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_manualConnection(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	connectionName := "azurerm_private_endpoint_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_manualConnection(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.is_manual_connection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "private_service_connection.0.request_message", "Please approve this connection"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateEndpoint_manualConnectionApproved(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(connectionName, "status", "Approved"),
+					resource.TestCheckResourceAttrSet(connectionName, "name"),
+				),
+			},
+			{
+				ResourceName:      connectionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateEndpoint_privateDnsZone(t *testing.T) {
+	resourceName := "azurerm_private_endpoint.test"
+	recordName := "azurerm_private_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_privateDnsZone(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(recordName, "records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Private Endpoint not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParsePrivateEndpointID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Network.PrivateEndpointsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private Endpoint %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Network.PrivateEndpointsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateEndpointDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Network.PrivateEndpointsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_endpoint" {
+			continue
+		}
+
+		id, err := resourceid.ParsePrivateEndpointID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Network.PrivateEndpointsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Private Endpoint %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateEndpoint_template(rInt int, location string) string {
+	template := testAccAzureRMPrivateLinkService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_subnet" "endpoint" {
+  name                              = "acctestsnet-endpoint-%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  virtual_network_name              = "${azurerm_virtual_network.test.name}"
+  address_prefix                    = "10.5.2.0/24"
+  private_endpoint_network_policies = "Disabled"
+}
+
+resource "azurerm_private_link_service" "test" {
+  name                = "acctestpls-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  load_balancer_frontend_ip_configuration_ids = ["${azurerm_lb.test.frontend_ip_configuration.0.id}"]
+  auto_approval_subscription_ids              = ["${data.azurerm_client_config.current.subscription_id}"]
+  visibility_subscription_ids                 = ["${data.azurerm_client_config.current.subscription_id}"]
+
+  nat_ip_configuration {
+    name      = "primary"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.endpoint.id}"
+
+  private_service_connection {
+    name                           = "acctestconnection-%d"
+    is_manual_connection           = false
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint" "import" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  location            = "${azurerm_private_endpoint.test.location}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+  subnet_id           = "${azurerm_private_endpoint.test.subnet_id}"
+
+  private_service_connection {
+    name                           = "acctestconnection-%d"
+    is_manual_connection           = false
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_manualConnection(rInt int, location string) string {
+	template := testAccAzureRMPrivateLinkService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "endpoint" {
+  name                              = "acctestsnet-endpoint-%d"
+  resource_group_name               = "${azurerm_resource_group.test.name}"
+  virtual_network_name              = "${azurerm_virtual_network.test.name}"
+  address_prefix                    = "10.5.2.0/24"
+  private_endpoint_network_policies = "Disabled"
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  subnet_id           = "${azurerm_subnet.endpoint.id}"
+
+  private_service_connection {
+    name                           = "acctestconnection-%d"
+    is_manual_connection           = true
+    private_connection_resource_id = "${azurerm_private_link_service.test.id}"
+    request_message                = "Please approve this connection"
+  }
+}
+`, template, rInt, rInt, rInt)
+}
+
+func testAccAzureRMPrivateEndpoint_manualConnectionApproved(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_manualConnection(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection" "test" {
+  private_link_service_id = "${azurerm_private_link_service.test.id}"
+  private_endpoint_id     = "${azurerm_private_endpoint.test.id}"
+  status                  = "Approved"
+  description             = "Approved by Terraform"
+}
+`, template)
+}
+
+func testAccAzureRMPrivateEndpoint_privateDnsZone(rInt int, location string) string {
+	template := testAccAzureRMPrivateEndpoint_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.internal"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_a_record" "test" {
+  name                = "service"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  ttl                 = 300
+  records             = ["${azurerm_private_endpoint.test.private_ip_address}"]
+}
+`, template, rInt)
+}
@@ -0,0 +1,441 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var privateLinkServiceResourceName = "azurerm_private_link_service"
+
+func resourceArmPrivateLinkService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateLinkServiceCreateUpdate,
+		Read:   resourceArmPrivateLinkServiceRead,
+		Update: resourceArmPrivateLinkServiceCreateUpdate,
+		Delete: resourceArmPrivateLinkServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"load_balancer_frontend_ip_configuration_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"nat_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 8,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: resourceid.ValidateSubnetID,
+						},
+
+						"private_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.IPv4Address,
+						},
+
+						"private_ip_address_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.IPv4),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.IPv4),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"auto_approval_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"visibility_subscription_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+
+			"fqdns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateLinkServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Private Link Service creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_link_service", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	ipConfigurations, subnetNamesToLock, virtualNetworkNamesToLock, err := expandArmPrivateLinkServiceIPConfigurations(d.Get("nat_ip_configuration").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.PrivateLinkService{
+		Location: utils.String(location),
+		PrivateLinkServiceProperties: &network.PrivateLinkServiceProperties{
+			IPConfigurations:                     ipConfigurations,
+			LoadBalancerFrontendIPConfigurations: expandArmPrivateLinkServiceFrontendIPConfigurations(d.Get("load_balancer_frontend_ip_configuration_ids").(*schema.Set).List()),
+			AutoApproval: &network.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: utils.ExpandStringSlice(d.Get("auto_approval_subscription_ids").(*schema.Set).List()),
+			},
+			Visibility: &network.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: utils.ExpandStringSlice(d.Get("visibility_subscription_ids").(*schema.Set).List()),
+			},
+			Fqdns: utils.ExpandStringSlice(d.Get("fqdns").([]interface{})),
+		},
+		Tags: expandTags(t, meta),
+	}
+
+	locks.ByName(name, privateLinkServiceResourceName)
+	defer locks.UnlockByName(name, privateLinkServiceResourceName)
+
+	locks.MultipleByName(&subnetNamesToLock, subnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
+
+	locks.MultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Private Link Service %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPrivateLinkServiceRead(d, meta)
+}
+
+func resourceArmPrivateLinkServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateLinkServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Private Link Service %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil {
+		d.Set("alias", props.Alias)
+
+		if err := d.Set("nat_ip_configuration", flattenArmPrivateLinkServiceIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `nat_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("load_balancer_frontend_ip_configuration_ids", flattenArmPrivateLinkServiceFrontendIPConfigurations(props.LoadBalancerFrontendIPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `load_balancer_frontend_ip_configuration_ids`: %+v", err)
+		}
+
+		var autoApprovalSubscriptions *[]string
+		if props.AutoApproval != nil {
+			autoApprovalSubscriptions = props.AutoApproval.Subscriptions
+		}
+		if err := d.Set("auto_approval_subscription_ids", utils.FlattenStringSlice(autoApprovalSubscriptions)); err != nil {
+			return fmt.Errorf("Error setting `auto_approval_subscription_ids`: %+v", err)
+		}
+
+		var visibilitySubscriptions *[]string
+		if props.Visibility != nil {
+			visibilitySubscriptions = props.Visibility.Subscriptions
+		}
+		if err := d.Set("visibility_subscription_ids", utils.FlattenStringSlice(visibilitySubscriptions)); err != nil {
+			return fmt.Errorf("Error setting `visibility_subscription_ids`: %+v", err)
+		}
+
+		if err := d.Set("fqdns", utils.FlattenStringSlice(props.Fqdns)); err != nil {
+			return fmt.Errorf("Error setting `fqdns`: %+v", err)
+		}
+
+		networkInterfaceIds := make([]interface{}, 0)
+		if nics := props.NetworkInterfaces; nics != nil {
+			for _, nic := range *nics {
+				if nic.ID != nil {
+					networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+				}
+			}
+		}
+		if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmPrivateLinkServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateLinkServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, subnetNamesToLock, virtualNetworkNamesToLock, err := expandArmPrivateLinkServiceIPConfigurations(d.Get("nat_ip_configuration").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, privateLinkServiceResourceName)
+	defer locks.UnlockByName(id.Name, privateLinkServiceResourceName)
+
+	locks.MultipleByName(&subnetNamesToLock, subnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetNamesToLock, subnetResourceName)
+
+	locks.MultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, virtualNetworkResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Link Service %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func expandArmPrivateLinkServiceIPConfigurations(input []interface{}) (*[]network.PrivateLinkServiceIPConfiguration, []string, []string, error) {
+	results := make([]network.PrivateLinkServiceIPConfiguration, 0)
+	subnetNamesToLock := make([]string, 0)
+	virtualNetworkNamesToLock := make([]string, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		subnetId := v["subnet_id"].(string)
+		subnet, err := resourceid.ParseSubnetID(subnetId)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !sliceContainsValue(subnetNamesToLock, subnet.Name) {
+			subnetNamesToLock = append(subnetNamesToLock, subnet.Name)
+		}
+		if !sliceContainsValue(virtualNetworkNamesToLock, subnet.VirtualNetworkName) {
+			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, subnet.VirtualNetworkName)
+		}
+
+		props := network.PrivateLinkServiceIPConfigurationProperties{
+			PrivateIPAllocationMethod: network.Dynamic,
+			PrivateIPAddressVersion:   network.IPVersion(v["private_ip_address_version"].(string)),
+			Subnet: &network.Subnet{
+				ID: utils.String(subnetId),
+			},
+		}
+		if privateIpAddress := v["private_ip_address"].(string); privateIpAddress != "" {
+			props.PrivateIPAddress = utils.String(privateIpAddress)
+			props.PrivateIPAllocationMethod = network.Static
+		}
+
+		results = append(results, network.PrivateLinkServiceIPConfiguration{
+			Name: utils.String(v["name"].(string)),
+			PrivateLinkServiceIPConfigurationProperties: &props,
+		})
+	}
+
+	return &results, subnetNamesToLock, virtualNetworkNamesToLock, nil
+}
+
+func flattenArmPrivateLinkServiceIPConfigurations(input *[]network.PrivateLinkServiceIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		subnetId := ""
+		privateIpAddress := ""
+		privateIpAddressVersion := ""
+		if props := item.PrivateLinkServiceIPConfigurationProperties; props != nil {
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+			// the IP Address is only user-specified when it's allocated Statically
+			if props.PrivateIPAllocationMethod == network.Static && props.PrivateIPAddress != nil {
+				privateIpAddress = *props.PrivateIPAddress
+			}
+			privateIpAddressVersion = string(props.PrivateIPAddressVersion)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                       name,
+			"subnet_id":                  subnetId,
+			"private_ip_address":         privateIpAddress,
+			"private_ip_address_version": privateIpAddressVersion,
+		})
+	}
+
+	return results
+}
+
+func expandArmPrivateLinkServiceFrontendIPConfigurations(input []interface{}) *[]network.FrontendIPConfiguration {
+	results := make([]network.FrontendIPConfiguration, 0)
+
+	for _, item := range input {
+		results = append(results, network.FrontendIPConfiguration{
+			ID: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkServiceFrontendIPConfigurations(input *[]network.FrontendIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.ID != nil {
+			results = append(results, *item.ID)
+		}
+	}
+
+	return results
+}
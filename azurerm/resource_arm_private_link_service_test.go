@@ -0,0 +1,268 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateLinkService_basic(t *testing.T) {
+	resourceName := "azurerm_private_link_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateLinkServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateLinkService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateLinkServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "nat_ip_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer_frontend_ip_configuration_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "alias"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateLinkService_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_link_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateLinkServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateLinkService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateLinkServiceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateLinkService_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_link_service"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateLinkService_complete(t *testing.T) {
+	resourceName := "azurerm_private_link_service.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateLinkServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateLinkService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateLinkServiceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateLinkService_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateLinkServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "nat_ip_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "nat_ip_configuration.0.private_ip_address", "10.5.1.17"),
+					resource.TestCheckResourceAttr(resourceName, "auto_approval_subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateLinkServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Private Link Service not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParsePrivateLinkServiceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Network.PrivateLinkServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private Link Service %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on Network.PrivateLinkServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateLinkServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Network.PrivateLinkServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_link_service" {
+			continue
+		}
+
+		id, err := resourceid.ParsePrivateLinkServiceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.Name, ""); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Network.PrivateLinkServicesClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("Private Link Service %q (Resource Group %q) still exists", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateLinkService_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                                  = "acctestsnet-%d"
+  resource_group_name                   = "${azurerm_resource_group.test.name}"
+  virtual_network_name                  = "${azurerm_virtual_network.test.name}"
+  address_prefix                        = "10.5.1.0/24"
+  private_link_service_network_policies = "Disabled"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name      = "internal"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMPrivateLinkService_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateLinkService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_link_service" "test" {
+  name                = "acctestpls-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  load_balancer_frontend_ip_configuration_ids = ["${azurerm_lb.test.frontend_ip_configuration.0.id}"]
+
+  nat_ip_configuration {
+    name      = "primary"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateLinkService_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateLinkService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_link_service" "import" {
+  name                = "${azurerm_private_link_service.test.name}"
+  location            = "${azurerm_private_link_service.test.location}"
+  resource_group_name = "${azurerm_private_link_service.test.resource_group_name}"
+
+  load_balancer_frontend_ip_configuration_ids = ["${azurerm_lb.test.frontend_ip_configuration.0.id}"]
+
+  nat_ip_configuration {
+    name      = "primary"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMPrivateLinkService_complete(rInt int, location string) string {
+	template := testAccAzureRMPrivateLinkService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_private_link_service" "test" {
+  name                = "acctestpls-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  load_balancer_frontend_ip_configuration_ids = ["${azurerm_lb.test.frontend_ip_configuration.0.id}"]
+  auto_approval_subscription_ids              = ["${data.azurerm_client_config.current.subscription_id}"]
+  visibility_subscription_ids                 = ["${data.azurerm_client_config.current.subscription_id}"]
+
+  nat_ip_configuration {
+    name               = "primary"
+    subnet_id          = "${azurerm_subnet.test.id}"
+    private_ip_address = "10.5.1.17"
+  }
+
+  nat_ip_configuration {
+    name      = "secondary"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, template, rInt)
+}
@@ -81,7 +81,7 @@ func testCheckAzureRMRecoveryServicesVaultDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).recoveryServices.VaultsClient
+		client := testAccProvider.Meta().(*ArmClient).RecoveryServices.VaultsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -113,7 +113,7 @@ func testCheckAzureRMRecoveryServicesVaultExists(resourceName string) resource.T
 			return fmt.Errorf("Bad: no resource group found in state for Recovery Services Vault: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).recoveryServices.VaultsClient
+		client := testAccProvider.Meta().(*ArmClient).RecoveryServices.VaultsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
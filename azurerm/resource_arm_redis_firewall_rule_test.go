@@ -173,7 +173,7 @@ func testCheckAzureRMRedisFirewallRuleExists(resourceName string) resource.TestC
 		cacheName := rs.Primary.Attributes["redis_cache_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).redis.FirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).Redis.FirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, cacheName, name)
 		if err != nil {
@@ -188,7 +188,7 @@ func testCheckAzureRMRedisFirewallRuleExists(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMRedisFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resource.GroupsClient
+	client := testAccProvider.Meta().(*ArmClient).Resource.GroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -163,7 +163,7 @@ func testCheckAzureRMRelayNamespaceExists(resourceName string) resource.TestChec
 		name := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).relay.NamespacesClient
+		client := testAccProvider.Meta().(*ArmClient).Relay.NamespacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -180,7 +180,7 @@ func testCheckAzureRMRelayNamespaceExists(resourceName string) resource.TestChec
 }
 
 func testCheckAzureRMRelayNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).relay.NamespacesClient
+	client := testAccProvider.Meta().(*ArmClient).Relay.NamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
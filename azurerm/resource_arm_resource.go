@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceCreateUpdate,
+		Read:   resourceArmResourceRead,
+		Update: resourceArmResourceCreateUpdate,
+		Delete: resourceArmResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmResourceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"api_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// Computed since the location can also be specified within the `body`
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				StateFunc:        azure.NormalizeLocation,
+				DiffSuppressFunc: azure.SuppressLocationDiff,
+			},
+
+			"body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "{}",
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				StateFunc:        resourceArmResourceNormalizeJson,
+			},
+
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.GenericResourcesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	genericId := resource.GenericResourceID{
+		ParentId: d.Get("parent_id").(string),
+		Type:     d.Get("type").(string),
+		Name:     d.Get("name").(string),
+	}
+	resourceId, err := genericId.ID()
+	if err != nil {
+		return fmt.Errorf("Error building Resource ID for Resource %q (Type %q / Parent %q): %+v", genericId.Name, genericId.Type, genericId.ParentId, err)
+	}
+	apiVersion := d.Get("api_version").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceId, apiVersion)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Resource %q: %+v", resourceId, tf.WrapArmError(err))
+			}
+		}
+
+		if id, ok := existing.Value["id"].(string); ok && id != "" {
+			return tf.ImportAsExistsError("azurerm_resource", id)
+		}
+	}
+
+	body, err := structure.ExpandJsonFromString(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `body`: %+v", err)
+	}
+	if location := d.Get("location").(string); location != "" {
+		body["location"] = azure.NormalizeLocation(location)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceId, apiVersion, body)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Resource %q: %+v", resourceId, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Resource %q: %+v", resourceId, tf.WrapArmError(err))
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmResourceRead(d, meta)
+}
+
+func resourceArmResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.GenericResourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resource.ParseGenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	apiVersion := d.Get("api_version").(string)
+
+	resp, err := client.Get(ctx, d.Id(), apiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Resource %q was not found - removing from state!", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Resource %q: %+v", d.Id(), tf.WrapArmError(err))
+	}
+
+	d.Set("name", id.Name)
+	d.Set("parent_id", id.ParentId)
+	d.Set("type", id.Type)
+	d.Set("api_version", apiVersion)
+
+	if location, ok := resp.Value["location"].(string); ok {
+		d.Set("location", azure.NormalizeLocation(location))
+	}
+
+	// only the fields which are configured within the `body` are compared, since Azure populates a number of
+	// fields (and defaults) which would otherwise cause a diff - however when importing all fields are used
+	remote := resource.RemoveReadOnlyFields(resp.Value)
+	var body interface{} = remote
+	if existing := d.Get("body").(string); existing != "" {
+		configured, err := structure.ExpandJsonFromString(existing)
+		if err != nil {
+			return fmt.Errorf("Error expanding `body`: %+v", err)
+		}
+		body = resource.ProjectBody(configured, remote)
+	}
+
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Error flattening `body`: %+v", err)
+	}
+	d.Set("body", resourceArmResourceNormalizeJson(string(bodyJson)))
+
+	outputJson, err := json.Marshal(resp.Value)
+	if err != nil {
+		return fmt.Errorf("Error flattening `output`: %+v", err)
+	}
+	d.Set("output", string(outputJson))
+
+	return nil
+}
+
+func resourceArmResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Resource.GenericResourcesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	apiVersion := d.Get("api_version").(string)
+
+	future, err := client.Delete(ctx, d.Id(), apiVersion)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Resource %q: %+v", d.Id(), tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for deletion of Resource %q: %+v", d.Id(), tf.WrapArmError(err))
+	}
+
+	return nil
+}
+
+// resourceArmResourceImport parses an ID in the format `{resourceId}?api-version={apiVersion}`, since the API
+// Version is required to be able to retrieve the Resource
+func resourceArmResourceImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	input := d.Id()
+	index := strings.Index(input, "?")
+	if index == -1 {
+		return nil, fmt.Errorf("Error importing Resource %q: expected an ID in the format `{resourceId}?api-version={apiVersion}`", input)
+	}
+
+	query, err := url.ParseQuery(input[index+1:])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the API Version from %q: %+v", input, err)
+	}
+
+	apiVersion := query.Get("api-version")
+	if apiVersion == "" {
+		return nil, fmt.Errorf("Error importing Resource %q: expected an ID in the format `{resourceId}?api-version={apiVersion}`", input)
+	}
+
+	resourceId := input[:index]
+	if _, err := resource.ParseGenericResourceID(resourceId); err != nil {
+		return nil, err
+	}
+
+	d.SetId(resourceId)
+	d.Set("api_version", apiVersion)
+	// an empty body means all of the fields will be read back
+	d.Set("body", "")
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceArmResourceNormalizeJson(input interface{}) string {
+	if input == nil || input.(string) == "" {
+		return ""
+	}
+
+	normalized, err := structure.NormalizeJsonString(input)
+	if err != nil {
+		// the value is validated, so this shouldn't happen
+		return input.(string)
+	}
+
+	return normalized
+}
@@ -126,6 +126,50 @@ func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroup_defaultTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	// the default tags are configured globally, so this test can't be run in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_defaultTags(ri, location, "MSFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "MSFT"),
+				),
+			},
+			{
+				Config: testAccAzureRMResourceGroup_defaultTags(ri, location, "Contoso"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "Contoso"),
+				),
+			},
+			{
+				// adding a new default tag should apply it to the existing Resource Group
+				Config: testAccAzureRMResourceGroup_additionalDefaultTag(ri, location, "Contoso"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "Platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "Contoso"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMResourceGroupExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -137,7 +181,7 @@ func testCheckAzureRMResourceGroupExists(resourceName string) resource.TestCheck
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resource.GroupsClient
+		client := testAccProvider.Meta().(*ArmClient).Resource.GroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup)
@@ -164,7 +208,7 @@ func testCheckAzureRMResourceGroupDisappears(resourceName string) resource.TestC
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resource.GroupsClient
+		client := testAccProvider.Meta().(*ArmClient).Resource.GroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		deleteFuture, err := client.Delete(ctx, resourceGroup)
@@ -182,7 +226,7 @@ func testCheckAzureRMResourceGroupDisappears(resourceName string) resource.TestC
 }
 
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resource.GroupsClient
+	client := testAccProvider.Meta().(*ArmClient).Resource.GroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -251,3 +295,46 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMResourceGroup_defaultTags(rInt int, location string, costCenter string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  default_tags {
+    tags = {
+      environment = "Production"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    cost_center = "%s"
+  }
+}
+`, rInt, location, costCenter)
+}
+
+func testAccAzureRMResourceGroup_additionalDefaultTag(rInt int, location string, costCenter string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  default_tags {
+    tags = {
+      environment = "Production"
+      owner       = "Platform"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    cost_center = "%s"
+  }
+}
+`, rInt, location, costCenter)
+}
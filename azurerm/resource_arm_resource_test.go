@@ -0,0 +1,337 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMResource_basic(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttrSet(resourceName, "output"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAzureRMResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResource_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_resource"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_update(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMResource_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMResource_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_locationInBody(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_locationInBody(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "location", azure.NormalizeLocation(location)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAzureRMResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResource_childResource(t *testing.T) {
+	resourceName := "azurerm_resource.subnet"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_childResource(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Microsoft.Network/virtualNetworks/subnets"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAzureRMResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func testAccAzureRMResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s?api-version=%s", rs.Primary.ID, rs.Primary.Attributes["api_version"]), nil
+	}
+}
+
+func testCheckAzureRMResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		apiVersion := rs.Primary.Attributes["api_version"]
+
+		client := testAccProvider.Meta().(*ArmClient).Resource.GenericResourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, rs.Primary.ID, apiVersion)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Resource %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on GenericResourcesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Resource.GenericResourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource" {
+			continue
+		}
+
+		apiVersion := rs.Primary.Attributes["api_version"]
+
+		resp, err := client.Get(ctx, rs.Primary.ID, apiVersion)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Resource %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAzureRMResource_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource" "test" {
+  name        = "acctestvnet-%d"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2019-04-01"
+  location    = "${azurerm_resource_group.test.location}"
+
+  body = <<BODY
+{
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": ["10.0.0.0/16"]
+    }
+  }
+}
+BODY
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMResource_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMResource_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "import" {
+  name        = "${azurerm_resource.test.name}"
+  parent_id   = "${azurerm_resource.test.parent_id}"
+  type        = "${azurerm_resource.test.type}"
+  api_version = "${azurerm_resource.test.api_version}"
+  location    = "${azurerm_resource.test.location}"
+  body        = "${azurerm_resource.test.body}"
+}
+`, template)
+}
+
+func testAccAzureRMResource_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource" "test" {
+  name        = "acctestvnet-%d"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2019-04-01"
+  location    = "${azurerm_resource_group.test.location}"
+
+  body = <<BODY
+{
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": ["10.0.0.0/16", "10.1.0.0/16"]
+    }
+  },
+  "tags": {
+    "environment": "Production"
+  }
+}
+BODY
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMResource_locationInBody(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource" "test" {
+  name        = "acctestnsg-%d"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Network/networkSecurityGroups"
+  api_version = "2019-04-01"
+
+  body = <<BODY
+{
+  "location": "${azurerm_resource_group.test.location}"
+}
+BODY
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMResource_childResource(rInt int, location string) string {
+	template := testAccAzureRMResource_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "subnet" {
+  name        = "internal"
+  parent_id   = "${azurerm_resource.test.id}"
+  type        = "Microsoft.Network/virtualNetworks/subnets"
+  api_version = "2019-04-01"
+
+  body = <<BODY
+{
+  "properties": {
+    "addressPrefix": "10.0.2.0/24"
+  }
+}
+BODY
+}
+`, template)
+}
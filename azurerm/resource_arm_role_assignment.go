@@ -1,19 +1,19 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
-
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +26,12 @@ func resourceArmRoleAssignment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -69,9 +75,10 @@ func resourceArmRoleAssignment() *schema.Resource {
 }
 
 func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	roleAssignmentsClient := meta.(*ArmClient).authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*ArmClient).authorization.RoleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	roleAssignmentsClient := meta.(*ArmClient).Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*ArmClient).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -83,7 +90,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		roleName := v.(string)
 		roleDefinitions, err := roleDefinitionsClient.List(ctx, scope, fmt.Sprintf("roleName eq '%s'", roleName))
 		if err != nil {
-			return fmt.Errorf("Error loading Role Definition List: %+v", err)
+			return fmt.Errorf("Error loading Role Definition List: %+v", tf.WrapArmError(err))
 		}
 		if len(roleDefinitions.Values()) != 1 {
 			return fmt.Errorf("Error loading Role Definition List: could not find role '%s'", roleName)
@@ -99,7 +106,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 	if name == "" {
 		uuid, err := uuid.GenerateUUID()
 		if err != nil {
-			return fmt.Errorf("Error generating UUID for Role Assignment: %+v", err)
+			return fmt.Errorf("Error generating UUID for Role Assignment: %+v", tf.WrapArmError(err))
 		}
 
 		name = uuid
@@ -109,7 +116,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		existing, err := roleAssignmentsClient.Get(ctx, scope, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Role Assignment ID for %q (Scope %q): %+v", name, scope, err)
+				return fmt.Errorf("Error checking for presence of existing Role Assignment ID for %q (Scope %q): %+v", name, scope, tf.WrapArmError(err))
 			}
 		}
 
@@ -125,7 +132,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
-	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(scope, name, properties, meta)); err != nil {
+	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(ctx, scope, name, properties, meta)); err != nil {
 		return err
 	}
 
@@ -142,9 +149,10 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).authorization.RoleAssignmentsClient
-	roleDefinitionsClient := meta.(*ArmClient).authorization.RoleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*ArmClient).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetByID(ctx, d.Id())
 	if err != nil {
@@ -154,7 +162,7 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error loading Role Assignment %q: %+v", d.Id(), err)
+		return fmt.Errorf("Error loading Role Assignment %q: %+v", d.Id(), tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -168,7 +176,7 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 		if roleId := props.RoleDefinitionID; roleId != nil {
 			roleResp, err := roleDefinitionsClient.GetByID(ctx, *roleId)
 			if err != nil {
-				return fmt.Errorf("Error loading Role Definition %q: %+v", *roleId, err)
+				return fmt.Errorf("Error loading Role Definition %q: %+v", *roleId, tf.WrapArmError(err))
 			}
 
 			if roleProps := roleResp.RoleDefinitionProperties; roleProps != nil {
@@ -181,8 +189,9 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).authorization.RoleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Authorization.RoleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseRoleAssignmentId(d.Id())
 	if err != nil {
@@ -211,10 +220,9 @@ func validateRoleDefinitionName(i interface{}, k string) ([]string, []error) {
 	return nil, nil
 }
 
-func retryRoleAssignmentsClient(scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}) func() *resource.RetryError {
+func retryRoleAssignmentsClient(ctx context.Context, scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		roleAssignmentsClient := meta.(*ArmClient).authorization.RoleAssignmentsClient
-		ctx := meta.(*ArmClient).StopContext
+		roleAssignmentsClient := meta.(*ArmClient).Authorization.RoleAssignmentsClient
 
 		resp, err := roleAssignmentsClient.Create(ctx, scope, name, properties)
 		if err != nil {
@@ -248,7 +248,7 @@ func testCheckAzureRMRoleAssignmentExists(resourceName string) resource.TestChec
 		scope := rs.Primary.Attributes["scope"]
 		roleAssignmentName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).authorization.RoleAssignmentsClient
+		client := testAccProvider.Meta().(*ArmClient).Authorization.RoleAssignmentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, roleAssignmentName)
 
@@ -272,7 +272,7 @@ func testCheckAzureRMRoleAssignmentDestroy(s *terraform.State) error {
 		scope := rs.Primary.Attributes["scope"]
 		roleAssignmentName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).authorization.RoleAssignmentsClient
+		client := testAccProvider.Meta().(*ArmClient).Authorization.RoleAssignmentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, roleAssignmentName)
 
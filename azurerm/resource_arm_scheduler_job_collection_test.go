@@ -97,7 +97,7 @@ func testCheckAzureRMSchedulerJobCollectionDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).scheduler.JobCollectionsClient
+		client := testAccProvider.Meta().(*ArmClient).Scheduler.JobCollectionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -130,7 +130,7 @@ func testCheckAzureRMSchedulerJobCollectionExists(resourceName string) resource.
 			return fmt.Errorf("Bad: no resource group found in state for Scheduler Job Collection: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).scheduler.JobCollectionsClient
+		client := testAccProvider.Meta().(*ArmClient).Scheduler.JobCollectionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
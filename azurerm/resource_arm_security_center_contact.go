@@ -3,12 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -29,6 +30,13 @@ func resourceArmSecurityCenterContact() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Type:         schema.TypeString,
@@ -56,8 +64,9 @@ func resourceArmSecurityCenterContact() *schema.Resource {
 }
 
 func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).SecurityCenter.ContactsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterContactName
 
@@ -65,7 +74,7 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Security Center Contact: %+v", err)
+				return fmt.Errorf("Error checking for presence of existing Security Center Contact: %+v", tf.WrapArmError(err))
 			}
 		}
 
@@ -96,12 +105,12 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 	if d.IsNewResource() {
 
 		if _, err := client.Create(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error creating Security Center Contact: %+v", err)
+			return fmt.Errorf("Error creating Security Center Contact: %+v", tf.WrapArmError(err))
 		}
 
 		resp, err := client.Get(ctx, name)
 		if err != nil {
-			return fmt.Errorf("Error reading Security Center Contact: %+v", err)
+			return fmt.Errorf("Error reading Security Center Contact: %+v", tf.WrapArmError(err))
 		}
 		if resp.ID == nil {
 			return fmt.Errorf("Security Center Contact ID is nil")
@@ -110,7 +119,7 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 		d.SetId(*resp.ID)
 	} else {
 		if _, err := client.Update(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error updating Security Center Contact: %+v", err)
+			return fmt.Errorf("Error updating Security Center Contact: %+v", tf.WrapArmError(err))
 		}
 	}
 
@@ -118,8 +127,9 @@ func resourceArmSecurityCenterContactCreateUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).SecurityCenter.ContactsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterContactName
 
@@ -131,7 +141,7 @@ func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Security Center Contact: %+v", err)
+		return fmt.Errorf("Error reading Security Center Contact: %+v", tf.WrapArmError(err))
 	}
 
 	if properties := resp.ContactProperties; properties != nil {
@@ -144,9 +154,10 @@ func resourceArmSecurityCenterContactRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceArmSecurityCenterContactDelete(_ *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.ContactsClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmSecurityCenterContactDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).SecurityCenter.ContactsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterContactName
 
@@ -157,7 +168,7 @@ func resourceArmSecurityCenterContactDelete(_ *schema.ResourceData, meta interfa
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Security Center Contact: %+v", err)
+		return fmt.Errorf("Error deleting Security Center Contact: %+v", tf.WrapArmError(err))
 	}
 
 	return nil
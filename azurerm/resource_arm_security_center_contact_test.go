@@ -157,7 +157,7 @@ func testAccAzureRMSecurityCenterContact_phoneOptional(t *testing.T) {
 
 func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ArmClient).securityCenter.ContactsClient
+		client := testAccProvider.Meta().(*ArmClient).SecurityCenter.ContactsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -181,7 +181,7 @@ func testCheckAzureRMSecurityCenterContactExists(resourceName string) resource.T
 }
 
 func testCheckAzureRMSecurityCenterContactDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).securityCenter.ContactsClient
+	client := testAccProvider.Meta().(*ArmClient).SecurityCenter.ContactsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 	for _, res := range s.RootModule().Resources {
 		if res.Type != "azurerm_security_center_contact" {
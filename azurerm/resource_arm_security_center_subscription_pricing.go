@@ -3,10 +3,13 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +28,13 @@ func resourceArmSecurityCenterSubscriptionPricing() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tier": {
 				Type:     schema.TypeString,
@@ -39,8 +49,9 @@ func resourceArmSecurityCenterSubscriptionPricing() *schema.Resource {
 }
 
 func resourceArmSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.PricingClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).SecurityCenter.PricingClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterSubscriptionPricingName
 
@@ -54,12 +65,12 @@ func resourceArmSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData,
 	}
 
 	if _, err := client.UpdateSubscriptionPricing(ctx, name, pricing); err != nil {
-		return fmt.Errorf("Error creating/updating Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error creating/updating Security Center Subscription pricing: %+v", tf.WrapArmError(err))
 	}
 
 	resp, err := client.GetSubscriptionPricing(ctx, name)
 	if err != nil {
-		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", tf.WrapArmError(err))
 	}
 	if resp.ID == nil {
 		return fmt.Errorf("Security Center Subscription pricing ID is nil")
@@ -71,8 +82,9 @@ func resourceArmSecurityCenterSubscriptionPricingUpdate(d *schema.ResourceData,
 }
 
 func resourceArmSecurityCenterSubscriptionPricingRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.PricingClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).SecurityCenter.PricingClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetSubscriptionPricing(ctx, securityCenterSubscriptionPricingName)
 	if err != nil {
@@ -82,7 +94,7 @@ func resourceArmSecurityCenterSubscriptionPricingRead(d *schema.ResourceData, me
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", tf.WrapArmError(err))
 	}
 
 	if properties := resp.PricingProperties; properties != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -29,6 +30,13 @@ func resourceArmSecurityCenterWorkspace() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scope": {
 				Type:         schema.TypeString,
@@ -46,9 +54,10 @@ func resourceArmSecurityCenterWorkspace() *schema.Resource {
 }
 
 func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	priceClient := meta.(*ArmClient).securityCenter.PricingClient
-	client := meta.(*ArmClient).securityCenter.WorkspaceClient
-	ctx := meta.(*ArmClient).StopContext
+	priceClient := meta.(*ArmClient).SecurityCenter.PricingClient
+	client := meta.(*ArmClient).SecurityCenter.WorkspaceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := securityCenterWorkspaceName
 
@@ -56,7 +65,7 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 		existing, err := client.Get(ctx, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Security Center Workspace: %+v", err)
+				return fmt.Errorf("Error checking for presence of existing Security Center Workspace: %+v", tf.WrapArmError(err))
 			}
 		}
 
@@ -69,7 +78,7 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 	//API does not error, it just doesn't set the workspace scope
 	price, err := priceClient.GetSubscriptionPricing(ctx, securityCenterSubscriptionPricingName)
 	if err != nil {
-		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", err)
+		return fmt.Errorf("Error reading Security Center Subscription pricing: %+v", tf.WrapArmError(err))
 	}
 
 	if price.PricingProperties == nil {
@@ -88,11 +97,11 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 
 	if d.IsNewResource() {
 		if _, err = client.Create(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error creating Security Center Workspace: %+v", err)
+			return fmt.Errorf("Error creating Security Center Workspace: %+v", tf.WrapArmError(err))
 		}
 	} else {
 		if _, err = client.Update(ctx, name, contact); err != nil {
-			return fmt.Errorf("Error updating Security Center Workspace: %+v", err)
+			return fmt.Errorf("Error updating Security Center Workspace: %+v", tf.WrapArmError(err))
 		}
 	}
 
@@ -121,7 +130,7 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 
 	resp, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("Error waiting: %+v", err)
+		return fmt.Errorf("Error waiting: %+v", tf.WrapArmError(err))
 	}
 
 	if d.IsNewResource() {
@@ -132,8 +141,9 @@ func resourceArmSecurityCenterWorkspaceCreateUpdate(d *schema.ResourceData, meta
 }
 
 func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.WorkspaceClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).SecurityCenter.WorkspaceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, securityCenterWorkspaceName)
 	if err != nil {
@@ -143,7 +153,7 @@ func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interfa
 			return nil
 		}
 
-		return fmt.Errorf("Error reading Security Center Workspace: %+v", err)
+		return fmt.Errorf("Error reading Security Center Workspace: %+v", tf.WrapArmError(err))
 	}
 
 	if properties := resp.WorkspaceSettingProperties; properties != nil {
@@ -154,9 +164,10 @@ func resourceArmSecurityCenterWorkspaceRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceArmSecurityCenterWorkspaceDelete(_ *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).securityCenter.WorkspaceClient
-	ctx := meta.(*ArmClient).StopContext
+func resourceArmSecurityCenterWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).SecurityCenter.WorkspaceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Delete(ctx, securityCenterWorkspaceName)
 	if err != nil {
@@ -165,7 +176,7 @@ func resourceArmSecurityCenterWorkspaceDelete(_ *schema.ResourceData, meta inter
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Security Center Workspace: %+v", err)
+		return fmt.Errorf("Error deleting Security Center Workspace: %+v", tf.WrapArmError(err))
 	}
 
 	return nil
@@ -5,16 +5,17 @@ import (
 	"log"
 	"regexp"
 	"strings"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -36,6 +37,13 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 		MigrateState:  resourceAzureRMServiceBusNamespaceMigrateState,
 		SchemaVersion: 1,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -70,6 +78,8 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 				ValidateFunc: validate.IntInSlice([]int{0, 1, 2, 4}),
 			},
 
+			"network_rulesets": azure.SchemaServiceBusNamespaceNetworkRuleSets(),
+
 			"default_primary_connection_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -100,8 +110,9 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 }
 
 func resourceArmServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).servicebus.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM ServiceBus Namespace creation.")
 
@@ -130,7 +141,7 @@ func resourceArmServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta int
 			Name: servicebus.SkuName(sku),
 			Tier: servicebus.SkuTier(sku),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if capacity := d.Get("capacity"); capacity != nil {
@@ -143,6 +154,11 @@ func resourceArmServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta int
 		parameters.Sku.Capacity = utils.Int32(int32(capacity.(int)))
 	}
 
+	networkRuleSets, hasNetworkRuleSets := d.GetOk("network_rulesets")
+	if hasNetworkRuleSets && !strings.EqualFold(sku, string(servicebus.Premium)) {
+		return fmt.Errorf("`network_rulesets` can only be specified for Service Bus SKU %q", string(servicebus.Premium))
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
 	if err != nil {
 		return err
@@ -152,6 +168,13 @@ func resourceArmServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta int
 		return err
 	}
 
+	if hasNetworkRuleSets && d.HasChange("network_rulesets") {
+		ruleSet := azure.ExpandServiceBusNamespaceNetworkRuleSets(networkRuleSets.([]interface{}))
+		if _, err := client.CreateOrUpdateNetworkRuleSet(ctx, resourceGroup, name, ruleSet); err != nil {
+			return fmt.Errorf("Error setting Network Rule Sets for ServiceBus Namespace %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return err
@@ -167,8 +190,9 @@ func resourceArmServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).servicebus.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -183,7 +207,7 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure ServiceBus Namespace %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", resp.Name)
@@ -197,6 +221,22 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 		d.Set("capacity", sku.Capacity)
 	}
 
+	// Network Rule Sets are only supported on the Premium SKU
+	networkRuleSets := make([]interface{}, 0)
+	if sku := resp.Sku; sku != nil && sku.Tier == servicebus.SkuTierPremium {
+		ruleSet, err := client.GetNetworkRuleSet(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(ruleSet.Response) {
+				return fmt.Errorf("Error retrieving Network Rule Sets for ServiceBus Namespace %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
+			}
+		} else {
+			networkRuleSets = azure.FlattenServiceBusNamespaceNetworkRuleSets(ruleSet.NetworkRuleSetProperties)
+		}
+	}
+	if err := d.Set("network_rulesets", networkRuleSets); err != nil {
+		return fmt.Errorf("Error setting `network_rulesets`: %+v", err)
+	}
+
 	keys, err := client.ListKeys(ctx, resourceGroup, name, serviceBusNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] Unable to List default keys for Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -207,14 +247,15 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmServiceBusNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).servicebus.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -233,7 +274,7 @@ func resourceArmServiceBusNamespaceDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting Service Bus %q: %+v", name, err)
+		return fmt.Errorf("Error deleting Service Bus %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	return nil
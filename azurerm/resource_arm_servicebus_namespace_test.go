@@ -146,6 +146,51 @@ func TestAccAzureRMServiceBusNamespace_premium(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_networkRuleSets(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceBusNamespace_networkRuleSets(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.default_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.ip_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.ip_rule.0.ip_mask", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.0.ignore_missing_vnet_service_endpoint", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMServiceBusNamespace_networkRuleSetsUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.default_action", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.ip_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_rulesets.0.virtual_network_rule.0.ignore_missing_vnet_service_endpoint", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMServiceBusNamespace_basicCapacity(t *testing.T) {
 	ri := tf.AccRandTimeInt()
 	config := testAccAzureRMServiceBusNamespace_basicCapacity(ri, testLocation())
@@ -181,7 +226,7 @@ func TestAccAzureRMServiceBusNamespace_premiumCapacity(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusNamespaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).servicebus.NamespacesClient
+	client := testAccProvider.Meta().(*ArmClient).ServiceBus.NamespacesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -220,7 +265,7 @@ func testCheckAzureRMServiceBusNamespaceExists(resourceName string) resource.Tes
 			return fmt.Errorf("Bad: no resource group found in state for Service Bus Namespace: %s", namespaceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).servicebus.NamespacesClient
+		client := testAccProvider.Meta().(*ArmClient).ServiceBus.NamespacesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, namespaceName)
@@ -331,3 +376,78 @@ resource "azurerm_servicebus_namespace" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMServiceBusNamespace_networkRuleSetsTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  service_endpoints    = ["Microsoft.ServiceBus"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMServiceBusNamespace_networkRuleSets(rInt int, location string) string {
+	template := testAccAzureRMServiceBusNamespace_networkRuleSetsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+
+  network_rulesets {
+    default_action = "Deny"
+
+    ip_rule {
+      ip_mask = "10.0.0.0/16"
+    }
+
+    virtual_network_rule {
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMServiceBusNamespace_networkRuleSetsUpdated(rInt int, location string) string {
+	template := testAccAzureRMServiceBusNamespace_networkRuleSetsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+
+  network_rulesets {
+    default_action = "Allow"
+
+    virtual_network_rule {
+      subnet_id                            = "${azurerm_subnet.test.id}"
+      ignore_missing_vnet_service_endpoint = true
+    }
+  }
+}
+`, template, rInt)
+}
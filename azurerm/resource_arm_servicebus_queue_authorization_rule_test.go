@@ -132,7 +132,7 @@ func TestAccAzureRMServiceBusQueueAuthorizationRule_requiresImport(t *testing.T)
 }
 
 func testCheckAzureRMServiceBusQueueAuthorizationRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).servicebus.QueuesClient
+	conn := testAccProvider.Meta().(*ArmClient).ServiceBus.QueuesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -172,7 +172,7 @@ func testCheckAzureRMServiceBusQueueAuthorizationRuleExists(resourceName string)
 			return fmt.Errorf("Bad: no resource group found in state for ServiceBus Queue Authorization Rule: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).servicebus.QueuesClient
+		conn := testAccProvider.Meta().(*ArmClient).ServiceBus.QueuesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.GetAuthorizationRule(ctx, resourceGroup, namespaceName, queueName, name)
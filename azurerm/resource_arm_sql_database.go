@@ -6,16 +6,18 @@ import (
 	"strings"
 	"time"
 
-	uuid "github.com/satori/go.uuid"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	sqlpreview "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	sqlSvc "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -30,6 +32,13 @@ func resourceArmSqlDatabase() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -308,6 +317,60 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Default:  false,
 			},
 
+			"short_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 35),
+						},
+					},
+				},
+			},
+
+			"long_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"weekly_retention": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"monthly_retention": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"yearly_retention": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+						},
+
+						"week_of_year": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 52),
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 
@@ -327,14 +390,24 @@ func resourceArmSqlDatabase() *schema.Resource {
 				}
 			}
 
+			if ltr := diff.Get("long_term_retention_policy").([]interface{}); len(ltr) > 0 && ltr[0] != nil {
+				policy := ltr[0].(map[string]interface{})
+
+				yearlyRetention := policy["yearly_retention"].(string)
+				if yearlyRetention != "" && yearlyRetention != "PT0S" && policy["week_of_year"].(int) == 0 {
+					return fmt.Errorf("`week_of_year` must be specified when `yearly_retention` is set")
+				}
+			}
+
 			return nil
 		},
 	}
 }
 
 func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).sql.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	serverName := d.Get("server_name").(string)
@@ -347,7 +420,7 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		existing, err := client.Get(ctx, resourceGroup, serverName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+				return fmt.Errorf("Error checking for presence of existing SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
 			}
 		}
 
@@ -366,7 +439,7 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		DatabaseProperties: &sql.DatabaseProperties{
 			CreateMode: sql.CreateMode(createMode),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("source_database_id"); ok {
@@ -448,11 +521,11 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
 	if err != nil {
-		return fmt.Errorf("Error issuing create/update request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error issuing create/update request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting on create/update future for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error waiting on create/update future for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
 	}
 
 	if _, ok := d.GetOk("import"); ok {
@@ -477,22 +550,53 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	resp, err := client.Get(ctx, resourceGroup, serverName, name, "")
 	if err != nil {
-		return fmt.Errorf("Error issuing get request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+		return fmt.Errorf("Error issuing get request for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
 	}
 
 	d.SetId(*resp.ID)
 
-	threatDetectionClient := meta.(*ArmClient).sql.DatabaseThreatDetectionPoliciesClient
+	threatDetectionClient := meta.(*ArmClient).Sql.DatabaseThreatDetectionPoliciesClient
 	if _, err = threatDetectionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, *threatDetection); err != nil {
 		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
 	}
 
+	if d.HasChange("short_term_retention_policy") {
+		if v := d.Get("short_term_retention_policy").([]interface{}); len(v) > 0 {
+			shortTermRetentionClient := meta.(*ArmClient).Sql.BackupShortTermRetentionPoliciesClient
+			shortTermRetention := expandArmSqlDatabaseShortTermRetentionPolicy(v)
+			shortTermFuture, err := shortTermRetentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, shortTermRetention)
+			if err != nil {
+				return fmt.Errorf("Error setting Short Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+
+			if err = shortTermFuture.WaitForCompletionRef(ctx, shortTermRetentionClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for the Short Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+		}
+	}
+
+	if d.HasChange("long_term_retention_policy") {
+		if v := d.Get("long_term_retention_policy").([]interface{}); len(v) > 0 {
+			longTermRetentionClient := meta.(*ArmClient).Sql.BackupLongTermRetentionPoliciesClient
+			longTermRetention := expandArmSqlDatabaseLongTermRetentionPolicy(v)
+			longTermFuture, err := longTermRetentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, longTermRetention)
+			if err != nil {
+				return fmt.Errorf("Error setting Long Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+
+			if err = longTermFuture.WaitForCompletionRef(ctx, longTermRetentionClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for the Long Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+		}
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
 func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).sql.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -511,10 +615,10 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on Sql Database %s: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Sql Database %s: %+v", name, tf.WrapArmError(err))
 	}
 
-	threatDetectionClient := meta.(*ArmClient).sql.DatabaseThreatDetectionPoliciesClient
+	threatDetectionClient := meta.(*ArmClient).Sql.DatabaseThreatDetectionPoliciesClient
 	threatDetection, err := threatDetectionClient.Get(ctx, resourceGroup, serverName, name)
 	if err == nil {
 		flattenedThreatDetection := flattenArmSqlServerThreatDetectionPolicy(d, threatDetection)
@@ -566,16 +670,38 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		} else {
 			d.Set("read_scale", false)
 		}
+
+		// Backup Retention Policies aren't supported for Data Warehouses
+		if props.Edition != sql.DataWarehouse {
+			shortTermRetentionClient := meta.(*ArmClient).Sql.BackupShortTermRetentionPoliciesClient
+			shortTermRetention, err := shortTermRetentionClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Short Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+			if err := d.Set("short_term_retention_policy", flattenArmSqlDatabaseShortTermRetentionPolicy(shortTermRetention.BackupShortTermRetentionPolicyProperties)); err != nil {
+				return fmt.Errorf("Error setting `short_term_retention_policy`: %+v", err)
+			}
+
+			longTermRetentionClient := meta.(*ArmClient).Sql.BackupLongTermRetentionPoliciesClient
+			longTermRetention, err := longTermRetentionClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Long Term Retention Policy for SQL Database %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+			if err := d.Set("long_term_retention_policy", flattenArmSqlDatabaseLongTermRetentionPolicy(longTermRetention.Properties)); err != nil {
+				return fmt.Errorf("Error setting `long_term_retention_policy`: %+v", err)
+			}
+		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).sql.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -592,7 +718,7 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 
-		return fmt.Errorf("Error deleting SQL Database: %+v", err)
+		return fmt.Errorf("Error deleting SQL Database: %+v", tf.WrapArmError(err))
 	}
 
 	return nil
@@ -731,3 +857,94 @@ func expandArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, location st
 
 	return &policy, nil
 }
+
+func expandArmSqlDatabaseShortTermRetentionPolicy(input []interface{}) sqlpreview.BackupShortTermRetentionPolicy {
+	policy := input[0].(map[string]interface{})
+
+	return sqlpreview.BackupShortTermRetentionPolicy{
+		BackupShortTermRetentionPolicyProperties: &sqlpreview.BackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(policy["retention_days"].(int))),
+		},
+	}
+}
+
+func flattenArmSqlDatabaseShortTermRetentionPolicy(input *sqlpreview.BackupShortTermRetentionPolicyProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	retentionDays := 0
+	if input.RetentionDays != nil {
+		retentionDays = int(*input.RetentionDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"retention_days": retentionDays,
+		},
+	}
+}
+
+func expandArmSqlDatabaseLongTermRetentionPolicy(input []interface{}) sqlSvc.BackupLongTermRetentionPolicyProperties {
+	// a retention period of `PT0S` disables the retention of that backup
+	properties := sqlSvc.BackupLongTermRetentionPolicyProperties{
+		WeeklyRetention:  utils.String("PT0S"),
+		MonthlyRetention: utils.String("PT0S"),
+		YearlyRetention:  utils.String("PT0S"),
+	}
+
+	if input[0] == nil {
+		return properties
+	}
+	policy := input[0].(map[string]interface{})
+
+	if v := policy["weekly_retention"].(string); v != "" {
+		properties.WeeklyRetention = utils.String(v)
+	}
+	if v := policy["monthly_retention"].(string); v != "" {
+		properties.MonthlyRetention = utils.String(v)
+	}
+	if v := policy["yearly_retention"].(string); v != "" {
+		properties.YearlyRetention = utils.String(v)
+	}
+	if v := policy["week_of_year"].(int); v != 0 {
+		properties.WeekOfYear = utils.Int32(int32(v))
+	}
+
+	return properties
+}
+
+func flattenArmSqlDatabaseLongTermRetentionPolicy(input *sqlSvc.BackupLongTermRetentionPolicyProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	weeklyRetention := ""
+	if input.WeeklyRetention != nil {
+		weeklyRetention = *input.WeeklyRetention
+	}
+
+	monthlyRetention := ""
+	if input.MonthlyRetention != nil {
+		monthlyRetention = *input.MonthlyRetention
+	}
+
+	yearlyRetention := ""
+	if input.YearlyRetention != nil {
+		yearlyRetention = *input.YearlyRetention
+	}
+
+	weekOfYear := 0
+	if input.WeekOfYear != nil {
+		weekOfYear = int(*input.WeekOfYear)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"weekly_retention":  weeklyRetention,
+			"monthly_retention": monthlyRetention,
+			"yearly_retention":  yearlyRetention,
+			"week_of_year":      weekOfYear,
+		},
+	}
+}
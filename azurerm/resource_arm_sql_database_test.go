@@ -328,6 +328,53 @@ func TestAccAzureRMSqlDatabase_readScale(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlDatabase_backupRetentionPolicies(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabase_backupRetentionPolicies(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "short_term_retention_policy.0.retention_days", "14"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.weekly_retention", "P12W"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.monthly_retention", "P12M"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.yearly_retention", "P7Y"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.week_of_year", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_mode"},
+			},
+			{
+				Config: testAccAzureRMSqlDatabase_backupRetentionPoliciesUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "short_term_retention_policy.0.retention_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.weekly_retention", "P1W"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.monthly_retention", "PT0S"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.yearly_retention", "PT0S"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSqlDatabaseExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -340,7 +387,7 @@ func testCheckAzureRMSqlDatabaseExists(resourceName string) resource.TestCheckFu
 		serverName := rs.Primary.Attributes["server_name"]
 		databaseName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sql.DatabasesClient
+		client := testAccProvider.Meta().(*ArmClient).Sql.DatabasesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, "")
@@ -366,7 +413,7 @@ func testCheckAzureRMSqlDatabaseDestroy(s *terraform.State) error {
 		serverName := rs.Primary.Attributes["server_name"]
 		databaseName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sql.DatabasesClient
+		client := testAccProvider.Meta().(*ArmClient).Sql.DatabasesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, "")
@@ -396,7 +443,7 @@ func testCheckAzureRMSqlDatabaseDisappears(resourceName string) resource.TestChe
 		serverName := rs.Primary.Attributes["server_name"]
 		databaseName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sql.DatabasesClient
+		client := testAccProvider.Meta().(*ArmClient).Sql.DatabasesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		if _, err := client.Delete(ctx, resourceGroup, serverName, databaseName); err != nil {
@@ -848,3 +895,82 @@ resource "azurerm_sql_database" "test" {
 }
 `, rInt, location, rInt, rInt, readScale)
 }
+
+func testAccAzureRMSqlDatabase_backupRetentionPolicies(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+
+  short_term_retention_policy {
+    retention_days = 14
+  }
+
+  long_term_retention_policy {
+    weekly_retention  = "P12W"
+    monthly_retention = "P12M"
+    yearly_retention  = "P7Y"
+    week_of_year      = 1
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMSqlDatabase_backupRetentionPoliciesUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+
+  short_term_retention_policy {
+    retention_days = 7
+  }
+
+  long_term_retention_policy {
+    weekly_retention  = "P1W"
+    monthly_retention = "PT0S"
+    yearly_retention  = "PT0S"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,403 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlFailoverGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlFailoverGroupCreateUpdate,
+		Read:   resourceArmSqlFailoverGroupRead,
+		Update: resourceArmSqlFailoverGroupCreateUpdate,
+		Delete: resourceArmSqlFailoverGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlFailoverGroupName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceid.ValidateSqlDatabaseID,
+				},
+				Set: schema.HashString,
+			},
+
+			"partner_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: resourceid.ValidateSqlServerID,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.ReadOnlyEndpointFailoverPolicyDisabled),
+								string(sql.ReadOnlyEndpointFailoverPolicyEnabled),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			// the role changes when a failover is triggered outside of Terraform, so this is Computed only
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.FailoverGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for SQL Failover Group creation.")
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing SQL Failover Group %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_sql_failover_group", *existing.ID)
+		}
+	}
+
+	readWriteEndpoint, err := expandSqlFailoverGroupReadWriteEndpoint(d.Get("read_write_endpoint_failover_policy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `read_write_endpoint_failover_policy`: %+v", err)
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+
+	properties := sql.FailoverGroup{
+		FailoverGroupProperties: &sql.FailoverGroupProperties{
+			ReadOnlyEndpoint:  expandSqlFailoverGroupReadOnlyEndpoint(d.Get("readonly_endpoint_failover_policy").([]interface{})),
+			ReadWriteEndpoint: readWriteEndpoint,
+			PartnerServers:    expandSqlFailoverGroupPartnerServers(d.Get("partner_servers").([]interface{})),
+			Databases:         utils.ExpandStringSlice(d.Get("databases").(*schema.Set).List()),
+		},
+		Tags: expandTags(t, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Failover Group %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Failover Group %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, tf.WrapArmError(err))
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of SQL Failover Group %q (Resource Group %q, Server %q)", name, resourceGroup, serverName)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlFailoverGroupRead(d, meta)
+}
+
+func resourceArmSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.FailoverGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseSqlFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] SQL Failover Group %q was not found (Resource Group %q, Server %q) - removing from state", id.Name, id.ResourceGroup, id.ServerName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (Resource Group %q, Server %q): %+v", id.Name, id.ResourceGroup, id.ServerName, tf.WrapArmError(err))
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.ServerName)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.FailoverGroupProperties; props != nil {
+		if err := d.Set("read_write_endpoint_failover_policy", flattenSqlFailoverGroupReadWriteEndpoint(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("readonly_endpoint_failover_policy", flattenSqlFailoverGroupReadOnlyEndpoint(props.ReadOnlyEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `readonly_endpoint_failover_policy`: %+v", err)
+		}
+
+		// this is read through `server_name` even once it's the secondary (e.g. after a planned failover),
+		// which returns the Databases on `server_name` - matching those which were specified
+		if err := d.Set("databases", utils.FlattenStringSlice(props.Databases)); err != nil {
+			return fmt.Errorf("Error setting `databases`: %+v", err)
+		}
+
+		if err := d.Set("partner_servers", flattenSqlFailoverGroupPartnerServers(props.PartnerServers)); err != nil {
+			return fmt.Errorf("Error setting `partner_servers`: %+v", err)
+		}
+
+		d.Set("role", string(props.ReplicationRole))
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Sql.FailoverGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseSqlFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting SQL Failover Group %q (Resource Group %q, Server %q): %+v", id.Name, id.ResourceGroup, id.ServerName, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Failover Group %q (Resource Group %q, Server %q): %+v", id.Name, id.ResourceGroup, id.ServerName, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func expandSqlFailoverGroupReadWriteEndpoint(input []interface{}) (*sql.FailoverGroupReadWriteEndpoint, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	mode := sql.ReadWriteEndpointFailoverPolicy(v["mode"].(string))
+	graceMinutes := v["grace_minutes"].(int)
+
+	endpoint := sql.FailoverGroupReadWriteEndpoint{
+		FailoverPolicy: mode,
+	}
+
+	switch mode {
+	case sql.Automatic:
+		if graceMinutes == 0 {
+			return nil, fmt.Errorf("`grace_minutes` must be specified when `mode` is `Automatic`")
+		}
+		endpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(graceMinutes))
+	case sql.Manual:
+		if graceMinutes != 0 {
+			return nil, fmt.Errorf("`grace_minutes` can only be specified when `mode` is `Automatic`")
+		}
+	}
+
+	return &endpoint, nil
+}
+
+func flattenSqlFailoverGroupReadWriteEndpoint(input *sql.FailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+func expandSqlFailoverGroupReadOnlyEndpoint(input []interface{}) *sql.FailoverGroupReadOnlyEndpoint {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &sql.FailoverGroupReadOnlyEndpoint{
+		FailoverPolicy: sql.ReadOnlyEndpointFailoverPolicy(v["mode"].(string)),
+	}
+}
+
+func flattenSqlFailoverGroupReadOnlyEndpoint(input *sql.FailoverGroupReadOnlyEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": string(input.FailoverPolicy),
+		},
+	}
+}
+
+func expandSqlFailoverGroupPartnerServers(input []interface{}) *[]sql.PartnerInfo {
+	results := make([]sql.PartnerInfo, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, sql.PartnerInfo{
+			ID: utils.String(v["id"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenSqlFailoverGroupPartnerServers(input *[]sql.PartnerInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, server := range *input {
+		output := map[string]interface{}{
+			"role": string(server.ReplicationRole),
+		}
+
+		if server.ID != nil {
+			output["id"] = *server.ID
+		}
+
+		if server.Location != nil {
+			output["location"] = azure.NormalizeLocation(*server.Location)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
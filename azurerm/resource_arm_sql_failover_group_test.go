@@ -0,0 +1,345 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlFailoverGroup_basic(t *testing.T) {
+	resourceName := "azurerm_sql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partner_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partner_servers.0.role", "Secondary"),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.mode", "Automatic"),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.grace_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlFailoverGroup_plannedFailover(t *testing.T) {
+	resourceName := "azurerm_sql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	name := fmt.Sprintf("acctestsfg%d", ri)
+	primaryServerName := fmt.Sprintf("acctestmssql%d-primary", ri)
+	secondaryServerName := fmt.Sprintf("acctestmssql%d-secondary", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+				),
+			},
+			{
+				// swapping the roles outside of Terraform shouldn't show a diff
+				PreConfig: testAccAzureRMSqlFailoverGroupFailover(t, resourceGroup, secondaryServerName, name),
+				Config:    testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				PlanOnly:  true,
+			},
+			{
+				// fail back, so that changes can be applied again
+				PreConfig: testAccAzureRMSqlFailoverGroupFailover(t, resourceGroup, primaryServerName, name),
+				Config:    testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+					resource.TestCheckResourceAttr(resourceName, "partner_servers.0.role", "Secondary"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlFailoverGroup_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_sql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSqlFailoverGroup_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_sql_failover_group"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlFailoverGroup_update(t *testing.T) {
+	resourceName := "azurerm_sql_failover_group.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlFailoverGroup_complete(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.mode", "Manual"),
+					resource.TestCheckResourceAttr(resourceName, "readonly_endpoint_failover_policy.0.mode", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.mode", "Automatic"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlFailoverGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("SQL Failover Group not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseSqlFailoverGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Sql.FailoverGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Failover Group %q (Resource Group %q, Server %q) does not exist", id.Name, id.ResourceGroup, id.ServerName)
+			}
+			return fmt.Errorf("Bad: Get on Sql.FailoverGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+// testAccAzureRMSqlFailoverGroupFailover returns a PreConfig func which triggers a planned failover of the Failover
+// Group to the specified SQL Server, as would happen outside of Terraform
+func testAccAzureRMSqlFailoverGroupFailover(t *testing.T, resourceGroup string, serverName string, name string) func() {
+	return func() {
+		client := testAccProvider.Meta().(*ArmClient).Sql.FailoverGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		future, err := client.Failover(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			t.Fatalf("Bad: Failover on Sql.FailoverGroupsClient: %+v", err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			t.Fatalf("Bad: waiting for Failover of SQL Failover Group %q (Resource Group %q) to Server %q: %+v", name, resourceGroup, serverName, err)
+		}
+	}
+}
+
+func testCheckAzureRMSqlFailoverGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Sql.FailoverGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_failover_group" {
+			continue
+		}
+
+		id, err := resourceid.ParseSqlFailoverGroupID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Get on Sql.FailoverGroupsClient: %+v", err)
+			}
+			continue
+		}
+
+		return fmt.Errorf("SQL Failover Group %q (Resource Group %q, Server %q) still exists", id.Name, id.ResourceGroup, id.ServerName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlFailoverGroup_template(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test_primary" {
+  name                         = "acctestmssql%d-primary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "test_secondary" {
+  name                         = "acctestmssql%d-secondary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "%s"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_sql_server.test_primary.resource_group_name}"
+  server_name                      = "${azurerm_sql_server.test_primary.name}"
+  location                         = "${azurerm_sql_server.test_primary.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt)
+}
+
+func testAccAzureRMSqlFailoverGroup_basic(rInt int, location string, altLocation string) string {
+	template := testAccAzureRMSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_failover_group" "test" {
+  name                = "acctestsfg%d"
+  resource_group_name = "${azurerm_sql_server.test_primary.resource_group_name}"
+  server_name         = "${azurerm_sql_server.test_primary.name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_servers {
+    id = "${azurerm_sql_server.test_secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSqlFailoverGroup_requiresImport(rInt int, location string, altLocation string) string {
+	template := testAccAzureRMSqlFailoverGroup_basic(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_failover_group" "import" {
+  name                = "${azurerm_sql_failover_group.test.name}"
+  resource_group_name = "${azurerm_sql_failover_group.test.resource_group_name}"
+  server_name         = "${azurerm_sql_failover_group.test.server_name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_servers {
+    id = "${azurerm_sql_server.test_secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, template)
+}
+
+func testAccAzureRMSqlFailoverGroup_complete(rInt int, location string, altLocation string) string {
+	template := testAccAzureRMSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_failover_group" "test" {
+  name                = "acctestsfg%d"
+  resource_group_name = "${azurerm_sql_server.test_primary.resource_group_name}"
+  server_name         = "${azurerm_sql_server.test_primary.name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_servers {
+    id = "${azurerm_sql_server.test_secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  readonly_endpoint_failover_policy {
+    mode = "Enabled"
+  }
+
+  tags = {
+    environment = "production"
+  }
+}
+`, template, rInt)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
@@ -17,6 +18,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/accounts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
 )
@@ -37,6 +40,13 @@ func resourceArmStorageAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -417,6 +427,115 @@ func resourceArmStorageAccount() *schema.Resource {
 				ValidateFunc: validateAzureRMStorageAccountTags,
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_origins": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"exposed_headers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"allowed_headers": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+									"allowed_methods": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 64,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"DELETE",
+												"GET",
+												"HEAD",
+												"MERGE",
+												"POST",
+												"OPTIONS",
+												"PUT"}, false),
+										},
+									},
+									"max_age_in_seconds": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 2000000000),
+									},
+								},
+							},
+						},
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+						"default_service_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"static_website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"error_404_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"queue_properties": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -600,9 +719,10 @@ func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []stri
 }
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
-	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Storage.AccountsClient
+	advancedThreatProtectionClient := meta.(*ArmClient).SecurityCenter.AdvancedThreatProtectionClient
 
 	storageAccountName := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -611,7 +731,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroupName, err)
+				return fmt.Errorf("Error checking for presence of existing Storage Account %q (Resource Group %q): %s", storageAccountName, resourceGroupName, tf.WrapArmError(err))
 			}
 		}
 
@@ -638,7 +758,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			Encryption: &storage.Encryption{
@@ -698,16 +818,16 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	// Create
 	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
 	if err != nil {
-		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
+		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %+v", storageAccountName, err)
+		return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %+v", storageAccountName, tf.WrapArmError(err))
 	}
 
 	account, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
 	if err != nil {
-		return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, err)
+		return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, tf.WrapArmError(err))
 	}
 
 	if account.ID == nil {
@@ -728,12 +848,33 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err = advancedThreatProtectionClient.Create(ctx, d.Id(), advancedThreatProtectionSetting); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account enable_advanced_threat_protection %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account enable_advanced_threat_protection %q: %+v", storageAccountName, tf.WrapArmError(err))
+		}
+	}
+
+	if val, ok := d.GetOk("blob_properties"); ok {
+		blobClient := meta.(*ArmClient).Storage.AccountsDataPlaneClient
+
+		if _, err = blobClient.SetServiceProperties(ctx, storageAccountName, expandBlobProperties(val.([]interface{}))); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, tf.WrapArmError(err))
+		}
+	}
+
+	if val, ok := d.GetOk("static_website"); ok {
+		// static websites are only supported on StorageV2 and BlockBlobStorage accounts
+		if accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
+			return fmt.Errorf("`static_website` is only supported for Storage Accounts with an `account_kind` of `StorageV2` or `BlockBlobStorage`")
+		}
+
+		blobClient := meta.(*ArmClient).Storage.AccountsDataPlaneClient
+
+		if _, err = blobClient.SetServiceProperties(ctx, storageAccountName, expandStaticWebsiteProperties(val.([]interface{}))); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `static_website` %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 	}
 
 	if val, ok := d.GetOk("queue_properties"); ok {
-		queueClient := meta.(*ArmClient).storage.QueuesClient
+		queueClient := meta.(*ArmClient).Storage.QueuesClient
 
 		queueProperties, err := expandQueueProperties(val.([]interface{}))
 		if err != nil {
@@ -741,7 +882,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err = queueClient.SetServiceProperties(ctx, storageAccountName, queueProperties); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account `queue_properties` %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account `queue_properties` %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 	}
 
@@ -752,9 +893,10 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
-	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Storage.AccountsClient
+	advancedThreatProtectionClient := meta.(*ArmClient).SecurityCenter.AdvancedThreatProtectionClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -786,7 +928,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account type %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account type %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("account_replication_type")
@@ -802,7 +944,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account access_tier %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account access_tier %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("access_tier")
@@ -812,11 +954,11 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		tags := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: expandTags(tags),
+			Tags: expandTags(tags, meta),
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account tags %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account tags %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("tags")
@@ -852,7 +994,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account Encryption %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account Encryption %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 	}
 
@@ -864,7 +1006,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account Custom Domain %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account Custom Domain %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 	}
 
@@ -878,7 +1020,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account enable_https_traffic_only %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account enable_https_traffic_only %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("enable_https_traffic_only")
@@ -890,7 +1032,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account identity %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account identity %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 	}
 
@@ -902,7 +1044,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account network_rules %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account network_rules %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("network_rules")
@@ -917,14 +1059,41 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err := advancedThreatProtectionClient.Create(ctx, d.Id(), opts); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account enable_advanced_threat_protection %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account enable_advanced_threat_protection %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("enable_advanced_threat_protection")
 	}
 
+	if d.HasChange("blob_properties") {
+		blobClient := meta.(*ArmClient).Storage.AccountsDataPlaneClient
+
+		if _, err := blobClient.SetServiceProperties(ctx, storageAccountName, expandBlobProperties(d.Get("blob_properties").([]interface{}))); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, tf.WrapArmError(err))
+		}
+
+		d.SetPartial("blob_properties")
+	}
+
+	if d.HasChange("static_website") {
+		// static websites are only supported on StorageV2 and BlockBlobStorage accounts
+		staticWebsite := d.Get("static_website").([]interface{})
+		accountKind := d.Get("account_kind").(string)
+		if len(staticWebsite) > 0 && accountKind != string(storage.StorageV2) && accountKind != string(storage.BlockBlobStorage) {
+			return fmt.Errorf("`static_website` is only supported for Storage Accounts with an `account_kind` of `StorageV2` or `BlockBlobStorage`")
+		}
+
+		blobClient := meta.(*ArmClient).Storage.AccountsDataPlaneClient
+
+		if _, err := blobClient.SetServiceProperties(ctx, storageAccountName, expandStaticWebsiteProperties(staticWebsite)); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `static_website` %q: %+v", storageAccountName, tf.WrapArmError(err))
+		}
+
+		d.SetPartial("static_website")
+	}
+
 	if d.HasChange("queue_properties") {
-		queueClient := meta.(*ArmClient).storage.QueuesClient
+		queueClient := meta.(*ArmClient).Storage.QueuesClient
 
 		queueProperties, err := expandQueueProperties(d.Get("queue_properties").([]interface{}))
 		if err != nil {
@@ -932,7 +1101,7 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if _, err = queueClient.SetServiceProperties(ctx, storageAccountName, queueProperties); err != nil {
-			return fmt.Errorf("Error updating Azure Storage Account `queue_properties` %q: %+v", storageAccountName, err)
+			return fmt.Errorf("Error updating Azure Storage Account `queue_properties` %q: %+v", storageAccountName, tf.WrapArmError(err))
 		}
 
 		d.SetPartial("queue_properties")
@@ -943,10 +1112,11 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
-	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
-	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Storage.AccountsClient
+	advancedThreatProtectionClient := meta.(*ArmClient).SecurityCenter.AdvancedThreatProtectionClient
+	endpointSuffix := meta.(*ArmClient).Environment.StorageEndpointSuffix
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -961,7 +1131,7 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading the state of AzureRM Storage Account %q: %+v", name, err)
+		return fmt.Errorf("Error reading the state of AzureRM Storage Account %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	keys, err := client.ListKeys(ctx, resGroup, name)
@@ -1070,13 +1240,37 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
-	queueClient := meta.(*ArmClient).storage.QueuesClient
+	// FileStorage accounts don't have a Blob Service
+	blobProperties := make([]interface{}, 0)
+	staticWebsite := make([]interface{}, 0)
+	if resp.Kind != storage.FileStorage {
+		blobClient := meta.(*ArmClient).Storage.AccountsDataPlaneClient
+		blobProps, err := blobClient.GetServiceProperties(ctx, name)
+		if err != nil {
+			if blobProps.Response.Response != nil && !utils.ResponseWasNotFound(blobProps.Response) {
+				return fmt.Errorf("Error reading blob properties for AzureRM Storage Account %q: %+v", name, tf.WrapArmError(err))
+			}
+		}
+
+		blobProperties = flattenBlobProperties(blobProps)
+		staticWebsite = flattenStaticWebsiteProperties(blobProps)
+	}
+
+	if err := d.Set("blob_properties", blobProperties); err != nil {
+		return fmt.Errorf("Error setting `blob_properties `for AzureRM Storage Account %q: %+v", name, err)
+	}
+
+	if err := d.Set("static_website", staticWebsite); err != nil {
+		return fmt.Errorf("Error setting `static_website `for AzureRM Storage Account %q: %+v", name, err)
+	}
+
+	queueClient := meta.(*ArmClient).Storage.QueuesClient
 	queueProps, err := queueClient.GetServiceProperties(ctx, name)
 	if err != nil {
 		if queueProps.Response.Response != nil && !utils.ResponseWasNotFound(queueProps.Response) {
-			return fmt.Errorf("Error reading queue properties for AzureRM Storage Account %q: %+v", name, err)
+			return fmt.Errorf("Error reading queue properties for AzureRM Storage Account %q: %+v", name, tf.WrapArmError(err))
 		}
 	}
 
@@ -1088,8 +1282,9 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	client := meta.(*ArmClient).storageServiceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	client := meta.(*ArmClient).Storage.AccountsClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1104,7 +1299,7 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 	}
 
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
@@ -1135,7 +1330,7 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
 		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error issuing delete request for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+			return fmt.Errorf("Error issuing delete request for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, tf.WrapArmError(err))
 		}
 	}
 
@@ -1234,6 +1429,84 @@ func expandStorageAccountBypass(networkRule map[string]interface{}) storage.Bypa
 	return storage.Bypass(strings.Join(bypassValues, ", "))
 }
 
+func expandBlobProperties(input []interface{}) accounts.StorageServiceProperties {
+	// elements which are omitted are left as-is by the API, so these need to be explicitly cleared
+	properties := accounts.StorageServiceProperties{
+		Cors: &accounts.Cors{
+			CorsRule: []accounts.CorsRule{},
+		},
+		DeleteRetentionPolicy: &accounts.DeleteRetentionPolicy{
+			Enabled: false,
+		},
+	}
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+
+	attrs := input[0].(map[string]interface{})
+
+	properties.Cors = expandBlobPropertiesCors(attrs["cors_rule"].([]interface{}))
+
+	if policies := attrs["delete_retention_policy"].([]interface{}); len(policies) > 0 {
+		days := 7
+		if policy, ok := policies[0].(map[string]interface{}); ok {
+			days = policy["days"].(int)
+		}
+
+		properties.DeleteRetentionPolicy = &accounts.DeleteRetentionPolicy{
+			Enabled: true,
+			Days:    days,
+		}
+	}
+
+	if version := attrs["default_service_version"].(string); version != "" {
+		properties.DefaultServiceVersion = utils.String(version)
+	}
+
+	return properties
+}
+
+func expandBlobPropertiesCors(input []interface{}) *accounts.Cors {
+	corsRules := make([]accounts.CorsRule, 0)
+	for _, attr := range input {
+		corsRuleAttr := attr.(map[string]interface{})
+		corsRule := accounts.CorsRule{}
+
+		corsRule.AllowedOrigins = strings.Join(*utils.ExpandStringSlice(corsRuleAttr["allowed_origins"].([]interface{})), ",")
+		corsRule.ExposedHeaders = strings.Join(*utils.ExpandStringSlice(corsRuleAttr["exposed_headers"].([]interface{})), ",")
+		corsRule.AllowedHeaders = strings.Join(*utils.ExpandStringSlice(corsRuleAttr["allowed_headers"].([]interface{})), ",")
+		corsRule.AllowedMethods = strings.Join(*utils.ExpandStringSlice(corsRuleAttr["allowed_methods"].([]interface{})), ",")
+		corsRule.MaxAgeInSeconds = corsRuleAttr["max_age_in_seconds"].(int)
+
+		corsRules = append(corsRules, corsRule)
+	}
+
+	return &accounts.Cors{
+		CorsRule: corsRules,
+	}
+}
+
+func expandStaticWebsiteProperties(input []interface{}) accounts.StorageServiceProperties {
+	properties := accounts.StorageServiceProperties{
+		StaticWebsite: &accounts.StaticWebsite{
+			Enabled: false,
+		},
+	}
+	if len(input) == 0 {
+		return properties
+	}
+
+	properties.StaticWebsite.Enabled = true
+
+	// an empty block enables the static website without any documents
+	if attrs, ok := input[0].(map[string]interface{}); ok {
+		properties.StaticWebsite.IndexDocument = attrs["index_document"].(string)
+		properties.StaticWebsite.ErrorDocument404Path = attrs["error_404_document"].(string)
+	}
+
+	return properties
+}
+
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
 	var err error
 	properties := queues.StorageServiceProperties{}
@@ -1378,6 +1651,63 @@ func flattenStorageAccountVirtualNetworks(input *[]storage.VirtualNetworkRule) [
 	return virtualNetworks
 }
 
+func flattenBlobProperties(input accounts.GetServicePropertiesResult) []interface{} {
+	if input.Response.Response == nil {
+		return []interface{}{}
+	}
+
+	blobProperties := make(map[string]interface{})
+
+	if cors := input.Cors; cors != nil && len(cors.CorsRule) > 0 {
+		corsRules := make([]interface{}, 0)
+		for _, corsRule := range cors.CorsRule {
+			corsRules = append(corsRules, map[string]interface{}{
+				"allowed_origins":    flattenCorsProperty(corsRule.AllowedOrigins),
+				"allowed_methods":    flattenCorsProperty(corsRule.AllowedMethods),
+				"allowed_headers":    flattenCorsProperty(corsRule.AllowedHeaders),
+				"exposed_headers":    flattenCorsProperty(corsRule.ExposedHeaders),
+				"max_age_in_seconds": corsRule.MaxAgeInSeconds,
+			})
+		}
+		blobProperties["cors_rule"] = corsRules
+	}
+
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled {
+		blobProperties["delete_retention_policy"] = []interface{}{
+			map[string]interface{}{
+				"days": policy.Days,
+			},
+		}
+	}
+
+	if version := input.DefaultServiceVersion; version != nil && *version != "" {
+		blobProperties["default_service_version"] = *version
+	}
+
+	if len(blobProperties) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{blobProperties}
+}
+
+func flattenStaticWebsiteProperties(input accounts.GetServicePropertiesResult) []interface{} {
+	if input.Response.Response == nil {
+		return []interface{}{}
+	}
+
+	website := input.StaticWebsite
+	if website == nil || !website.Enabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"index_document":     website.IndexDocument,
+			"error_404_document": website.ErrorDocument404Path,
+		},
+	}
+}
+
 func flattenQueueProperties(input queues.StorageServicePropertiesResponse) []interface{} {
 	if input.Response.Response == nil {
 		return []interface{}{}
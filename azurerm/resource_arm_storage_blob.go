@@ -12,18 +12,17 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/storage"
+	azauto "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
-
-	"github.com/hashicorp/terraform/helper/validation"
-
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
-
-	"github.com/Azure/azure-sdk-for-go/storage"
-	azauto "github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -38,6 +37,13 @@ func resourceArmStorageBlob() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -130,13 +136,14 @@ func resourceArmStorageBlob() *schema.Resource {
 
 func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
-	env := armClient.environment
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
+	env := armClient.Environment
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
 
-	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	blobClient, accountExists, err := getBlobStorageClientForStorageAccount(ctx, armClient, resourceGroupName, storageAccountName)
 	if err != nil {
 		return err
 	}
@@ -159,7 +166,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	if requireResourcesToBeImported && d.IsNewResource() {
 		exists, err := blob.Exists()
 		if err != nil {
-			return fmt.Errorf("Error checking if Blob %q exists (Container %q / Account %q / Resource Group %q): %s", name, containerName, storageAccountName, resourceGroupName, err)
+			return fmt.Errorf("Error checking if Blob %q exists (Container %q / Account %q / Resource Group %q): %s", name, containerName, storageAccountName, resourceGroupName, tf.WrapArmError(err))
 		}
 
 		if exists {
@@ -170,14 +177,14 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	if sourceUri != "" {
 		options := &storage.CopyOptions{}
 		if err := blob.Copy(sourceUri, options); err != nil {
-			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+			return fmt.Errorf("Error creating storage blob on Azure: %s", tf.WrapArmError(err))
 		}
 	} else {
 		switch strings.ToLower(blobType) {
 		case "block":
 			options := &storage.PutBlobOptions{}
 			if err := blob.CreateBlockBlob(options); err != nil {
-				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				return fmt.Errorf("Error creating storage blob on Azure: %s", tf.WrapArmError(err))
 			}
 
 			source := d.Get("source").(string)
@@ -186,7 +193,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 				attempts := d.Get("attempts").(int)
 
 				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+					return fmt.Errorf("Error creating storage blob on Azure: %s", tf.WrapArmError(err))
 				}
 			}
 		case "page":
@@ -196,7 +203,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 				attempts := d.Get("attempts").(int)
 
 				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+					return fmt.Errorf("Error creating storage blob on Azure: %s", tf.WrapArmError(err))
 				}
 			} else {
 				size := int64(d.Get("size").(int))
@@ -205,7 +212,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 				blob.Properties.ContentLength = size
 				blob.Properties.ContentType = contentType
 				if err := blob.PutPageBlob(options); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+					return fmt.Errorf("Error creating storage blob on Azure: %s", tf.WrapArmError(err))
 				}
 			}
 		}
@@ -232,13 +239,13 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 
 	file, err := os.Open(source)
 	if err != nil {
-		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
+		return fmt.Errorf("Error opening source file for upload %q: %s", source, tf.WrapArmError(err))
 	}
 	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
 
 	blobSize, pageList, err := resourceArmStorageBlobPageSplit(file)
 	if err != nil {
-		return fmt.Errorf("Error splitting source file %q into pages: %s", source, err)
+		return fmt.Errorf("Error splitting source file %q into pages: %s", source, tf.WrapArmError(err))
 	}
 
 	options := &storage.PutBlobOptions{}
@@ -248,7 +255,7 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 	blob.Properties.ContentType = contentType
 	err = blob.PutPageBlob(options)
 	if err != nil {
-		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+		return fmt.Errorf("Error creating storage blob on Azure: %s", tf.WrapArmError(err))
 	}
 
 	pages := make(chan resourceArmStorageBlobPage, len(pageList))
@@ -294,7 +301,7 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 
 	info, err := file.Stat()
 	if err != nil {
-		return int64(0), nil, fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+		return int64(0), nil, fmt.Errorf("Could not stat file %q: %s", file.Name(), tf.WrapArmError(err))
 	}
 
 	blobSize := info.Size()
@@ -315,7 +322,7 @@ func resourceArmStorageBlobPageSplit(file *os.File) (int64, []resourceArmStorage
 		pageBuf := make([]byte, minPageSize)
 		_, err = file.ReadAt(pageBuf, i)
 		if err != nil && err != io.EOF {
-			return int64(0), nil, fmt.Errorf("Could not read chunk at %d: %s", i, err)
+			return int64(0), nil, fmt.Errorf("Could not read chunk at %d: %s", i, tf.WrapArmError(err))
 		}
 
 		if bytes.Equal(pageBuf, emptyPage) {
@@ -371,7 +378,7 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 		chunk := make([]byte, size)
 		_, err := page.section.Read(chunk)
 		if err != nil && err != io.EOF {
-			ctx.errors <- fmt.Errorf("Error reading source file %q at offset %d: %s", ctx.source, page.offset, err)
+			ctx.errors <- fmt.Errorf("Error reading source file %q at offset %d: %s", ctx.source, page.offset, tf.WrapArmError(err))
 			ctx.wg.Done()
 			continue
 		}
@@ -391,7 +398,7 @@ func resourceArmStorageBlobPageUploadWorker(ctx resourceArmStorageBlobPageUpload
 			}
 		}
 		if err != nil {
-			ctx.errors <- fmt.Errorf("Error writing page at offset %d for file %q: %s", page.offset, ctx.source, err)
+			ctx.errors <- fmt.Errorf("Error writing page at offset %d for file %q: %s", page.offset, ctx.source, tf.WrapArmError(err))
 			ctx.wg.Done()
 			continue
 		}
@@ -410,13 +417,13 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 
 	file, err := os.Open(source)
 	if err != nil {
-		return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
+		return fmt.Errorf("Error opening source file for upload %q: %s", source, tf.WrapArmError(err))
 	}
 	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
 
 	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
 	if err != nil {
-		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
+		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, tf.WrapArmError(err))
 	}
 
 	wg := &sync.WaitGroup{}
@@ -454,7 +461,7 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
-		return fmt.Errorf("Error updating block list for source file %q: %s", source, err)
+		return fmt.Errorf("Error updating block list for source file %q: %s", source, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -470,14 +477,14 @@ func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourc
 
 	info, err := file.Stat()
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), err)
+		return nil, nil, fmt.Errorf("Error stating source file %q: %s", file.Name(), tf.WrapArmError(err))
 	}
 
 	for i := int64(0); i < info.Size(); i = i + blockSize {
 		entropy := make([]byte, idSize)
 		_, err = rand.Read(entropy)
 		if err != nil {
-			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), err)
+			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), tf.WrapArmError(err))
 		}
 
 		sectionSize := blockSize
@@ -519,7 +526,7 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 
 		_, err := block.section.Read(buffer)
 		if err != nil {
-			ctx.errors <- fmt.Errorf("Error reading source file %q: %s", ctx.source, err)
+			ctx.errors <- fmt.Errorf("Error reading source file %q: %s", ctx.source, tf.WrapArmError(err))
 			ctx.wg.Done()
 			continue
 		}
@@ -533,7 +540,7 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 			}
 		}
 		if err != nil {
-			ctx.errors <- fmt.Errorf("Error uploading block %q for source file %q: %s", block.id, ctx.source, err)
+			ctx.errors <- fmt.Errorf("Error uploading block %q for source file %q: %s", block.id, ctx.source, tf.WrapArmError(err))
 			ctx.wg.Done()
 			continue
 		}
@@ -544,9 +551,10 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 
 func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
-	id, err := parseStorageBlobID(d.Id(), armClient.environment)
+	id, err := parseStorageBlobID(d.Id(), armClient.Environment)
 	if err != nil {
 		return err
 	}
@@ -560,9 +568,9 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q", id.storageAccountName)
 	}
 
-	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	blobClient, accountExists, err := getBlobStorageClientForStorageAccount(ctx, armClient, *resourceGroup, id.storageAccountName)
 	if err != nil {
-		return fmt.Errorf("Error getting storage account %s: %+v", id.storageAccountName, err)
+		return fmt.Errorf("Error getting storage account %s: %+v", id.storageAccountName, tf.WrapArmError(err))
 	}
 	if !accountExists {
 		return fmt.Errorf("Storage account %s not found in resource group %s", id.storageAccountName, *resourceGroup)
@@ -595,9 +603,10 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
-	id, err := parseStorageBlobID(d.Id(), armClient.environment)
+	id, err := parseStorageBlobID(d.Id(), armClient.Environment)
 	if err != nil {
 		return err
 	}
@@ -611,7 +620,7 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q", id.storageAccountName)
 	}
 
-	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	blobClient, accountExists, err := getBlobStorageClientForStorageAccount(ctx, armClient, *resourceGroup, id.storageAccountName)
 	if err != nil {
 		return err
 	}
@@ -626,7 +635,7 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	blob := container.GetBlobReference(id.blobName)
 	exists, err := blob.Exists()
 	if err != nil {
-		return fmt.Errorf("error checking for existence of storage blob %q: %s", id.blobName, err)
+		return fmt.Errorf("error checking for existence of storage blob %q: %s", id.blobName, tf.WrapArmError(err))
 	}
 
 	if !exists {
@@ -638,13 +647,13 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	options := &storage.GetBlobPropertiesOptions{}
 	err = blob.GetProperties(options)
 	if err != nil {
-		return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, tf.WrapArmError(err))
 	}
 
 	metadataOptions := &storage.GetBlobMetadataOptions{}
 	err = blob.GetMetadata(metadataOptions)
 	if err != nil {
-		return fmt.Errorf("Error getting metadata of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		return fmt.Errorf("Error getting metadata of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, tf.WrapArmError(err))
 	}
 
 	d.Set("name", id.blobName)
@@ -671,23 +680,24 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
-	id, err := parseStorageBlobID(d.Id(), armClient.environment)
+	id, err := parseStorageBlobID(d.Id(), armClient.Environment)
 	if err != nil {
 		return err
 	}
 
 	resourceGroup, err := determineResourceGroupForStorageAccount(id.storageAccountName, armClient)
 	if err != nil {
-		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q: %+v", id.storageAccountName, err)
+		return fmt.Errorf("Unable to determine Resource Group for Storage Account %q: %+v", id.storageAccountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		log.Printf("[INFO] Resource Group doesn't exist so the blob won't exist")
 		return nil
 	}
 
-	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	blobClient, accountExists, err := getBlobStorageClientForStorageAccount(ctx, armClient, *resourceGroup, id.storageAccountName)
 	if err != nil {
 		return err
 	}
@@ -702,7 +712,7 @@ func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) erro
 	blob := container.GetBlobReference(id.blobName)
 	_, err = blob.DeleteIfExists(options)
 	if err != nil {
-		return fmt.Errorf("Error deleting storage blob %q: %s", id.blobName, err)
+		return fmt.Errorf("Error deleting storage blob %q: %s", id.blobName, tf.WrapArmError(err))
 	}
 
 	return nil
@@ -739,13 +749,13 @@ func parseStorageBlobID(input string, environment azauto.Environment) (*storageB
 }
 
 func determineResourceGroupForStorageAccount(accountName string, client *ArmClient) (*string, error) {
-	storageClient := client.storageServiceClient
+	storageClient := client.Storage.AccountsClient
 	ctx := client.StopContext
 
 	// first locate which resource group the storage account is in
 	groupsResp, err := storageClient.List(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Error loading the Resource Groups for Storage Account %q: %+v", accountName, err)
+		return nil, fmt.Errorf("Error loading the Resource Groups for Storage Account %q: %+v", accountName, tf.WrapArmError(err))
 	}
 
 	if groups := groupsResp.Value; groups != nil {
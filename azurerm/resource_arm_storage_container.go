@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/containers"
 )
@@ -27,6 +29,13 @@ func resourceArmStorageContainer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -76,8 +85,9 @@ func resourceArmStorageContainer() *schema.Resource {
 }
 
 func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	containerName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
@@ -89,7 +99,7 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", containerName, accountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", containerName, accountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		return fmt.Errorf("Unable to locate Resource Group for Storage Container %q (Account %s)", containerName, accountName)
@@ -105,7 +115,7 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		existing, err := client.GetProperties(ctx, accountName, containerName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for existence of existing Container %q (Account %q / Resource Group %q): %+v", containerName, accountName, *resourceGroup, err)
+				return fmt.Errorf("Error checking for existence of existing Container %q (Account %q / Resource Group %q): %+v", containerName, accountName, *resourceGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -120,7 +130,7 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		MetaData:    metaData,
 	}
 	if _, err := client.Create(ctx, accountName, containerName, input); err != nil {
-		return fmt.Errorf("Error creating Container %q (Account %q / Resource Group %q): %s", containerName, accountName, *resourceGroup, err)
+		return fmt.Errorf("Error creating Container %q (Account %q / Resource Group %q): %s", containerName, accountName, *resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.SetId(id)
@@ -128,8 +138,9 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*ArmClient).Storage
 
 	id, err := containers.ParseResourceID(d.Id())
 	if err != nil {
@@ -138,7 +149,7 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", id.ContainerName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", id.ContainerName, id.AccountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Container %q (Account %s) - assuming removed & removing from state", id.ContainerName, id.AccountName)
@@ -157,7 +168,7 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 		accessLevel := expandStorageContainerAccessLevel(accessLevelRaw)
 
 		if _, err := client.SetAccessControl(ctx, id.AccountName, id.ContainerName, accessLevel); err != nil {
-			return fmt.Errorf("Error updating the Access Control for Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, err)
+			return fmt.Errorf("Error updating the Access Control for Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, tf.WrapArmError(err))
 		}
 		log.Printf("[DEBUG] Updated the Access Control for Container %q (Storage Account %q / Resource Group %q)", id.ContainerName, id.AccountName, *resourceGroup)
 	}
@@ -168,7 +179,7 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 		metaData := storage.ExpandMetaData(metaDataRaw)
 
 		if _, err := client.SetMetaData(ctx, id.AccountName, id.ContainerName, metaData); err != nil {
-			return fmt.Errorf("Error updating the MetaData for Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, err)
+			return fmt.Errorf("Error updating the MetaData for Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, tf.WrapArmError(err))
 		}
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.ContainerName, id.AccountName, *resourceGroup)
 	}
@@ -177,8 +188,9 @@ func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*ArmClient).Storage
 
 	id, err := containers.ParseResourceID(d.Id())
 	if err != nil {
@@ -187,7 +199,7 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", id.ContainerName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", id.ContainerName, id.AccountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Container %q (Account %s) - assuming removed & removing from state", id.ContainerName, id.AccountName)
@@ -208,7 +220,7 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Container %q (Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, err)
+		return fmt.Errorf("Error retrieving Container %q (Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, tf.WrapArmError(err))
 	}
 
 	d.Set("name", id.ContainerName)
@@ -232,8 +244,9 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*ArmClient).Storage
 
 	id, err := containers.ParseResourceID(d.Id())
 	if err != nil {
@@ -242,7 +255,7 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", id.ContainerName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Container %q (Account %s): %s", id.ContainerName, id.AccountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Container %q (Account %s) - assuming removed & removing from state", id.ContainerName, id.AccountName)
@@ -256,7 +269,7 @@ func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	if _, err := client.Delete(ctx, id.AccountName, id.ContainerName); err != nil {
-		return fmt.Errorf("Error deleting Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, err)
+		return fmt.Errorf("Error deleting Container %q (Storage Account %q / Resource Group %q): %s", id.ContainerName, id.AccountName, *resourceGroup, tf.WrapArmError(err))
 	}
 
 	return nil
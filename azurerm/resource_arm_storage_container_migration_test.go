@@ -24,7 +24,7 @@ func TestAccAzureRMStorageContainerMigrateState(t *testing.T) {
 
 	client.StopContext = testAccProvider.StopContext()
 
-	suffix := client.environment.StorageEndpointSuffix
+	suffix := client.Environment.StorageEndpointSuffix
 
 	cases := map[string]struct {
 		StateVersion       int
@@ -0,0 +1,428 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[a-zA-Z0-9]*$`),
+								"A rule name can contain any combination of alpha numeric characters.",
+							),
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"blockBlob"}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(-1, 99999),
+												},
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(-1, 99999),
+												},
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(-1, 99999),
+												},
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(-1, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	storageClient := meta.(*ArmClient).Storage
+	client := storageClient.ManagementPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	accountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy for Storage Account %q (Resource Group %q): %+v", accountId.Name, accountId.ResourceGroup, tf.WrapArmError(err))
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy", *existing.ID)
+		}
+	}
+
+	properties := storage.ManagementPolicyProperties{
+		Policy: &storage.ManagementPolicySchema{
+			Rules: expandStorageManagementPolicyRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	if _, err := storageClient.CreateOrUpdateManagementPolicy(ctx, accountId.ResourceGroup, accountId.Name, properties); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy for Storage Account %q (Resource Group %q): %+v", accountId.Name, accountId.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", accountId.Name, accountId.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Management Policy for Storage Account %q (Resource Group %q)", accountId.Name, accountId.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.ManagementPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountId := resourceid.StorageAccountID{
+		SubscriptionId: id.SubscriptionId,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           id.StorageAccountName,
+	}
+
+	resp, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy for Storage Account %q (Resource Group %q) was not found - removing from state!", accountId.Name, accountId.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", accountId.Name, accountId.ResourceGroup, tf.WrapArmError(err))
+	}
+
+	d.Set("storage_account_id", accountId.String())
+
+	var rules *[]storage.ManagementPolicyRule
+	if props := resp.ManagementPolicyProperties; props != nil && props.Policy != nil {
+		rules = props.Policy.Rules
+	}
+	if err := d.Set("rule", flattenStorageManagementPolicyRules(rules)); err != nil {
+		return fmt.Errorf("Error setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Storage.ManagementPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Management Policy for Storage Account %q (Resource Group %q): %+v", id.StorageAccountName, id.ResourceGroup, tf.WrapArmError(err))
+		}
+	}
+
+	return nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) *[]storage.ManagementPolicyRule {
+	rules := make([]storage.ManagementPolicyRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rule := storage.ManagementPolicyRule{
+			Name:    utils.String(v["name"].(string)),
+			Enabled: utils.Bool(v["enabled"].(bool)),
+			Type:    utils.String("Lifecycle"),
+			Definition: &storage.ManagementPolicyDefinition{
+				Actions: expandStorageManagementPolicyActions(v["actions"].([]interface{})),
+				Filters: expandStorageManagementPolicyFilters(v["filters"].([]interface{})),
+			},
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &rules
+}
+
+func expandStorageManagementPolicyFilters(input []interface{}) *storage.ManagementPolicyFilter {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	filter := storage.ManagementPolicyFilter{}
+
+	if prefixMatch := v["prefix_match"].(*schema.Set).List(); len(prefixMatch) > 0 {
+		filter.PrefixMatch = utils.ExpandStringSlice(prefixMatch)
+	}
+
+	if blobTypes := v["blob_types"].(*schema.Set).List(); len(blobTypes) > 0 {
+		filter.BlobTypes = utils.ExpandStringSlice(blobTypes)
+	}
+
+	return &filter
+}
+
+func expandStorageManagementPolicyActions(input []interface{}) *storage.ManagementPolicyAction {
+	action := storage.ManagementPolicyAction{}
+	if len(input) == 0 || input[0] == nil {
+		return &action
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if baseBlobs := v["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+		baseBlob := baseBlobs[0].(map[string]interface{})
+		action.BaseBlob = &storage.ManagementPolicyBaseBlob{
+			TierToCool:    expandStorageManagementPolicyDateAfterModification(baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int)),
+			TierToArchive: expandStorageManagementPolicyDateAfterModification(baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int)),
+			Delete:        expandStorageManagementPolicyDateAfterModification(baseBlob["delete_after_days_since_modification_greater_than"].(int)),
+		}
+	}
+
+	if snapshots := v["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+		snapshot := snapshots[0].(map[string]interface{})
+		action.Snapshot = &storage.ManagementPolicySnapShot{}
+
+		// -1 is used to represent an action which hasn't been configured
+		if days := snapshot["delete_after_days_since_creation_greater_than"].(int); days >= 0 {
+			action.Snapshot.Delete = &storage.DateAfterCreation{
+				DaysAfterCreationGreaterThan: utils.Int32(int32(days)),
+			}
+		}
+	}
+
+	return &action
+}
+
+func expandStorageManagementPolicyDateAfterModification(days int) *storage.DateAfterModification {
+	// -1 is used to represent an action which hasn't been configured
+	if days < 0 {
+		return nil
+	}
+
+	return &storage.DateAfterModification{
+		DaysAfterModificationGreaterThan: utils.Int32(int32(days)),
+	}
+}
+
+func flattenStorageManagementPolicyRules(input *[]storage.ManagementPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		enabled := false
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		filters := make([]interface{}, 0)
+		actions := make([]interface{}, 0)
+		if definition := rule.Definition; definition != nil {
+			filters = flattenStorageManagementPolicyFilters(definition.Filters)
+			actions = flattenStorageManagementPolicyActions(definition.Actions)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    name,
+			"enabled": enabled,
+			"filters": filters,
+			"actions": actions,
+		})
+	}
+
+	return results
+}
+
+func flattenStorageManagementPolicyFilters(input *storage.ManagementPolicyFilter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prefix_match": schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.PrefixMatch)),
+			"blob_types":   schema.NewSet(schema.HashString, utils.FlattenStringSlice(input.BlobTypes)),
+		},
+	}
+}
+
+func flattenStorageManagementPolicyActions(input *storage.ManagementPolicyAction) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseBlobs := make([]interface{}, 0)
+	if baseBlob := input.BaseBlob; baseBlob != nil {
+		baseBlobs = append(baseBlobs, map[string]interface{}{
+			"tier_to_cool_after_days_since_modification_greater_than":    flattenStorageManagementPolicyDateAfterModification(baseBlob.TierToCool),
+			"tier_to_archive_after_days_since_modification_greater_than": flattenStorageManagementPolicyDateAfterModification(baseBlob.TierToArchive),
+			"delete_after_days_since_modification_greater_than":          flattenStorageManagementPolicyDateAfterModification(baseBlob.Delete),
+		})
+	}
+
+	snapshots := make([]interface{}, 0)
+	if snapshot := input.Snapshot; snapshot != nil {
+		days := -1
+		if snapshot.Delete != nil && snapshot.Delete.DaysAfterCreationGreaterThan != nil {
+			days = int(*snapshot.Delete.DaysAfterCreationGreaterThan)
+		}
+
+		snapshots = append(snapshots, map[string]interface{}{
+			"delete_after_days_since_creation_greater_than": days,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_blob": baseBlobs,
+			"snapshot":  snapshots,
+		},
+	}
+}
+
+func flattenStorageManagementPolicyDateAfterModification(input *storage.DateAfterModification) int {
+	if input == nil || input.DaysAfterModificationGreaterThan == nil {
+		return -1
+	}
+
+	return int(*input.DaysAfterModificationGreaterThan)
+}
@@ -0,0 +1,303 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStorageManagementPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "rule1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filters.0.prefix_match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filters.0.blob_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", "50"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.snapshot.0.delete_after_days_since_creation_greater_than", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageManagementPolicy_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_management_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_update(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageManagementPolicy_multipleRules(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.name", "rule2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filters.0.prefix_match.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.actions.0.snapshot.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageManagementPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseStorageManagementPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).Storage.ManagementPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Management Policy for Storage Account %q (Resource Group %q) does not exist", id.StorageAccountName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageManagementPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageManagementPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).Storage.ManagementPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_management_policy" {
+			continue
+		}
+
+		id, err := resourceid.ParseStorageManagementPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Management Policy for Storage Account %q (Resource Group %q) still exists", id.StorageAccountName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageManagementPolicy_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "BlobStorage"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageManagementPolicy_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name    = "rule1"
+    enabled = true
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "import" {
+  storage_account_id = "${azurerm_storage_management_policy.test.storage_account_id}"
+
+  rule {
+    name    = "rule1"
+    enabled = true
+
+    actions {
+      base_blob {
+        delete_after_days_since_modification_greater_than = 100
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_multipleRules(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name    = "rule1"
+    enabled = false
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than = 11
+        delete_after_days_since_modification_greater_than       = 101
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 31
+      }
+    }
+  }
+
+  rule {
+    name    = "rule2"
+    enabled = true
+
+    filters {
+      prefix_match = ["logs/", "container2/prefix2"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_archive_after_days_since_modification_greater_than = 30
+        delete_after_days_since_modification_greater_than          = 365
+      }
+    }
+  }
+}
+`, template)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
 )
@@ -25,6 +27,13 @@ func resourceArmStorageQueue() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageQueueMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -77,8 +86,9 @@ func validateArmStorageQueueName(v interface{}, k string) (warnings []string, er
 }
 
 func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	queueClient := meta.(*ArmClient).storage.QueuesClient
-	ctx := meta.(*ArmClient).StopContext
+	queueClient := meta.(*ArmClient).Storage.QueuesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	queueName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
@@ -91,7 +101,7 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 		existing, err := queueClient.GetMetaData(ctx, accountName, queueName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Queue %q (Storage Account %q): %s", queueName, accountName, err)
+				return fmt.Errorf("Error checking for presence of existing Queue %q (Storage Account %q): %s", queueName, accountName, tf.WrapArmError(err))
 			}
 		}
 
@@ -101,7 +111,7 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if _, err := queueClient.Create(ctx, accountName, queueName, metaData); err != nil {
-		return fmt.Errorf("Error creating Queue %q (Account %q): %+v", queueName, accountName, err)
+		return fmt.Errorf("Error creating Queue %q (Account %q): %+v", queueName, accountName, tf.WrapArmError(err))
 	}
 
 	d.SetId(resourceID)
@@ -110,8 +120,9 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := queues.ParseResourceID(d.Id())
 	if err != nil {
@@ -129,8 +140,9 @@ func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := queues.ParseResourceID(d.Id())
 	if err != nil {
@@ -139,7 +151,7 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Queue Container %q (Account %s): %s", id.QueueName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Queue Container %q (Account %s): %s", id.QueueName, id.AccountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		log.Printf("[WARN] Unable to determine Resource Group for Storage Queue %q (Account %s) - assuming removed & removing from state", id.QueueName, id.AccountName)
@@ -170,8 +182,9 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := queues.ParseResourceID(d.Id())
 	if err != nil {
@@ -180,7 +193,7 @@ func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) err
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Queue %q (Account %s): %s", id.QueueName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Queue %q (Account %s): %s", id.QueueName, id.AccountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		log.Printf("[WARN] Unable to determine Resource Group for Storage Queue %q (Account %s) - assuming removed & removing from state", id.QueueName, id.AccountName)
@@ -189,7 +202,7 @@ func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if _, err := storageClient.QueuesClient.Delete(ctx, id.AccountName, id.QueueName); err != nil {
-		return fmt.Errorf("Error deleting Storage Queue %q: %s", id.QueueName, err)
+		return fmt.Errorf("Error deleting Storage Queue %q: %s", id.QueueName, tf.WrapArmError(err))
 	}
 
 	return nil
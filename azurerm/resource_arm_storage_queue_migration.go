@@ -26,7 +26,7 @@ func migrateStorageQueueStateV0toV1(is *terraform.InstanceState, meta interface{
 
 	log.Printf("[DEBUG] ARM Storage Queue Attributes before Migration: %#v", is.Attributes)
 
-	environment := meta.(*ArmClient).environment
+	environment := meta.(*ArmClient).Environment
 
 	queueName := is.Attributes["name"]
 	storageAccountName := is.Attributes["storage_account_name"]
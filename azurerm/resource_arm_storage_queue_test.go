@@ -156,7 +156,7 @@ func testCheckAzureRMStorageQueueExists(resourceName string) resource.TestCheckF
 		name := rs.Primary.Attributes["name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		queueClient := testAccProvider.Meta().(*ArmClient).storage.QueuesClient
+		queueClient := testAccProvider.Meta().(*ArmClient).Storage.QueuesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		metaData, err := queueClient.GetMetaData(ctx, accountName, name)
@@ -181,7 +181,7 @@ func testCheckAzureRMStorageQueueDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		accountName := rs.Primary.Attributes["storage_account_name"]
 
-		queueClient := testAccProvider.Meta().(*ArmClient).storage.QueuesClient
+		queueClient := testAccProvider.Meta().(*ArmClient).Storage.QueuesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		metaData, err := queueClient.GetMetaData(ctx, accountName, name)
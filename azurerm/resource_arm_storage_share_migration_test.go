@@ -27,7 +27,7 @@ func TestAzureRMStorageShareMigrateStateV0ToV1(t *testing.T) {
 			"quota":                5120,
 		}
 		meta := &ArmClient{
-			environment: cloud,
+			Environment: cloud,
 		}
 		expected := map[string]interface{}{
 			"id":                   "share1/group1/account1",
@@ -69,7 +69,7 @@ func TestAzureRMStorageShareMigrateStateV1ToV2(t *testing.T) {
 			"quota":                5120,
 		}
 		meta := &ArmClient{
-			environment: cloud,
+			Environment: cloud,
 		}
 		expected := map[string]interface{}{
 			"id":                   fmt.Sprintf("https://account1.file.%s/share1", cloud.StorageEndpointSuffix),
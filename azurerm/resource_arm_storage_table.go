@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/tables"
 )
@@ -38,6 +40,13 @@ func resourceArmStorageTable() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -97,8 +106,9 @@ func resourceArmStorageTable() *schema.Resource {
 }
 
 func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
-	storageClient := meta.(*ArmClient).storage
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*ArmClient).Storage
 
 	tableName := d.Get("name").(string)
 	accountName := d.Get("storage_account_name").(string)
@@ -107,7 +117,7 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, accountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", tableName, accountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", tableName, accountName, tf.WrapArmError(err))
 	}
 	if resourceGroup == nil {
 		return fmt.Errorf("Unable to locate Resource Group for Storage Share %q (Account %s) - assuming removed & removing from state", tableName, accountName)
@@ -123,7 +133,7 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 		existing, err := client.Exists(ctx, *resourceGroup, tableName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing) {
-				return fmt.Errorf("Error checking for existence of existing Storage Table %q (Account %q / Resource Group %q): %+v", tableName, accountName, *resourceGroup, err)
+				return fmt.Errorf("Error checking for existence of existing Storage Table %q (Account %q / Resource Group %q): %+v", tableName, accountName, *resourceGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -134,7 +144,7 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[DEBUG] Creating Table %q in Storage Account %q.", tableName, accountName)
 	if _, err := client.Create(ctx, accountName, tableName); err != nil {
-		return fmt.Errorf("Error creating Table %q within Storage Account %q: %s", tableName, accountName, err)
+		return fmt.Errorf("Error creating Table %q within Storage Account %q: %s", tableName, accountName, tf.WrapArmError(err))
 	}
 
 	if _, err := client.SetACL(ctx, accountName, tableName, acls); err != nil {
@@ -146,8 +156,9 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := tables.ParseResourceID(d.Id())
 	if err != nil {
@@ -156,7 +167,7 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", id.TableName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 	}
 
 	if resourceGroup == nil {
@@ -178,12 +189,12 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Table %q in Storage Account %q: %s", id.TableName, id.AccountName, err)
+		return fmt.Errorf("Error retrieving Table %q in Storage Account %q: %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 	}
 
 	acls, err := client.GetACL(ctx, id.AccountName, id.TableName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving ACL's %q in Storage Account %q: %s", id.TableName, id.AccountName, err)
+		return fmt.Errorf("Error retrieving ACL's %q in Storage Account %q: %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 	}
 
 	d.Set("name", id.TableName)
@@ -198,8 +209,9 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := tables.ParseResourceID(d.Id())
 	if err != nil {
@@ -208,7 +220,7 @@ func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) err
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", id.TableName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 	}
 
 	if resourceGroup == nil {
@@ -223,15 +235,16 @@ func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[INFO] Deleting Table %q in Storage Account %q", id.TableName, id.AccountName)
 	if _, err := client.Delete(ctx, id.AccountName, id.TableName); err != nil {
-		return fmt.Errorf("Error deleting Table %q from Storage Account %q: %s", id.TableName, id.AccountName, err)
+		return fmt.Errorf("Error deleting Table %q from Storage Account %q: %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 	}
 
 	return nil
 }
 
 func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	storageClient := meta.(*ArmClient).storage
-	ctx := meta.(*ArmClient).StopContext
+	storageClient := meta.(*ArmClient).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := tables.ParseResourceID(d.Id())
 	if err != nil {
@@ -240,7 +253,7 @@ func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) err
 
 	resourceGroup, err := storageClient.FindResourceGroup(ctx, id.AccountName)
 	if err != nil {
-		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", id.TableName, id.AccountName, err)
+		return fmt.Errorf("Error locating Resource Group for Storage Table %q (Account %s): %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 	}
 
 	if resourceGroup == nil {
@@ -261,7 +274,7 @@ func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) err
 		acls := expandStorageTableACLs(aclsRaw)
 
 		if _, err := client.SetACL(ctx, id.AccountName, id.TableName, acls); err != nil {
-			return fmt.Errorf("Error updating ACL's for Storage Table %q (Storage Account %q): %s", id.TableName, id.AccountName, err)
+			return fmt.Errorf("Error updating ACL's for Storage Table %q (Storage Account %q): %s", id.TableName, id.AccountName, tf.WrapArmError(err))
 		}
 
 		log.Printf("[DEBUG] Updated the ACL's for Storage Table %q (Storage Account %q)", id.TableName, id.AccountName)
@@ -70,7 +70,7 @@ func resourceStorageTableStateResourceV0V1() *schema.Resource {
 func resourceStorageTableStateUpgradeV0ToV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	tableName := rawState["name"].(string)
 	accountName := rawState["storage_account_name"].(string)
-	environment := meta.(*ArmClient).environment
+	environment := meta.(*ArmClient).Environment
 
 	id := rawState["id"].(string)
 	newResourceID := fmt.Sprintf("https://%s.table.%s/%s", accountName, environment.StorageEndpointSuffix, tableName)
@@ -83,7 +83,7 @@ func resourceStorageTableStateUpgradeV0ToV1(rawState map[string]interface{}, met
 func resourceStorageTableStateUpgradeV1ToV2(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	tableName := rawState["name"].(string)
 	accountName := rawState["storage_account_name"].(string)
-	environment := meta.(*ArmClient).environment
+	environment := meta.(*ArmClient).Environment
 
 	id := rawState["id"].(string)
 	newResourceID := fmt.Sprintf("https://%s.table.%s/Tables('%s')", accountName, environment.StorageEndpointSuffix, tableName)
@@ -25,9 +25,9 @@ func TestAzureRMStorageTableMigrateStateV0ToV1(t *testing.T) {
 			"storage_account_name": "account1",
 		}
 		meta := &ArmClient{
-			environment: cloud,
+			Environment: cloud,
 		}
-		suffix := meta.environment.StorageEndpointSuffix
+		suffix := meta.Environment.StorageEndpointSuffix
 
 		expected := map[string]interface{}{
 			"id":                   fmt.Sprintf("https://account1.table.%s/table1", suffix),
@@ -60,9 +60,9 @@ func TestAzureRMStorageTableMigrateStateV1ToV2(t *testing.T) {
 		t.Logf("[DEBUG] Testing with Cloud %q", cloud.Name)
 
 		meta := &ArmClient{
-			environment: cloud,
+			Environment: cloud,
 		}
-		suffix := meta.environment.StorageEndpointSuffix
+		suffix := meta.Environment.StorageEndpointSuffix
 
 		input := map[string]interface{}{
 			"id":                   fmt.Sprintf("https://account1.table.%s/table1", suffix),
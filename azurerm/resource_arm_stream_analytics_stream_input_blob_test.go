@@ -180,7 +180,7 @@ func testCheckAzureRMStreamAnalyticsStreamInputBlobExists(resourceName string) r
 		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		conn := testAccProvider.Meta().(*ArmClient).streamanalytics.InputsClient
+		conn := testAccProvider.Meta().(*ArmClient).StreamAnalytics.InputsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
 		if err != nil {
@@ -196,7 +196,7 @@ func testCheckAzureRMStreamAnalyticsStreamInputBlobExists(resourceName string) r
 }
 
 func testCheckAzureRMStreamAnalyticsStreamInputBlobDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).streamanalytics.InputsClient
+	conn := testAccProvider.Meta().(*ArmClient).StreamAnalytics.InputsClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_stream_analytics_stream_input_eventhub" {
@@ -1,20 +1,39 @@
 package azurerm
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 var subnetResourceName = "azurerm_subnet"
 
+// the Network Policies for Private Endpoints and Private Link Services aren't exposed in the
+// version of the Network SDK used for Subnets - so the Subnet is managed using this API Version
+const subnetNetworkPoliciesApiVersion = "2019-06-01"
+
+// subnetNetworkPolicies are the fields within the `properties` of a Subnet which aren't exposed in
+// the version of the Network SDK used for Subnets
+type subnetNetworkPolicies struct {
+	PrivateEndpointNetworkPolicies    *string `json:"privateEndpointNetworkPolicies,omitempty"`
+	PrivateLinkServiceNetworkPolicies *string `json:"privateLinkServiceNetworkPolicies,omitempty"`
+}
+
 func resourceArmSubnet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSubnetCreateUpdate,
@@ -25,6 +44,13 @@ func resourceArmSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -71,6 +97,26 @@ func resourceArmSubnet() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"private_endpoint_network_policies": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Enabled",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Disabled",
+					"Enabled",
+				}, false),
+			},
+
+			"private_link_service_network_policies": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Enabled",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Disabled",
+					"Enabled",
+				}, false),
+			},
+
 			"delegation": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -122,8 +168,9 @@ func resourceArmSubnet() *schema.Resource {
 }
 
 func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Network.SubnetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -135,7 +182,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Subnet %q (Virtual Network %q / Resource Group %q): %s", name, vnetName, resGroup, err)
+				return fmt.Errorf("Error checking for presence of existing Subnet %q (Virtual Network %q / Resource Group %q): %s", name, vnetName, resGroup, tf.WrapArmError(err))
 			}
 		}
 
@@ -198,13 +245,18 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		SubnetPropertiesFormat: &properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, vnetName, name, subnet)
+	policies := subnetNetworkPolicies{
+		PrivateEndpointNetworkPolicies:    utils.String(d.Get("private_endpoint_network_policies").(string)),
+		PrivateLinkServiceNetworkPolicies: utils.String(d.Get("private_link_service_network_policies").(string)),
+	}
+
+	future, err := subnetCreateOrUpdateWithNetworkPolicies(ctx, client, resGroup, vnetName, name, subnet, policies)
 	if err != nil {
-		return fmt.Errorf("Error Creating/Updating Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		return fmt.Errorf("Error Creating/Updating Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		return fmt.Errorf("Error waiting for completion of Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, tf.WrapArmError(err))
 	}
 
 	read, err := client.Get(ctx, resGroup, vnetName, name, "")
@@ -221,8 +273,9 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Network.SubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -232,14 +285,13 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	vnetName := id.Path["virtualNetworks"]
 	name := id.Path["subnets"]
 
-	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
-
+	resp, policies, err := subnetGetWithNetworkPolicies(ctx, client, resGroup, vnetName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure Subnet %q: %+v", name, err)
+		return fmt.Errorf("Error making Read request on Azure Subnet %q: %+v", name, tf.WrapArmError(err))
 	}
 
 	d.Set("name", name)
@@ -277,12 +329,16 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	d.Set("private_endpoint_network_policies", flattenSubnetNetworkPolicies(policies.PrivateEndpointNetworkPolicies))
+	d.Set("private_link_service_network_policies", flattenSubnetNetworkPolicies(policies.PrivateLinkServiceNetworkPolicies))
+
 	return nil
 }
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).network.SubnetsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).Network.SubnetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -322,16 +378,217 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	future, err := client.Delete(ctx, resGroup, vnetName, name)
 	if err != nil {
-		return fmt.Errorf("Error deleting Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		return fmt.Errorf("Error deleting Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, tf.WrapArmError(err))
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion for Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		return fmt.Errorf("Error waiting for completion for Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, tf.WrapArmError(err))
 	}
 
 	return nil
 }
 
+// subnetCreateOrUpdateWithNetworkPolicies creates/updates the Subnet using the Network SDK, with the Network
+// Policies added to the request body - using an API Version which supports them. Since this API Version is newer
+// than the Network SDK, any properties of an existing Subnet which the Network SDK doesn't know about (for example
+// the `natGateway` managed by `azurerm_subnet_nat_gateway_association`) are retained in the request body.
+func subnetCreateOrUpdateWithNetworkPolicies(ctx context.Context, client *network.SubnetsClient, resGroup string, vnetName string, name string, subnet network.Subnet, policies subnetNetworkPolicies) (future network.SubnetsCreateOrUpdateFuture, err error) {
+	body, err := expandSubnetWithNetworkPolicies(subnet, policies)
+	if err != nil {
+		return future, err
+	}
+
+	resp, existing, err := subnetGetRaw(ctx, client, resGroup, vnetName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			return future, autorest.NewErrorWithError(err, "network.SubnetsClient", "Get", resp, "Failure retrieving the existing Subnet")
+		}
+	}
+	if len(existing) > 0 {
+		if err := mergeSubnetUnknownProperties(body, existing); err != nil {
+			return future, err
+		}
+	}
+
+	req, err := client.CreateOrUpdatePreparer(ctx, resGroup, vnetName, name, subnet)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "network.SubnetsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	req, err = autorest.Prepare(req, autorest.WithJSON(body), withSubnetNetworkPoliciesApiVersion())
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "network.SubnetsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	future, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		return future, autorest.NewErrorWithError(err, "network.SubnetsClient", "CreateOrUpdate", future.Response(), "Failure sending request")
+	}
+
+	return future, nil
+}
+
+// subnetGetWithNetworkPolicies retrieves the Subnet using the Network SDK, along with the Network Policies
+// from the response body - using an API Version which supports them
+func subnetGetWithNetworkPolicies(ctx context.Context, client *network.SubnetsClient, resGroup string, vnetName string, name string) (result network.Subnet, policies subnetNetworkPolicies, err error) {
+	resp, raw, err := subnetGetRaw(ctx, client, resGroup, vnetName, name)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, policies, err
+	}
+
+	// the body is consumed by the Responder, so is replaced with the copy which has already been read
+	resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	result, err = client.GetResponder(resp)
+	if err != nil {
+		return result, policies, autorest.NewErrorWithError(err, "network.SubnetsClient", "Get", resp, "Failure responding to request")
+	}
+
+	var wrapper struct {
+		Properties *subnetNetworkPolicies `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return result, policies, fmt.Errorf("Error parsing the Network Policies: %+v", err)
+	}
+	if wrapper.Properties != nil {
+		policies = *wrapper.Properties
+	}
+
+	return result, policies, nil
+}
+
+// subnetGetRaw retrieves the Subnet using an API Version which supports the Network Policies, returning the
+// raw response body - an error is returned (along with the response) when the Subnet wasn't retrieved
+func subnetGetRaw(ctx context.Context, client *network.SubnetsClient, resGroup string, vnetName string, name string) (*http.Response, []byte, error) {
+	req, err := client.GetPreparer(ctx, resGroup, vnetName, name, "")
+	if err == nil {
+		req, err = autorest.Prepare(req, withSubnetNetworkPoliciesApiVersion())
+	}
+	if err != nil {
+		return nil, nil, autorest.NewErrorWithError(err, "network.SubnetsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		return resp, nil, autorest.NewErrorWithError(err, "network.SubnetsClient", "Get", resp, "Failure sending request")
+	}
+
+	var raw []byte
+	if resp.Body != nil {
+		raw, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, nil, autorest.NewErrorWithError(err, "network.SubnetsClient", "Get", resp, "Failure reading response")
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// the Responder returns the error from Azure (and closes the body)
+		resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+		if _, err := client.GetResponder(resp); err != nil {
+			return resp, nil, autorest.NewErrorWithError(err, "network.SubnetsClient", "Get", resp, "Failure responding to request")
+		}
+		return resp, nil, fmt.Errorf("Unexpected status code %d retrieving the Subnet", resp.StatusCode)
+	}
+
+	return resp, raw, nil
+}
+
+func withSubnetNetworkPoliciesApiVersion() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err == nil {
+				query := r.URL.Query()
+				query.Set("api-version", subnetNetworkPoliciesApiVersion)
+				r.URL.RawQuery = query.Encode()
+			}
+			return r, err
+		})
+	}
+}
+
+func expandSubnetWithNetworkPolicies(subnet network.Subnet, policies subnetNetworkPolicies) (map[string]interface{}, error) {
+	// round-trip the Subnet so that the SDK's serialization (e.g. flattening the `properties`) is used
+	raw, err := json.Marshal(subnet)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing the Subnet: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing the Subnet: %+v", err)
+	}
+
+	props, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+	}
+	if v := policies.PrivateEndpointNetworkPolicies; v != nil {
+		props["privateEndpointNetworkPolicies"] = *v
+	}
+	if v := policies.PrivateLinkServiceNetworkPolicies; v != nil {
+		props["privateLinkServiceNetworkPolicies"] = *v
+	}
+	body["properties"] = props
+
+	return body, nil
+}
+
+// mergeSubnetUnknownProperties adds the `properties` of the existing Subnet which the Network SDK doesn't know
+// about to the request body, such that these aren't removed when the Subnet is updated
+func mergeSubnetUnknownProperties(body map[string]interface{}, existing []byte) error {
+	var raw struct {
+		Properties map[string]interface{} `json:"properties"`
+	}
+	if err := json.Unmarshal(existing, &raw); err != nil {
+		return fmt.Errorf("Error deserializing the existing Subnet: %+v", err)
+	}
+
+	// round-trip the existing Subnet through the Network SDK to find out which properties it knows about
+	var subnet network.Subnet
+	if err := json.Unmarshal(existing, &subnet); err != nil {
+		return fmt.Errorf("Error deserializing the existing Subnet: %+v", err)
+	}
+	serialized, err := json.Marshal(subnet)
+	if err != nil {
+		return fmt.Errorf("Error serializing the existing Subnet: %+v", err)
+	}
+	var known struct {
+		Properties map[string]interface{} `json:"properties"`
+	}
+	if err := json.Unmarshal(serialized, &known); err != nil {
+		return fmt.Errorf("Error deserializing the existing Subnet: %+v", err)
+	}
+
+	props, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+	}
+	for k, v := range raw.Properties {
+		if _, ok := known.Properties[k]; ok {
+			continue
+		}
+		// the Network Policies are managed by this resource
+		if _, ok := props[k]; ok {
+			continue
+		}
+		props[k] = v
+	}
+	body["properties"] = props
+
+	return nil
+}
+
+func flattenSubnetNetworkPolicies(input *string) string {
+	// these are omitted by the API when they're Enabled
+	if input == nil || *input == "" {
+		return "Enabled"
+	}
+
+	return *input
+}
+
 func expandSubnetServiceEndpoints(d *schema.ResourceData) []network.ServiceEndpointPropertiesFormat {
 	serviceEndpoints := d.Get("service_endpoints").([]interface{})
 	endpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubnetNatGatewayAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubnetNatGatewayAssociationCreate,
+		Read:   resourceArmSubnetNatGatewayAssociationRead,
+		Delete: resourceArmSubnetNatGatewayAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateSubnetID,
+			},
+
+			"nat_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateNatGatewayID,
+			},
+		},
+	}
+}
+
+func resourceArmSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaySubnetsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Subnet <-> NAT Gateway Association creation.")
+
+	subnetId := d.Get("subnet_id").(string)
+	natGatewayId := d.Get("nat_gateway_id").(string)
+
+	parsedSubnetId, err := resourceid.ParseSubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	parsedNatGatewayId, err := resourceid.ParseNatGatewayID(natGatewayId)
+	if err != nil {
+		return err
+	}
+
+	natGatewayName := parsedNatGatewayId.Name
+	locks.ByName(natGatewayName, natGatewayResourceName)
+	defer locks.UnlockByName(natGatewayName, natGatewayResourceName)
+
+	subnetName := parsedSubnetId.Name
+	virtualNetworkName := parsedSubnetId.VirtualNetworkName
+	resourceGroup := parsedSubnetId.ResourceGroup
+
+	locks.ByName(virtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(subnet.Response) {
+			return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) was not found!", subnetName, virtualNetworkName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if props := subnet.SubnetPropertiesFormat; props != nil {
+		if requireResourcesToBeImported {
+			if ng := props.NatGateway; ng != nil {
+				// we're intentionally not checking the ID - if there's a NatGateway, it needs to be imported
+				if ng.ID != nil && subnet.ID != nil {
+					return tf.ImportAsExistsError("azurerm_subnet_nat_gateway_association", *subnet.ID)
+				}
+			}
+		}
+
+		props.NatGateway = &network.SubResource{
+			ID: utils.String(natGatewayId),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
+	if err != nil {
+		return fmt.Errorf("Error updating NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubnetNatGatewayAssociationRead(d, meta)
+}
+
+func resourceArmSubnetNatGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaySubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualNetworkName := id.VirtualNetworkName
+	subnetName := id.Name
+
+	resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	props := resp.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, virtualNetworkName, resourceGroup)
+	}
+
+	natGateway := props.NatGateway
+	if natGateway == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) doesn't have a NAT Gateway - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", resp.ID)
+	d.Set("nat_gateway_id", natGateway.ID)
+
+	return nil
+}
+
+func resourceArmSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.NatGatewaySubnetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	virtualNetworkName := id.VirtualNetworkName
+	subnetName := id.Name
+
+	// retrieve the subnet
+	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	props := read.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("`Properties` was nil for Subnet %q (Virtual Network %q / Resource Group %q)", subnetName, virtualNetworkName, resourceGroup)
+	}
+
+	if props.NatGateway == nil || props.NatGateway.ID == nil {
+		log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) has no NAT Gateway - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+		return nil
+	}
+
+	// once we have the nat gateway id to lock on, lock on that
+	natGateway, err := resourceid.ParseNatGatewayID(*props.NatGateway.ID)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(natGateway.Name, natGatewayResourceName)
+	defer locks.UnlockByName(natGateway.Name, natGatewayResourceName)
+
+	locks.ByName(virtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(virtualNetworkName, virtualNetworkResourceName)
+
+	locks.ByName(subnetName, subnetResourceName)
+	defer locks.UnlockByName(subnetName, subnetResourceName)
+
+	// then re-retrieve it to ensure we've got the latest state
+	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	read.SubnetPropertiesFormat.NatGateway = nil
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, read)
+	if err != nil {
+		return fmt.Errorf("Error removing NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, tf.WrapArmError(err))
+	}
+
+	return nil
+}
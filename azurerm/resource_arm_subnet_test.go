@@ -89,6 +89,49 @@ func TestAccAzureRMSubnet_delegation(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSubnet_networkPolicies(t *testing.T) {
+	resourceName := "azurerm_subnet.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_endpoint_network_policies", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "private_link_service_network_policies", "Enabled"),
+				),
+			},
+			{
+				Config: testAccAzureRMSubnet_networkPolicies(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_endpoint_network_policies", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "private_link_service_network_policies", "Disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMSubnet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_endpoint_network_policies", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "private_link_service_network_policies", "Enabled"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSubnet_routeTableUpdate(t *testing.T) {
 	resourceName := "azurerm_subnet.test"
 	ri := tf.AccRandTimeInt()
@@ -301,6 +344,50 @@ func TestAccAzureRMSubnet_serviceEndpointsVNetUpdate(t *testing.T) {
 	})
 }
 
+func TestMergeSubnetUnknownProperties(t *testing.T) {
+	existing := `{
+  "name": "example",
+  "properties": {
+    "addressPrefix": "10.0.1.0/24",
+    "networkSecurityGroup": {"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1"},
+    "natGateway": {"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/natGateways/gateway1"},
+    "privateEndpointNetworkPolicies": "Enabled"
+  }
+}`
+	body := map[string]interface{}{
+		"name": "example",
+		"properties": map[string]interface{}{
+			"addressPrefix":                  "10.0.2.0/24",
+			"privateEndpointNetworkPolicies": "Disabled",
+		},
+	}
+
+	if err := mergeSubnetUnknownProperties(body, []byte(existing)); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	props := body["properties"].(map[string]interface{})
+
+	// properties which the Network SDK knows about are managed by the resource, so shouldn't be retained
+	if v := props["addressPrefix"]; v != "10.0.2.0/24" {
+		t.Fatalf("Expected `addressPrefix` to be %q but got %q", "10.0.2.0/24", v)
+	}
+	if _, ok := props["networkSecurityGroup"]; ok {
+		t.Fatalf("Expected `networkSecurityGroup` not to be retained")
+	}
+
+	// as are the Network Policies
+	if v := props["privateEndpointNetworkPolicies"]; v != "Disabled" {
+		t.Fatalf("Expected `privateEndpointNetworkPolicies` to be %q but got %q", "Disabled", v)
+	}
+
+	// whereas unknown properties should be retained
+	natGateway, ok := props["natGateway"].(map[string]interface{})
+	if !ok || !strings.HasSuffix(natGateway["id"].(string), "/natGateways/gateway1") {
+		t.Fatalf("Expected `natGateway` to be retained but got %+v", props["natGateway"])
+	}
+}
+
 func testCheckAzureRMSubnetExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -318,7 +405,7 @@ func testCheckAzureRMSubnetExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.SubnetsClient
+		client := testAccProvider.Meta().(*ArmClient).Network.SubnetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vnetName, name, "")
@@ -351,8 +438,8 @@ func testCheckAzureRMSubnetRouteTableExists(resourceName string, routeTableId st
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", subnetName)
 		}
 
-		networksClient := testAccProvider.Meta().(*ArmClient).network.VnetClient
-		subnetsClient := testAccProvider.Meta().(*ArmClient).network.SubnetsClient
+		networksClient := testAccProvider.Meta().(*ArmClient).Network.VnetClient
+		subnetsClient := testAccProvider.Meta().(*ArmClient).Network.SubnetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		vnetResp, vnetErr := networksClient.Get(ctx, resourceGroup, vnetName, "")
@@ -400,7 +487,7 @@ func testCheckAzureRMSubnetDisappears(resourceName string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).network.SubnetsClient
+		client := testAccProvider.Meta().(*ArmClient).Network.SubnetsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, vnetName, name)
 		if err != nil {
@@ -418,7 +505,7 @@ func testCheckAzureRMSubnetDisappears(resourceName string) resource.TestCheckFun
 }
 
 func testCheckAzureRMSubnetDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.SubnetsClient
+	client := testAccProvider.Meta().(*ArmClient).Network.SubnetsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -465,6 +552,31 @@ resource "azurerm_subnet" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMSubnet_networkPolicies(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                                  = "acctestsubnet%d"
+  resource_group_name                   = "${azurerm_resource_group.test.name}"
+  virtual_network_name                  = "${azurerm_virtual_network.test.name}"
+  address_prefix                        = "10.0.2.0/24"
+  private_endpoint_network_policies     = "Disabled"
+  private_link_service_network_policies = "Disabled"
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMSubnet_requiresImport(rInt int, location string) string {
 	template := testAccAzureRMSubnet_basic(rInt, location)
 	return fmt.Sprintf(`
@@ -9,13 +9,16 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +29,13 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Update: resourceArmTemplateDeploymentCreateUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,26 +43,86 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"resource_group_name": azure.SchemaResourceGroupName(),
+			// when neither `resource_group_name` or `management_group_id` are specified
+			// the Template is deployed at the Subscription scope
+			"resource_group_name": azure.SchemaResourceGroupNameOptional(),
+
+			"management_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"resource_group_name"},
+			},
+
+			// only used for Subscription and Management Group scoped Deployments
+			"location": azure.SchemaLocationOptional(),
 
 			"template_body": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				StateFunc: normalizeJson,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ConflictsWith:    []string{"template_link"},
+			},
+
+			"template_link": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"template_body"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"content_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
 			},
 
 			"parameters": {
 				Type:          schema.TypeMap,
 				Optional:      true,
-				ConflictsWith: []string{"parameters_body"},
+				ConflictsWith: []string{"parameters_body", "parameters_link"},
 			},
 
 			"parameters_body": {
-				Type:          schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ConflictsWith:    []string{"parameters", "parameters_link"},
+			},
+
+			"parameters_link": {
+				Type:          schema.TypeList,
 				Optional:      true,
-				StateFunc:     normalizeJson,
-				ConflictsWith: []string{"parameters"},
+				MaxItems:      1,
+				ConflictsWith: []string{"parameters", "parameters_body"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"content_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
 			},
 
 			"deployment_mode": {
@@ -69,24 +139,34 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.resource.DeploymentsClient
-	ctx := client.StopContext
-
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
+	deployClient := client.Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	id := templateDeploymentId{
+		name:            d.Get("name").(string),
+		resourceGroup:   d.Get("resource_group_name").(string),
+		managementGroup: d.Get("management_group_id").(string),
+	}
 	deploymentMode := d.Get("deployment_mode").(string)
+	location := d.Get("location").(string)
 
 	if requireResourcesToBeImported && d.IsNewResource() {
-		existing, err := deployClient.Get(ctx, resourceGroup, name)
+		existing, err := getTemplateDeployment(ctx, deployClient, id)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Template Deployment %s (resource group %s) %v", name, resourceGroup, err)
+				return fmt.Errorf("Error checking for presence of existing %s: %v", id, tf.WrapArmError(err))
 			}
 		}
 
@@ -100,6 +180,25 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 		Mode: resources.DeploymentMode(deploymentMode),
 	}
 
+	deployment := resources.Deployment{
+		Properties: &properties,
+	}
+
+	if id.resourceGroup != "" {
+		if location != "" {
+			return fmt.Errorf("`location` can only be specified for Subscription and Management Group scoped Template Deployments")
+		}
+	} else {
+		if location == "" {
+			return fmt.Errorf("`location` must be specified for Subscription and Management Group scoped Template Deployments")
+		}
+		if !strings.EqualFold(deploymentMode, string(resources.Incremental)) {
+			return fmt.Errorf("`deployment_mode` must be `Incremental` for Subscription and Management Group scoped Template Deployments")
+		}
+
+		deployment.Location = utils.String(azure.NormalizeLocation(location))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		params := v.(map[string]interface{})
 
@@ -124,6 +223,14 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 		properties.Parameters = &params
 	}
 
+	if v, ok := d.GetOk("parameters_link"); ok {
+		uri, contentVersion := expandTemplateDeploymentLink(v.([]interface{}))
+		properties.ParametersLink = &resources.ParametersLink{
+			URI:            uri,
+			ContentVersion: contentVersion,
+		}
+	}
+
 	if v, ok := d.GetOk("template_body"); ok {
 		template, err := expandTemplateBody(v.(string))
 		if err != nil {
@@ -133,25 +240,24 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 		properties.Template = &template
 	}
 
-	deployment := resources.Deployment{
-		Properties: &properties,
-	}
-
-	future, err := deployClient.CreateOrUpdate(ctx, resourceGroup, name, deployment)
-	if err != nil {
-		return fmt.Errorf("Error creating deployment: %+v", err)
+	if v, ok := d.GetOk("template_link"); ok {
+		uri, contentVersion := expandTemplateDeploymentLink(v.([]interface{}))
+		properties.TemplateLink = &resources.TemplateLink{
+			URI:            uri,
+			ContentVersion: contentVersion,
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, deployClient.Client); err != nil {
-		return fmt.Errorf("Error waiting for deployment: %+v", err)
+	if err := createTemplateDeployment(ctx, deployClient, id, deployment); err != nil {
+		return err
 	}
 
-	read, err := deployClient.Get(ctx, resourceGroup, name)
+	read, err := getTemplateDeployment(ctx, deployClient, id)
 	if err != nil {
 		return err
 	}
 	if read.ID == nil {
-		return fmt.Errorf("Cannot read Template Deployment %s (resource group %s) ID", name, resourceGroup)
+		return fmt.Errorf("Cannot read %s ID", id)
 	}
 
 	d.SetId(*read.ID)
@@ -161,91 +267,266 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.resource.DeploymentsClient
-	ctx := client.StopContext
+	deployClient := client.Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
-	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
 
-	resp, err := deployClient.Get(ctx, resourceGroup, name)
+	resp, err := getTemplateDeployment(ctx, deployClient, *id)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-
-	outputs := make(map[string]string)
-	if outs := resp.Properties.Outputs; outs != nil {
-		outsVal := outs.(map[string]interface{})
-		if len(outsVal) > 0 {
-			for key, output := range outsVal {
-				log.Printf("[DEBUG] Processing deployment output %s", key)
-				outputMap := output.(map[string]interface{})
-				outputValue, ok := outputMap["value"]
-				if !ok {
-					log.Printf("[DEBUG] No value - skipping")
-					continue
-				}
-				outputType, ok := outputMap["type"]
-				if !ok {
-					log.Printf("[DEBUG] No type - skipping")
-					continue
-				}
+		return fmt.Errorf("Error making Read request on %s: %+v", id, tf.WrapArmError(err))
+	}
 
-				var outputValueString string
-				switch strings.ToLower(outputType.(string)) {
-				case "bool":
-					outputValueString = strconv.FormatBool(outputValue.(bool))
+	d.Set("name", id.name)
+	d.Set("resource_group_name", id.resourceGroup)
+	d.Set("management_group_id", id.managementGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
 
-				case "string":
-					outputValueString = outputValue.(string)
+	props := resp.Properties
+	if props == nil {
+		return fmt.Errorf("Error retrieving %s: `properties` was nil", id)
+	}
 
-				case "int":
-					outputValueString = fmt.Sprint(outputValue)
+	d.Set("deployment_mode", string(props.Mode))
 
-				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
-						key, outputType)
-					continue
+	if err := d.Set("template_link", flattenTemplateDeploymentTemplateLink(props.TemplateLink)); err != nil {
+		return fmt.Errorf("Error setting `template_link`: %+v", err)
+	}
+	if err := d.Set("parameters_link", flattenTemplateDeploymentParametersLink(props.ParametersLink)); err != nil {
+		return fmt.Errorf("Error setting `parameters_link`: %+v", err)
+	}
+
+	// the template isn't returned when retrieving the Deployment, so we have to export it to detect changes
+	if props.TemplateLink == nil {
+		template, err := exportTemplateDeploymentTemplate(ctx, deployClient, *id)
+		if err != nil {
+			return fmt.Errorf("Error exporting the template for %s: %+v", id, tf.WrapArmError(err))
+		}
+
+		if template.Template != nil {
+			body, err := json.Marshal(template.Template)
+			if err != nil {
+				return fmt.Errorf("Error serializing `template_body`: %+v", err)
+			}
+			d.Set("template_body", normalizeJson(string(body)))
+		}
+	}
+
+	// only the parameters which were specified are tracked, since the API also returns any defaulted values
+	// and omits the values of `secureString` and `secureObject` parameters
+	deployedParameters := flattenTemplateDeploymentParameterValues(props.Parameters)
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters := v.(map[string]interface{})
+		for key := range parameters {
+			if value, ok := deployedParameters[key]; ok {
+				flattened, err := flattenTemplateDeploymentValue(value)
+				if err != nil {
+					return fmt.Errorf("Error flattening parameter %q: %+v", key, err)
 				}
-				outputs[key] = outputValueString
+				parameters[key] = flattened
+			}
+		}
+
+		if err := d.Set("parameters", parameters); err != nil {
+			return fmt.Errorf("Error setting `parameters`: %+v", err)
+		}
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		parameters, err := expandParametersBody(v.(string))
+		if err != nil {
+			return err
+		}
+
+		for key, raw := range parameters {
+			parameter, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// parameters sourced from a Key Vault reference don't have a value to compare
+			if _, hasValue := parameter["value"]; !hasValue {
+				continue
+			}
+
+			if value, ok := deployedParameters[key]; ok {
+				parameter["value"] = value
 			}
 		}
+
+		body, err := json.Marshal(parameters)
+		if err != nil {
+			return fmt.Errorf("Error serializing `parameters_body`: %+v", err)
+		}
+		d.Set("parameters_body", normalizeJson(string(body)))
+	}
+
+	outputs, outputsJson, err := flattenTemplateDeploymentOutputs(props.Outputs)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("Error setting `outputs`: %+v", err)
 	}
+	d.Set("outputs_json", outputsJson)
 
-	return d.Set("outputs", outputs)
+	return nil
 }
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.resource.DeploymentsClient
-	ctx := client.StopContext
+	deployClient := client.Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
-	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
+
+	if err := deleteTemplateDeployment(ctx, deployClient, *id); err != nil {
+		return err
+	}
+
+	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, *id)
+}
+
+// templateDeploymentId represents the scope a Template Deployment exists within - when neither the
+// Resource Group or Management Group are specified the Deployment exists at the Subscription scope
+type templateDeploymentId struct {
+	name            string
+	resourceGroup   string
+	managementGroup string
+}
+
+func (id templateDeploymentId) String() string {
+	if id.resourceGroup != "" {
+		return fmt.Sprintf("Template Deployment %q (Resource Group %q)", id.name, id.resourceGroup)
+	}
+
+	if id.managementGroup != "" {
+		return fmt.Sprintf("Template Deployment %q (Management Group %q)", id.name, id.managementGroup)
+	}
+
+	return fmt.Sprintf("Template Deployment %q (Subscription scope)", id.name)
+}
+
+func parseTemplateDeploymentID(input string) (*templateDeploymentId, error) {
+	// this is one of:
+	// /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1
+	// /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/deployment1
+	// /providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Resources/deployments/deployment1
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments)%2 != 0 {
+		return nil, fmt.Errorf("The number of segments in the Template Deployment ID %q is not divisible by 2", input)
+	}
+
+	id := templateDeploymentId{}
+	for i := 0; i < len(segments); i += 2 {
+		key := segments[i]
+		value := segments[i+1]
+		if value == "" {
+			return nil, fmt.Errorf("Template Deployment ID %q contained an empty value for %q", input, key)
+		}
+
+		switch strings.ToLower(key) {
+		case "resourcegroups":
+			id.resourceGroup = value
+		case "managementgroups":
+			id.managementGroup = value
+		case "deployments":
+			id.name = value
+		}
+	}
+
+	if id.name == "" {
+		return nil, fmt.Errorf("Template Deployment ID %q was missing the `deployments` segment", input)
+	}
+
+	return &id, nil
+}
+
+func createTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, id templateDeploymentId, deployment resources.Deployment) error {
+	var err error
+	switch {
+	case id.resourceGroup != "":
+		var future resources.DeploymentsCreateOrUpdateFuture
+		if future, err = client.CreateOrUpdate(ctx, id.resourceGroup, id.name, deployment); err == nil {
+			err = future.WaitForCompletionRef(ctx, client.Client)
+		}
+
+	case id.managementGroup != "":
+		var future resources.DeploymentsCreateOrUpdateAtManagementGroupScopeFuture
+		if future, err = client.CreateOrUpdateAtManagementGroupScope(ctx, id.managementGroup, id.name, deployment); err == nil {
+			err = future.WaitForCompletionRef(ctx, client.Client)
+		}
+
+	default:
+		var future resources.DeploymentsCreateOrUpdateAtSubscriptionScopeFuture
+		if future, err = client.CreateOrUpdateAtSubscriptionScope(ctx, id.name, deployment); err == nil {
+			err = future.WaitForCompletionRef(ctx, client.Client)
+		}
 	}
 
-	_, err = deployClient.Delete(ctx, resourceGroup, name)
 	if err != nil {
-		return err
+		return fmt.Errorf("Error creating %s: %+v", id, tf.WrapArmError(err))
+	}
+
+	return nil
+}
+
+func getTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, id templateDeploymentId) (resources.DeploymentExtended, error) {
+	if id.resourceGroup != "" {
+		return client.Get(ctx, id.resourceGroup, id.name)
+	}
+
+	if id.managementGroup != "" {
+		return client.GetAtManagementGroupScope(ctx, id.managementGroup, id.name)
+	}
+
+	return client.GetAtSubscriptionScope(ctx, id.name)
+}
+
+func exportTemplateDeploymentTemplate(ctx context.Context, client *resources.DeploymentsClient, id templateDeploymentId) (resources.DeploymentExportResult, error) {
+	if id.resourceGroup != "" {
+		return client.ExportTemplate(ctx, id.resourceGroup, id.name)
+	}
+
+	if id.managementGroup != "" {
+		return client.ExportTemplateAtManagementGroupScope(ctx, id.managementGroup, id.name)
 	}
 
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	return client.ExportTemplateAtSubscriptionScope(ctx, id.name)
+}
+
+func deleteTemplateDeployment(ctx context.Context, client *resources.DeploymentsClient, id templateDeploymentId) error {
+	var err error
+	switch {
+	case id.resourceGroup != "":
+		_, err = client.Delete(ctx, id.resourceGroup, id.name)
+	case id.managementGroup != "":
+		_, err = client.DeleteAtManagementGroupScope(ctx, id.managementGroup, id.name)
+	default:
+		_, err = client.DeleteAtSubscriptionScope(ctx, id.name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error deleting %s: %+v", id, tf.WrapArmError(err))
+	}
+
+	return nil
 }
 
 // TODO: move this out into the new `helpers` structure
@@ -265,6 +546,130 @@ func expandTemplateBody(template string) (map[string]interface{}, error) {
 	return templateBody, nil
 }
 
+func expandTemplateDeploymentLink(input []interface{}) (*string, *string) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	v := input[0].(map[string]interface{})
+
+	var contentVersion *string
+	if version := v["content_version"].(string); version != "" {
+		contentVersion = utils.String(version)
+	}
+
+	return utils.String(v["uri"].(string)), contentVersion
+}
+
+func flattenTemplateDeploymentLink(uri *string, contentVersion *string) []interface{} {
+	output := map[string]interface{}{
+		"uri":             "",
+		"content_version": "",
+	}
+
+	if uri != nil {
+		output["uri"] = *uri
+	}
+	if contentVersion != nil {
+		output["content_version"] = *contentVersion
+	}
+
+	return []interface{}{output}
+}
+
+func flattenTemplateDeploymentTemplateLink(input *resources.TemplateLink) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return flattenTemplateDeploymentLink(input.URI, input.ContentVersion)
+}
+
+func flattenTemplateDeploymentParametersLink(input *resources.ParametersLink) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return flattenTemplateDeploymentLink(input.URI, input.ContentVersion)
+}
+
+// flattenTemplateDeploymentParameterValues returns the values of the deployed parameters,
+// which are returned from the API in the form `{ "name": { "type": "String", "value": "..." } }`
+func flattenTemplateDeploymentParameterValues(input interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	parameters, ok := input.(map[string]interface{})
+	if !ok {
+		return output
+	}
+
+	for key, raw := range parameters {
+		parameter, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if value, ok := parameter["value"]; ok {
+			output[key] = value
+		}
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentOutputs returns the outputs of the deployment both as a map of strings
+// (where non-string values are JSON-encoded) and as a JSON object retaining the original types
+func flattenTemplateDeploymentOutputs(input interface{}) (map[string]interface{}, string, error) {
+	outputs := make(map[string]interface{})
+	values := make(map[string]interface{})
+
+	if outs, ok := input.(map[string]interface{}); ok {
+		for key, output := range outs {
+			log.Printf("[DEBUG] Processing deployment output %s", key)
+			outputMap, ok := output.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// outputs of type `secureString` and `secureObject` don't return a value
+			outputValue, ok := outputMap["value"]
+			if !ok {
+				log.Printf("[DEBUG] No value - skipping")
+				continue
+			}
+
+			flattened, err := flattenTemplateDeploymentValue(outputValue)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error flattening output %q: %+v", key, err)
+			}
+
+			outputs[key] = flattened
+			values[key] = outputValue
+		}
+	}
+
+	outputsJson, err := json.Marshal(values)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error serializing `outputs_json`: %+v", err)
+	}
+
+	return outputs, string(outputsJson), nil
+}
+
+// flattenTemplateDeploymentValue converts a parameter or output value into a string - where
+// strings are returned as-is and any other type (bools, numbers, arrays and objects) is JSON-encoded
+func flattenTemplateDeploymentValue(input interface{}) (string, error) {
+	if v, ok := input.(string); ok {
+		return v, nil
+	}
+
+	value, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
 func normalizeJson(jsonString interface{}) string {
 	if jsonString == nil || jsonString == "" {
 		return ""
@@ -278,33 +683,33 @@ func normalizeJson(jsonString interface{}) string {
 	return string(b[:])
 }
 
-func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client *resources.DeploymentsClient, resourceGroup, name string) error {
+func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client *resources.DeploymentsClient, id templateDeploymentId) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
-	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to be deleted", id)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
-		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
+		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, id),
 		Timeout: 40 * time.Minute,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Template Deployment (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for %s to be deleted: %+v", id, tf.WrapArmError(err))
 	}
 
 	return nil
 }
 
-func templateDeploymentStateStatusCodeRefreshFunc(ctx context.Context, client *resources.DeploymentsClient, resourceGroup, name string) resource.StateRefreshFunc {
+func templateDeploymentStateStatusCodeRefreshFunc(ctx context.Context, client *resources.DeploymentsClient, id templateDeploymentId) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, resourceGroup, name)
+		res, err := getTemplateDeployment(ctx, client, id)
 
-		log.Printf("Retrieving Template Deployment %q (Resource Group %q) returned Status %d", resourceGroup, name, res.StatusCode)
+		log.Printf("Retrieving %s returned Status %d", id, res.StatusCode)
 
 		if err != nil {
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
-			return nil, "", fmt.Errorf("Error polling for the status of the Template Deployment %q (RG: %q): %+v", name, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error polling for the status of %s: %+v", id, tf.WrapArmError(err))
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
@@ -176,6 +176,243 @@ func TestAccAzureRMTemplateDeployment_withError(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTemplateDeployment_complexOutputs(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_complexOutputs(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.stringOutput", "hello"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.boolOutput", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.arrayOutput", `["first","second"]`),
+					resource.TestCheckResourceAttr(resourceName, "outputs.objectOutput", `{"nested":{"value":5}}`),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", `{"arrayOutput":["first","second"],"boolOutput":true,"objectOutput":{"nested":{"value":5}},"stringOutput":"hello"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_templateLink(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_templateLink(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_link.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_link.0.content_version", "1.0.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.storageAccountType", "Standard_GRS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_subscriptionScope(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_subscriptionScope(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", fmt.Sprintf("hello-%d", ri)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_managementGroupScope(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_managementGroupScope(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "management_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "hello"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTemplateDeployment_updateParameters(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_parametersBodyGreeting(ri, testLocation(), "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "hello"),
+				),
+			},
+			{
+				Config: testAccAzureRMTemplateDeployment_parametersBodyGreeting(ri, testLocation(), "world"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "world"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseTemplateDeploymentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *templateDeploymentId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/deployments/deploy1",
+			Expected: &templateDeploymentId{
+				name:          "deploy1",
+				resourceGroup: "group1",
+			},
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Resources/Deployments/deploy1",
+			Expected: &templateDeploymentId{
+				name:          "deploy1",
+				resourceGroup: "group1",
+			},
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/deploy1",
+			Expected: &templateDeploymentId{
+				name: "deploy1",
+			},
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Resources/deployments/deploy1",
+			Expected: &templateDeploymentId{
+				name:            "deploy1",
+				managementGroup: "group1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseTemplateDeploymentID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestFlattenTemplateDeploymentOutputs(t *testing.T) {
+	input := map[string]interface{}{
+		"stringOutput": map[string]interface{}{
+			"type":  "String",
+			"value": "hello",
+		},
+		"intOutput": map[string]interface{}{
+			"type":  "Int",
+			"value": float64(-123),
+		},
+		"boolOutput": map[string]interface{}{
+			"type":  "Bool",
+			"value": false,
+		},
+		"arrayOutput": map[string]interface{}{
+			"type":  "Array",
+			"value": []interface{}{"first", float64(2)},
+		},
+		"objectOutput": map[string]interface{}{
+			"type": "Object",
+			"value": map[string]interface{}{
+				"nested": true,
+			},
+		},
+		"secureOutput": map[string]interface{}{
+			"type": "SecureString",
+		},
+	}
+
+	outputs, outputsJson, err := flattenTemplateDeploymentOutputs(input)
+	if err != nil {
+		t.Fatalf("Error flattening outputs: %+v", err)
+	}
+
+	expected := map[string]string{
+		"stringOutput": "hello",
+		"intOutput":    "-123",
+		"boolOutput":   "false",
+		"arrayOutput":  `["first",2]`,
+		"objectOutput": `{"nested":true}`,
+	}
+
+	if len(outputs) != len(expected) {
+		t.Fatalf("Expected %d outputs but got %d: %+v", len(expected), len(outputs), outputs)
+	}
+
+	for key, value := range expected {
+		if outputs[key] != value {
+			t.Fatalf("Expected output %q to be %q but got %q", key, value, outputs[key])
+		}
+	}
+
+	expectedJson := `{"arrayOutput":["first",2],"boolOutput":false,"intOutput":-123,"objectOutput":{"nested":true},"stringOutput":"hello"}`
+	if outputsJson != expectedJson {
+		t.Fatalf("Expected `outputs_json` to be %q but got %q", expectedJson, outputsJson)
+	}
+}
+
 func testCheckAzureRMTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -184,22 +421,21 @@ func testCheckAzureRMTemplateDeploymentExists(resourceName string) resource.Test
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		name := rs.Primary.Attributes["name"]
-		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
-		if !hasResourceGroup {
-			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", name)
+		id, err := parseTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).resource.DeploymentsClient
+		client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
-		resp, err := client.Get(ctx, resourceGroup, name)
+		resp, err := getTemplateDeployment(ctx, client, *id)
 		if err != nil {
 			return fmt.Errorf("Bad: Get on deploymentsClient: %s", err)
 		}
 
 		if resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Bad: TemplateDeployment %q (resource group: %q) does not exist", name, resourceGroup)
+			return fmt.Errorf("Bad: %s does not exist", id)
 		}
 
 		return nil
@@ -214,25 +450,24 @@ func testCheckAzureRMTemplateDeploymentDisappears(resourceName string) resource.
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		deploymentName := rs.Primary.Attributes["name"]
-		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
-		if !hasResourceGroup {
-			return fmt.Errorf("Bad: no resource group found in state for template deployment: %s", deploymentName)
+		id, err := parseTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).resource.DeploymentsClient
+		client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
-		if _, err := client.Delete(ctx, resourceGroup, deploymentName); err != nil {
-			return fmt.Errorf("Failed deleting Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
+		if err := deleteTemplateDeployment(ctx, client, *id); err != nil {
+			return err
 		}
 
-		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName)
+		return waitForTemplateDeploymentToBeDeleted(ctx, client, *id)
 	}
 }
 
 func testCheckAzureRMTemplateDeploymentDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resource.DeploymentsClient
+	client := testAccProvider.Meta().(*ArmClient).Resource.DeploymentsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -240,10 +475,12 @@ func testCheckAzureRMTemplateDeploymentDestroy(s *terraform.State) error {
 			continue
 		}
 
-		name := rs.Primary.Attributes["name"]
-		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		id, err := parseTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 
-		resp, err := client.Get(ctx, resourceGroup, name)
+		resp, err := getTemplateDeployment(ctx, client, *id)
 
 		if err != nil {
 			return nil
@@ -881,3 +1118,185 @@ DEPLOY
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMTemplateDeployment_complexOutputs(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "stringOutput": {
+      "type": "string",
+      "value": "hello"
+    },
+    "boolOutput": {
+      "type": "bool",
+      "value": true
+    },
+    "arrayOutput": {
+      "type": "array",
+      "value": [
+        "first",
+        "second"
+      ]
+    },
+    "objectOutput": {
+      "type": "object",
+      "value": {
+        "nested": {
+          "value": 5
+        }
+      }
+    }
+  }
+}
+DEPLOY
+
+  deployment_mode = "Incremental"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_templateLink(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  template_link {
+    uri             = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/master/101-storage-account-create/azuredeploy.json"
+    content_version = "1.0.0.0"
+  }
+
+  parameters = {
+    storageAccountType = "Standard_GRS"
+    location           = "${azurerm_resource_group.test.location}"
+  }
+
+  deployment_mode = "Incremental"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_subscriptionScope(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_template_deployment" "test" {
+  name     = "acctesttemplate-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "suffix": {
+      "type": "string"
+    }
+  },
+  "resources": [],
+  "outputs": {
+    "greeting": {
+      "type": "string",
+      "value": "[concat('hello-', parameters('suffix'))]"
+    }
+  }
+}
+DEPLOY
+
+  parameters = {
+    suffix = "%d"
+  }
+
+  deployment_mode = "Incremental"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_managementGroupScope(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  management_group_id = "${azurerm_management_group.test.group_id}"
+  location            = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "greeting": {
+      "type": "string",
+      "value": "hello"
+    }
+  }
+}
+DEPLOY
+
+  deployment_mode = "Incremental"
+}
+`, rInt, rInt, location)
+}
+
+func testAccAzureRMTemplateDeployment_parametersBodyGreeting(rInt int, location string, greeting string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "greeting": {
+      "type": "string"
+    }
+  },
+  "resources": [],
+  "outputs": {
+    "greeting": {
+      "type": "string",
+      "value": "[parameters('greeting')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters_body = <<PARAMS
+{
+  "greeting": {
+    "value": "%s"
+  }
+}
+PARAMS
+
+  deployment_mode = "Incremental"
+}
+`, rInt, location, rInt, greeting)
+}
@@ -386,7 +386,7 @@ func testCheckAzureRMTrafficManagerEndpointExists(resourceName string) resource.
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManager.EndpointsClient
+		conn := testAccProvider.Meta().(*ArmClient).TrafficManager.EndpointsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
 		if err != nil {
@@ -418,7 +418,7 @@ func testCheckAzureRMTrafficManagerEndpointDisappears(resourceName string) resou
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManager.EndpointsClient
+		conn := testAccProvider.Meta().(*ArmClient).TrafficManager.EndpointsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		if _, err := conn.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name); err != nil {
@@ -431,7 +431,7 @@ func testCheckAzureRMTrafficManagerEndpointDisappears(resourceName string) resou
 
 func testCheckAzureRMTrafficManagerEndpointDestroy(s *terraform.State) error {
 
-	conn := testAccProvider.Meta().(*ArmClient).trafficManager.EndpointsClient
+	conn := testAccProvider.Meta().(*ArmClient).TrafficManager.EndpointsClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_traffic_manager_endpoint" {
@@ -84,7 +84,7 @@ func testCheckAzureRMUserAssignedIdentityExists(resourceName string) resource.Te
 			return fmt.Errorf("Bad: no resource group found in state for virtual machine: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).msi.UserAssignedIdentitiesClient
+		client := testAccProvider.Meta().(*ArmClient).Msi.UserAssignedIdentitiesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -101,7 +101,7 @@ func testCheckAzureRMUserAssignedIdentityExists(resourceName string) resource.Te
 }
 
 func testCheckAzureRMUserAssignedIdentityDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).msi.UserAssignedIdentitiesClient
+	client := testAccProvider.Meta().(*ArmClient).Msi.UserAssignedIdentitiesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
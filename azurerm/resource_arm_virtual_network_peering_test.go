@@ -154,7 +154,7 @@ func testCheckAzureRMVirtualNetworkPeeringExists(resourceName string) resource.T
 		}
 
 		// Ensure resource group/virtual network peering combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).network.VnetPeeringsClient
+		client := testAccProvider.Meta().(*ArmClient).Network.VnetPeeringsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vnetName, name)
@@ -186,7 +186,7 @@ func testCheckAzureRMVirtualNetworkPeeringDisappears(resourceName string) resour
 		}
 
 		// Ensure resource group/virtual network peering combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).network.VnetPeeringsClient
+		client := testAccProvider.Meta().(*ArmClient).Network.VnetPeeringsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, vnetName, name)
@@ -203,7 +203,7 @@ func testCheckAzureRMVirtualNetworkPeeringDisappears(resourceName string) resour
 }
 
 func testCheckAzureRMVirtualNetworkPeeringDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).network.VnetPeeringsClient
+	client := testAccProvider.Meta().(*ArmClient).Network.VnetPeeringsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {